@@ -0,0 +1,408 @@
+// Package main is the entry point for the API server.
+// @title Vida Plus API
+// @version 1.0
+// @description API para o sistema Vida Plus - gestão de saúde e bem-estar
+// @termsOfService http://swagger.io/terms/
+
+// @contact.name Vida Plus Support
+// @contact.url http://www.vidaplus.com/support
+// @contact.email support@vidaplus.com
+
+// @license.name MIT
+// @license.url https://opensource.org/licenses/MIT
+
+// @host localhost:8080
+// @BasePath /v1
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Type "Bearer" followed by a space and JWT token.
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+	// The zoneinfo of the clinic timezone isn't installed in the container image
+	_ "time/tzdata"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+	echoSwagger "github.com/swaggo/echo-swagger"
+	"github.com/vida-plus/api/internal/config"
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/internal/handler"
+	"github.com/vida-plus/api/internal/healthcheck"
+	"github.com/vida-plus/api/internal/middleware"
+	"github.com/vida-plus/api/internal/migrations"
+	"github.com/vida-plus/api/internal/repository"
+	"github.com/vida-plus/api/internal/service"
+	"github.com/vida-plus/api/pkg"
+	"github.com/vida-plus/api/pkg/database"
+	"github.com/vida-plus/api/pkg/jwks"
+	"github.com/vida-plus/api/pkg/mailer"
+	"github.com/vida-plus/api/pkg/migrate"
+	"github.com/vida-plus/api/pkg/shutdown"
+
+	_ "github.com/vida-plus/api/doc" // docs is generated by Swag CLI, you have to import it.
+)
+
+func main() {
+	// Settings come from the defaults, the CONFIG_FILE YAML file and the environment
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("invalid configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+	slog.SetDefault(cfg.Log.Logger(os.Stderr))
+
+	// Database migrations are managed with "api migrate up|down|status"
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(cfg, os.Args[2:], os.Stdout))
+	}
+
+	// Initialize MongoDB connection
+	mongoClient := database.InitMongoDB(cfg.Mongo.Connection())
+	shutdownManager := shutdown.New(cfg.Shutdown.Timeouts())
+
+	// Initialize database and repositories
+	db := database.GetDatabase(mongoClient, cfg.Mongo.Database)
+	userRepo := repository.NewUserRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	tokenRevocationRepo := repository.NewTokenRevocationRepository(db)
+	passwordResetRepo := repository.NewPasswordResetRepository(db)
+	mfaRepo := repository.NewMFARepository(db)
+	mfaPolicyRepo := repository.NewMFAPolicyRepository(db)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(db)
+	auditRepo := repository.NewAuditRepository(db)
+	invitationRepo := repository.NewInvitationRepository(db)
+	appointmentRepo := repository.NewAppointmentRepository(db)
+	availabilityRepo := repository.NewAvailabilityRepository(db)
+	medicalRecordRepo := repository.NewMedicalRecordRepository(db)
+	clinicalNoteRepo := repository.NewClinicalNoteRepository(db)
+
+	// Migrate before creating indexes, since migrations may fix the data a new index requires
+	if cfg.MigrateOnStart {
+		migrationRunner, err := migrate.New(db, migrations.All)
+		if err == nil {
+			_, err = migrationRunner.Up(context.Background())
+		}
+		if err != nil {
+			slog.Error("error applying database migrations", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
+
+	if err := repository.EnsureIndexes(context.Background(), db); err != nil {
+		slog.Error("error creating database indexes", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	// Load the JWT signing keys and keep them rotating in the background
+	keyConfig := cfg.JWT.Keys()
+	signingKeys, err := jwks.Load(keyConfig)
+	if err != nil {
+		slog.Error("error loading signing keys", slog.Any("error", err))
+		os.Exit(1)
+	}
+	if keyConfig.Generated() {
+		slog.Warn("JWT_GENERATE_KEYS is set, using generated signing keys that are lost on restart and not shared between replicas")
+	}
+	workers, stopWorkers := context.WithCancel(context.Background())
+	var workersDone sync.WaitGroup
+	workersDone.Add(1)
+	go func() {
+		defer workersDone.Done()
+		jwks.Run(workers, signingKeys, keyConfig)
+	}()
+
+	// Initialize other dependencies
+	jwtManager := pkg.NewJWTManager(signingKeys, cfg.JWT.Tokens())
+	revocationStore := service.NewTokenRevocationService(tokenRevocationRepo, refreshTokenRepo, cfg.JWT.AccessTokenTTL)
+	userStatusCache := service.NewUserStatusService(userRepo)
+	userService := service.NewUserService(userRepo, userStatusCache, revocationStore)
+	jwtMiddleware := middleware.JWTMiddleware(jwtManager, revocationStore, userStatusCache)
+	smtpMailer := mailer.NewSMTPMailer(cfg.Mail.Host, cfg.Mail.Port, cfg.Mail.Username, cfg.Mail.Password, cfg.Mail.From)
+	passwordResetService := service.NewPasswordResetService(userRepo, passwordResetRepo, smtpMailer, revocationStore,
+		cfg.Link("/reset-password"))
+	emailVerificationService := service.NewEmailVerificationService(userRepo, jwtManager, smtpMailer, userStatusCache,
+		cfg.Link("/verify-email"))
+	mfaService := service.NewMFAService(userRepo, mfaRepo, mfaPolicyRepo)
+	profileService := service.NewProfileService(userRepo, jwtManager, smtpMailer, revocationStore,
+		cfg.Link("/confirm-email"))
+	loginThrottle := service.NewLoginThrottleService(loginAttemptRepo, userService, auditRepo, service.LoginThrottleConfig{
+		AccountFreeAttempts: cfg.LoginThrottle.AccountFreeAttempts,
+		IPFreeAttempts:      cfg.LoginThrottle.IPFreeAttempts,
+		BaseDelay:           cfg.LoginThrottle.BaseDelay,
+		MaxDelay:            cfg.LoginThrottle.MaxDelay,
+		BlockThreshold:      cfg.LoginThrottle.BlockThreshold,
+		Window:              cfg.LoginThrottle.Window,
+	})
+	userAdminService := service.NewUserAdminService(userRepo, userService, userStatusCache, revocationStore, auditRepo)
+	statsService := service.NewStatsService(userRepo)
+	invitationService := service.NewInvitationService(userRepo, invitationRepo, smtpMailer, auditRepo,
+		cfg.Link("/accept-invitation"))
+	availabilityService := service.NewAvailabilityService(availabilityRepo, appointmentRepo, userRepo, service.AvailabilityConfig{
+		Location:                cfg.Scheduling.Location(),
+		SlotDuration:            cfg.Scheduling.SlotDuration,
+		SpecialitySlotDurations: cfg.Scheduling.SpecialitySlotDurations,
+	})
+	appointmentService := service.NewAppointmentService(appointmentRepo, userRepo, availabilityService)
+	doctorDirectoryService := service.NewDoctorDirectoryService(userRepo, availabilityService)
+	medicalRecordService := service.NewMedicalRecordService(medicalRecordRepo, appointmentRepo, userRepo)
+	clinicalNoteService := service.NewClinicalNoteService(clinicalNoteRepo, medicalRecordRepo, userRepo)
+	bootstrapAdmin(context.Background(), invitationService, cfg.BootstrapAdminEmail)
+	_ = handler.GetValidator()
+
+	e := echo.New()
+	// Login throttling is keyed by client IP, which comes from the proxy in front of the API
+	e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	e.Server.ReadTimeout = cfg.Server.ReadTimeout
+	e.Server.ReadHeaderTimeout = cfg.Server.ReadHeaderTimeout
+	e.Server.WriteTimeout = cfg.Server.WriteTimeout
+	e.Server.IdleTimeout = cfg.Server.IdleTimeout
+
+	// Let the configured browser origins, such as the frontend, call the API
+	if len(cfg.CORS.AllowedOrigins) > 0 {
+		e.Use(echomiddleware.CORSWithConfig(echomiddleware.CORSConfig{
+			AllowOrigins:     cfg.CORS.AllowedOrigins,
+			AllowCredentials: cfg.CORS.AllowCredentials,
+			MaxAge:           int(cfg.CORS.MaxAge.Seconds()),
+		}))
+	}
+
+	// Configure Swagger documentation
+	e.GET("/swagger/*", echoSwagger.WrapHandler)
+
+	// Configure health check endpoint
+	healthRegistry := healthcheck.NewRegistry(cfg.Health.CacheTTL)
+	healthRegistry.Register(healthcheck.Component{Name: "mongodb", Check: healthcheck.MongoCheck(mongoClient),
+		Timeout: cfg.Health.CheckTimeout, Critical: true})
+	healthRegistry.Register(healthcheck.Component{Name: "signing_keys", Check: func(ctx context.Context) error {
+		_, err := signingKeys.Active()
+		return err
+	}, Timeout: cfg.Health.CheckTimeout, Critical: true})
+	// Most requests don't send emails, so an unreachable SMTP server only degrades the instance
+	healthRegistry.Register(healthcheck.Component{Name: "mailer",
+		Check:   healthcheck.TCPCheck(net.JoinHostPort(cfg.Mail.Host, strconv.Itoa(cfg.Mail.Port))),
+		Timeout: cfg.Health.CheckTimeout})
+	healthHandler := handler.NewHealthHandler(healthRegistry, shutdownManager)
+	e.GET("/health", healthHandler.Check)
+	e.GET("/health/live", healthHandler.Live)
+	e.GET("/health/ready", healthHandler.Ready)
+
+	// Publish the public signing keys so other services can verify our tokens
+	jwksHandler := handler.NewJWKSHandler(signingKeys)
+	e.GET("/.well-known/jwks.json", jwksHandler.GetKeys)
+
+	// Configure routes
+	configureAuthRoutes(e, jwtManager, jwtMiddleware, userService, refreshTokenRepo, revocationStore, passwordResetService,
+		emailVerificationService, mfaService, loginThrottle, invitationService, cfg.JWT.RefreshTokenTTL)
+	configureProtectedRoutes(e, jwtMiddleware, profileService)
+	configureAdminRoutes(e, jwtMiddleware, statsService, userAdminService, emailVerificationService, mfaService, loginThrottle, auditRepo,
+		invitationService)
+	configureAppointmentRoutes(e, jwtMiddleware, appointmentService)
+	configureDoctorRoutes(e, jwtMiddleware, doctorDirectoryService, availabilityService)
+	configurePatientRoutes(e, jwtMiddleware, medicalRecordService, clinicalNoteService)
+
+	// Hooks run in order once requests are drained, so the database is disconnected last
+	shutdownManager.Register("background workers", func(ctx context.Context) error {
+		stopWorkers()
+		workersDone.Wait()
+		return nil
+	})
+	shutdownManager.Register("mongodb", mongoClient.Disconnect)
+
+	os.Exit(serve(e, cfg.Server.Address, shutdownManager))
+}
+
+// serve runs the server until SIGINT or SIGTERM, or until it fails, then shuts down gracefully
+// and returns the exit code. A second signal during the shutdown terminates the process.
+func serve(e *echo.Echo, address string, shutdownManager *shutdown.Manager) int {
+	signals, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serverErrors := make(chan error, 1)
+	go func() { serverErrors <- e.Start(address) }()
+
+	exitCode := 0
+	select {
+	case <-signals.Done():
+		slog.Info("shutdown signal received")
+	case err := <-serverErrors:
+		slog.Error("server stopped unexpectedly", slog.Any("error", err))
+		exitCode = 1
+	}
+	stop()
+
+	if err := shutdownManager.Shutdown(e); err != nil {
+		exitCode = 1
+	}
+	return exitCode
+}
+
+func configureAuthRoutes(e *echo.Echo, jwtManager domain.JWTManager, jwtMiddleware echo.MiddlewareFunc, userService domain.UserStore,
+	refreshTokenRepo domain.RefreshTokenRepository, revocationStore domain.TokenRevocationStore, passwordResetService domain.PasswordResetService,
+	emailVerificationService domain.EmailVerificationService, mfaService domain.MFAService, loginThrottle domain.LoginThrottle,
+	invitationService domain.InvitationService, refreshTokenTTL time.Duration) {
+	authService := service.NewAuthService(userService, jwtManager, refreshTokenRepo, revocationStore, emailVerificationService, mfaService,
+		loginThrottle, refreshTokenTTL)
+	authHandler := handler.NewAuthHandler(authService, passwordResetService, emailVerificationService)
+	mfaHandler := handler.NewMFAHandler(mfaService)
+	invitationHandler := handler.NewInvitationHandler(invitationService)
+
+	// Configuração das rotas de autenticação
+	v1 := e.Group("/v1")
+	v1.POST("/auth/register", authHandler.Register)
+	v1.POST("/auth/invitations/accept", invitationHandler.Accept)
+	v1.POST("/auth/login", authHandler.Login)
+	v1.POST("/auth/refresh", authHandler.Refresh)
+	v1.POST("/auth/logout", authHandler.Logout, jwtMiddleware)
+	v1.POST("/auth/logout/all", authHandler.LogoutAll, jwtMiddleware)
+	v1.POST("/auth/password/forgot", authHandler.ForgotPassword)
+	v1.POST("/auth/password/reset", authHandler.ResetPassword)
+	v1.POST("/auth/verify-email", authHandler.VerifyEmail)
+	v1.POST("/auth/verify-email/resend", authHandler.ResendVerification)
+	v1.POST("/auth/mfa/setup", authHandler.SetupMFA)
+	v1.POST("/auth/mfa/verify", authHandler.VerifyMFA)
+	v1.POST("/auth/mfa/enroll", mfaHandler.Enroll, jwtMiddleware)
+	v1.POST("/auth/mfa/confirm", mfaHandler.Confirm, jwtMiddleware)
+	v1.POST("/auth/mfa/disable", mfaHandler.Disable, jwtMiddleware)
+}
+
+func configureProtectedRoutes(e *echo.Echo, jwtMiddleware echo.MiddlewareFunc, profileService domain.ProfileService) {
+	protectedHandler := handler.NewProtectedHandler()
+	profileHandler := handler.NewProfileHandler(profileService)
+
+	// Confirmação de troca de email (autenticada pelo token do link)
+	e.POST("/v1/profile/email/confirm", profileHandler.ConfirmEmailChange)
+
+	// Configuração das rotas protegidas
+	v1 := e.Group("/v1", jwtMiddleware)
+	v1.GET("/protected", protectedHandler.GetProtectedInfo)
+
+	// Autoatendimento do perfil do usuário autenticado
+	v1.GET("/profile", profileHandler.GetProfile)
+	v1.PATCH("/profile", profileHandler.UpdateProfile)
+	v1.POST("/profile/password", profileHandler.ChangePassword)
+	v1.POST("/profile/email", profileHandler.ChangeEmail)
+}
+
+func configureAdminRoutes(e *echo.Echo, jwtMiddleware echo.MiddlewareFunc, statsService domain.StatsService, userAdminService domain.UserAdminService,
+	emailVerificationService domain.EmailVerificationService, mfaService domain.MFAService, loginThrottle domain.LoginThrottle,
+	auditRepo domain.AuditRepository, invitationService domain.InvitationService) {
+	adminHandler := handler.NewAdminHandler(statsService, userAdminService, emailVerificationService, loginThrottle, auditRepo)
+	mfaHandler := handler.NewMFAHandler(mfaService)
+	invitationHandler := handler.NewInvitationHandler(invitationService)
+
+	// Configuração das rotas de admin (protegidas)
+	v1 := e.Group("/v1", jwtMiddleware)
+
+	// Rotas específicas para admin
+	adminGroup := v1.Group("/admin", middleware.RequireRole(domain.UserTypeAdmin))
+	adminGroup.GET("/users", adminHandler.ListUsers)
+	adminGroup.POST("/users", adminHandler.CreateUser)
+	adminGroup.GET("/users/:id", adminHandler.GetUser)
+	adminGroup.PATCH("/users/:id", adminHandler.UpdateUser)
+	adminGroup.DELETE("/users/:id", adminHandler.DeleteUser)
+	adminGroup.POST("/users/:id/restore", adminHandler.RestoreUser)
+	adminGroup.POST("/invitations", invitationHandler.Invite)
+	adminGroup.GET("/stats", adminHandler.GetSystemStats)
+	adminGroup.PATCH("/users/:id/status", adminHandler.UpdateUserStatus)
+	adminGroup.POST("/users/:id/verify-email", adminHandler.VerifyUserEmail)
+	adminGroup.POST("/users/:id/unlock", adminHandler.UnlockUser)
+	adminGroup.GET("/audit-logs", adminHandler.GetAuditLogs)
+	adminGroup.GET("/mfa/policies", mfaHandler.GetPolicies)
+	adminGroup.PUT("/mfa/policies/:type", mfaHandler.SetPolicy)
+}
+
+func configureAppointmentRoutes(e *echo.Echo, jwtMiddleware echo.MiddlewareFunc, appointmentService domain.AppointmentService) {
+	appointmentHandler := handler.NewAppointmentHandler(appointmentService)
+
+	// Pacientes gerenciam as próprias consultas; recepção, médicos e admins, as de qualquer paciente
+	appointments := e.Group("/v1/appointments", jwtMiddleware,
+		middleware.RequirePermission(domain.PermissionManageAppointments, domain.PermissionManageOwnAppointments))
+	appointments.POST("", appointmentHandler.Book)
+	appointments.GET("", appointmentHandler.List)
+	appointments.GET("/:id", appointmentHandler.Get)
+	appointments.POST("/:id/reschedule", appointmentHandler.Reschedule)
+	appointments.POST("/:id/cancel", appointmentHandler.Cancel)
+
+	// Check-in e falta são registrados pela equipe
+	staff := middleware.RequirePermission(domain.PermissionManageAppointments)
+	appointments.POST("/:id/check-in", appointmentHandler.CheckIn, staff)
+	appointments.POST("/:id/no-show", appointmentHandler.NoShow, staff)
+}
+
+func configureDoctorRoutes(e *echo.Echo, jwtMiddleware echo.MiddlewareFunc, doctorDirectoryService domain.DoctorDirectoryService,
+	availabilityService domain.AvailabilityService) {
+	doctorHandler := handler.NewDoctorHandler(doctorDirectoryService)
+	availabilityHandler := handler.NewAvailabilityHandler(availabilityService)
+
+	// Qualquer usuário autenticado consulta o diretório de médicos e as vagas para agendar
+	doctors := e.Group("/v1/doctors", jwtMiddleware)
+	doctors.GET("", doctorHandler.List)
+	doctors.GET("/:id", doctorHandler.Get)
+	doctors.GET("/:id/slots", availabilityHandler.Slots)
+
+	// O médico gerencia a própria agenda; recepção e admins, a de qualquer médico
+	calendar := middleware.RequireUserType(domain.UserTypeDoctor, domain.UserTypeAdmin, domain.UserTypeReceptionist)
+	doctors.GET("/:id/availability", availabilityHandler.GetAvailability, calendar)
+	doctors.PUT("/:id/availability", availabilityHandler.SetAvailability, calendar)
+	doctors.GET("/:id/availability/exceptions", availabilityHandler.ListExceptions, calendar)
+	doctors.POST("/:id/availability/exceptions", availabilityHandler.AddException, calendar)
+	doctors.DELETE("/:id/availability/exceptions/:exceptionId", availabilityHandler.DeleteException, calendar)
+}
+
+func configurePatientRoutes(e *echo.Echo, jwtMiddleware echo.MiddlewareFunc, medicalRecordService domain.MedicalRecordService,
+	clinicalNoteService domain.ClinicalNoteService) {
+	medicalRecordHandler := handler.NewMedicalRecordHandler(medicalRecordService)
+	clinicalNoteHandler := handler.NewClinicalNoteHandler(clinicalNoteService)
+
+	// Médicos leem e escrevem prontuários, enfermeiros leem a visão básica e pacientes, o próprio
+	// prontuário. O serviço aplica o escopo de cada um.
+	records := e.Group("/v1/patients/:id/medical-record", jwtMiddleware,
+		middleware.RequirePermission(domain.PermissionViewMedicalRecords, domain.PermissionViewBasicRecords, domain.PermissionViewOwnRecords))
+	records.GET("", medicalRecordHandler.GetRecord)
+	records.GET("/entries", medicalRecordHandler.ListEntries)
+	records.POST("/entries", medicalRecordHandler.CreateEntry)
+	records.GET("/entries/:entryId", medicalRecordHandler.GetEntry)
+	records.PATCH("/entries/:entryId", medicalRecordHandler.UpdateEntry)
+	records.POST("/entries/:entryId/finalize", medicalRecordHandler.FinalizeEntry)
+
+	// Notas assinadas só mudam por adendos, que guardam todas as versões
+	records.GET("/notes", clinicalNoteHandler.ListNotes)
+	records.POST("/notes", clinicalNoteHandler.CreateNote)
+	records.GET("/notes/:noteId", clinicalNoteHandler.GetNote)
+	records.PATCH("/notes/:noteId", clinicalNoteHandler.UpdateNote)
+	records.POST("/notes/:noteId/sign", clinicalNoteHandler.SignNote)
+	records.POST("/notes/:noteId/amendments", clinicalNoteHandler.AmendNote)
+}
+
+// bootstrapAdmin invites the first admin, since staff accounts can't self-register. Nothing
+// happens when email is empty or already belongs to a user.
+func bootstrapAdmin(ctx context.Context, invitationService domain.InvitationService, email string) {
+	if email == "" {
+		return
+	}
+
+	req := domain.InviteUserRequest{Email: email, Type: domain.UserTypeAdmin}
+	if _, err := invitationService.Invite(ctx, req, domain.SystemActor); err != nil {
+		var apiErr *domain.APIError
+		if errors.As(err, &apiErr) && apiErr.Status == http.StatusConflict {
+			return
+		}
+		slog.Error("error inviting bootstrap admin", slog.Any("error", err))
+		return
+	}
+	slog.Info("bootstrap admin invited", slog.String("email", email))
+}
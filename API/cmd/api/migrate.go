@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/vida-plus/api/internal/config"
+	"github.com/vida-plus/api/internal/migrations"
+	"github.com/vida-plus/api/pkg/database"
+	"github.com/vida-plus/api/pkg/migrate"
+)
+
+const migrateUsage = `usage: api migrate <command>
+
+commands:
+  up        apply every pending migration
+  down [n]  roll back the last n applied migrations (default 1)
+  status    list the migrations and when they were applied`
+
+// runMigrate runs the migrate subcommand with args and returns the exit code
+func runMigrate(cfg config.Config, args []string, out io.Writer) int {
+	if len(args) == 0 || (args[0] != "up" && args[0] != "down" && args[0] != "status") {
+		fmt.Fprintln(out, migrateUsage)
+		return 2
+	}
+
+	steps := 1
+	if args[0] == "down" && len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			fmt.Fprintln(out, "down takes a positive number of migrations")
+			return 2
+		}
+		steps = n
+	}
+
+	mongoClient := database.InitMongoDB(cfg.Mongo.Connection())
+	defer database.DisconnectMongoDB(mongoClient)
+
+	runner, err := migrate.New(database.GetDatabase(mongoClient, cfg.Mongo.Database), migrations.All)
+	if err != nil {
+		fmt.Fprintln(out, err)
+		return 1
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := runner.Up(ctx)
+		fmt.Fprintf(out, "%d migration(s) applied\n", applied)
+		if err != nil {
+			fmt.Fprintln(out, err)
+			return 1
+		}
+	case "down":
+		rolledBack, err := runner.Down(ctx, steps)
+		fmt.Fprintf(out, "%d migration(s) rolled back\n", rolledBack)
+		if err != nil {
+			fmt.Fprintln(out, err)
+			return 1
+		}
+	case "status":
+		statuses, err := runner.Status(ctx)
+		if err != nil {
+			fmt.Fprintln(out, err)
+			return 1
+		}
+		printStatus(out, statuses)
+	}
+	return 0
+}
+
+func printStatus(out io.Writer, statuses []migrate.Status) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Local().Format("2006-01-02 15:04:05")
+		}
+		if status.Unknown {
+			appliedAt += " (not in this build)"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+	}
+	w.Flush()
+}
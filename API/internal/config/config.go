@@ -0,0 +1,424 @@
+// Package config loads the settings of the API from an optional YAML file and environment
+// variables, which take precedence, and validates them at startup.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/vida-plus/api/pkg"
+	"github.com/vida-plus/api/pkg/database"
+	"github.com/vida-plus/api/pkg/jwks"
+	"github.com/vida-plus/api/pkg/shutdown"
+)
+
+// FileEnv is the environment variable with the path of the YAML configuration file
+const FileEnv = "CONFIG_FILE"
+
+// Config holds every setting of the API. The env tag names the variable that overrides a field.
+type Config struct {
+	Server     ServerConfig     `yaml:"server"`
+	Shutdown   ShutdownConfig   `yaml:"shutdown"`
+	Health     HealthConfig     `yaml:"health"`
+	Mongo      MongoConfig      `yaml:"mongo"`
+	JWT        JWTConfig        `yaml:"jwt"`
+	CORS       CORSConfig       `yaml:"cors"`
+	Log        LogConfig        `yaml:"log"`
+	Mail       MailConfig       `yaml:"mail"`
+	Scheduling SchedulingConfig `yaml:"scheduling"`
+	// LoginThrottle controls the backoff and lockout of failed logins
+	LoginThrottle LoginThrottleConfig `yaml:"login_throttle"`
+	// FrontendURL is where the links sent by email point to
+	FrontendURL string `yaml:"frontend_url" env:"FRONTEND_URL"`
+	// MigrateOnStart applies the pending database migrations when the API starts
+	MigrateOnStart bool `yaml:"migrate_on_start" env:"MIGRATE_ON_START"`
+	// BootstrapAdminEmail is invited as the first admin when set
+	BootstrapAdminEmail string `yaml:"bootstrap_admin_email" env:"BOOTSTRAP_ADMIN_EMAIL"`
+}
+
+// ServerConfig controls the HTTP server
+type ServerConfig struct {
+	Address           string        `yaml:"address" env:"SERVER_ADDRESS"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"SERVER_READ_TIMEOUT"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"SERVER_READ_HEADER_TIMEOUT"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"SERVER_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"SERVER_IDLE_TIMEOUT"`
+}
+
+// ShutdownConfig controls how long each phase of the graceful shutdown may take
+type ShutdownConfig struct {
+	ReadinessDelay time.Duration `yaml:"readiness_delay" env:"SHUTDOWN_READINESS_DELAY"`
+	DrainTimeout   time.Duration `yaml:"drain_timeout" env:"SHUTDOWN_DRAIN_TIMEOUT"`
+	HookTimeout    time.Duration `yaml:"hook_timeout" env:"SHUTDOWN_HOOK_TIMEOUT"`
+}
+
+// HealthConfig controls the checks of the readiness endpoint
+type HealthConfig struct {
+	// CacheTTL is how long a check result is reused
+	CacheTTL time.Duration `yaml:"cache_ttl" env:"HEALTH_CACHE_TTL"`
+	// CheckTimeout bounds each component check
+	CheckTimeout time.Duration `yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT"`
+}
+
+// MongoConfig controls the MongoDB connection
+type MongoConfig struct {
+	URI             string        `yaml:"uri" env:"MONGO_URI"`
+	Database        string        `yaml:"database" env:"MONGO_DATABASE"`
+	ConnectTimeout  time.Duration `yaml:"connect_timeout" env:"MONGO_CONNECT_TIMEOUT"`
+	MaxPoolSize     uint64        `yaml:"max_pool_size" env:"MONGO_MAX_POOL_SIZE"`
+	MinPoolSize     uint64        `yaml:"min_pool_size" env:"MONGO_MIN_POOL_SIZE"`
+	MaxConnIdleTime time.Duration `yaml:"max_conn_idle_time" env:"MONGO_MAX_CONN_IDLE_TIME"`
+}
+
+// JWTConfig controls the claims and lifetime of tokens and where their signing keys come from
+type JWTConfig struct {
+	Issuer          string        `yaml:"issuer" env:"JWT_ISSUER"`
+	Audience        string        `yaml:"audience" env:"JWT_AUDIENCE"`
+	ClockSkew       time.Duration `yaml:"clock_skew" env:"JWT_CLOCK_SKEW"`
+	AccessTokenTTL  time.Duration `yaml:"access_token_ttl" env:"JWT_ACCESS_TOKEN_TTL"`
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl" env:"JWT_REFRESH_TOKEN_TTL"`
+
+	KeysDir          string        `yaml:"keys_dir" env:"JWT_KEYS_DIR"`
+	PrivateKey       string        `yaml:"private_key" env:"JWT_PRIVATE_KEY"`
+	PrivateKeyFile   string        `yaml:"private_key_file" env:"JWT_PRIVATE_KEY_FILE"`
+	KeyID            string        `yaml:"key_id" env:"JWT_KEY_ID"`
+	KeyAlgorithm     string        `yaml:"key_algorithm" env:"JWT_KEY_ALGORITHM"`
+	RotationInterval time.Duration `yaml:"key_rotation_interval" env:"JWT_KEY_ROTATION_INTERVAL"`
+	GracePeriod      time.Duration `yaml:"key_grace_period" env:"JWT_KEY_GRACE_PERIOD"`
+	// GenerateKeys allows keys generated in memory when neither KeysDir nor PrivateKey is set.
+	// They are lost on restart and differ between replicas, so it is only meant for development.
+	GenerateKeys bool `yaml:"generate_keys" env:"JWT_GENERATE_KEYS"`
+}
+
+// CORSConfig controls which browser origins can call the API
+type CORSConfig struct {
+	// AllowedOrigins disables CORS when empty, and "*" allows any origin
+	AllowedOrigins   []string      `yaml:"allowed_origins" env:"CORS_ALLOWED_ORIGINS"`
+	AllowCredentials bool          `yaml:"allow_credentials" env:"CORS_ALLOW_CREDENTIALS"`
+	MaxAge           time.Duration `yaml:"max_age" env:"CORS_MAX_AGE"`
+}
+
+// LogConfig controls the default slog logger
+type LogConfig struct {
+	// Level is debug, info, warn or error
+	Level string `yaml:"level" env:"LOG_LEVEL"`
+	// Format is text or json
+	Format string `yaml:"format" env:"LOG_FORMAT"`
+}
+
+// MailConfig controls the SMTP server emails are sent through
+type MailConfig struct {
+	Host     string `yaml:"host" env:"SMTP_HOST"`
+	Port     int    `yaml:"port" env:"SMTP_PORT"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+	From     string `yaml:"from" env:"SMTP_FROM"`
+}
+
+// SchedulingConfig controls the calendars of the doctors
+type SchedulingConfig struct {
+	// Timezone of the clinic, in which working hours and dates are given
+	Timezone string `yaml:"timezone" env:"CLINIC_TIMEZONE"`
+	// SlotDuration is the length of the slots of doctors whose speciality has none of its own
+	SlotDuration time.Duration `yaml:"slot_duration" env:"SLOT_DURATION"`
+	// SpecialitySlotDurations maps specialities, ignoring case, to the length of their slots
+	SpecialitySlotDurations map[string]time.Duration `yaml:"speciality_slot_durations"`
+}
+
+// LoginThrottleConfig controls how failed logins are delayed and when accounts are blocked
+type LoginThrottleConfig struct {
+	// AccountFreeAttempts and IPFreeAttempts are the failures allowed before delays start
+	AccountFreeAttempts int `yaml:"account_free_attempts" env:"LOGIN_ACCOUNT_FREE_ATTEMPTS"`
+	IPFreeAttempts      int `yaml:"ip_free_attempts" env:"LOGIN_IP_FREE_ATTEMPTS"`
+	// BaseDelay doubles for every failure past the free attempts, up to MaxDelay
+	BaseDelay time.Duration `yaml:"base_delay" env:"LOGIN_BASE_DELAY"`
+	MaxDelay  time.Duration `yaml:"max_delay" env:"LOGIN_MAX_DELAY"`
+	// BlockThreshold is the number of failures that blocks the account until an admin unlocks it
+	BlockThreshold int `yaml:"block_threshold" env:"LOGIN_BLOCK_THRESHOLD"`
+	// Window is how long failures are remembered after the last one
+	Window time.Duration `yaml:"window" env:"LOGIN_ATTEMPT_WINDOW"`
+}
+
+// Default returns the configuration used for local development with docker-compose.
+func Default() Config {
+	tokens := pkg.DefaultJWTConfig()
+	keys := jwks.DefaultConfig()
+	shutdownConfig := shutdown.DefaultConfig()
+
+	return Config{
+		Server: ServerConfig{
+			Address:           ":8080",
+			ReadTimeout:       15 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+		},
+		Shutdown: ShutdownConfig{
+			ReadinessDelay: shutdownConfig.ReadinessDelay,
+			DrainTimeout:   shutdownConfig.DrainTimeout,
+			HookTimeout:    shutdownConfig.HookTimeout,
+		},
+		Health: HealthConfig{
+			CacheTTL:     5 * time.Second,
+			CheckTimeout: 2 * time.Second,
+		},
+		Mongo: MongoConfig{
+			URI:             "mongodb://localhost:27017",
+			Database:        "vida_plus",
+			ConnectTimeout:  10 * time.Second,
+			MaxPoolSize:     100,
+			MaxConnIdleTime: 5 * time.Minute,
+		},
+		JWT: JWTConfig{
+			Issuer:           tokens.Issuer,
+			Audience:         tokens.Audience,
+			ClockSkew:        tokens.ClockSkew,
+			AccessTokenTTL:   tokens.AccessTokenTTL,
+			RefreshTokenTTL:  tokens.RefreshTokenTTL,
+			KeyAlgorithm:     keys.Algorithm,
+			RotationInterval: keys.RotationInterval,
+			GracePeriod:      keys.GracePeriod,
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: []string{"http://localhost:5173"},
+			MaxAge:         time.Hour,
+		},
+		Log: LogConfig{Level: "info", Format: "text"},
+		Mail: MailConfig{
+			Host: "localhost",
+			Port: 1025,
+			From: "Vida Plus <no-reply@vidaplus.com>",
+		},
+		Scheduling: SchedulingConfig{
+			Timezone:     "America/Sao_Paulo",
+			SlotDuration: 30 * time.Minute,
+		},
+		LoginThrottle: LoginThrottleConfig{
+			AccountFreeAttempts: 3,
+			IPFreeAttempts:      20,
+			BaseDelay:           time.Second,
+			MaxDelay:            15 * time.Minute,
+			BlockThreshold:      10,
+			Window:              24 * time.Hour,
+		},
+		FrontendURL:    "http://localhost:5173",
+		MigrateOnStart: true,
+	}
+}
+
+// Load reads the file named by CONFIG_FILE, if any, over the defaults, then the environment
+// variables over both, and validates the result.
+func Load() (Config, error) {
+	cfg := Default()
+
+	if path := os.Getenv(FileEnv); path != "" {
+		if err := cfg.readFile(path); err != nil {
+			return cfg, err
+		}
+	}
+	if err := applyEnv(&cfg, os.LookupEnv); err != nil {
+		return cfg, err
+	}
+	if cfg.JWT.PrivateKeyFile != "" && cfg.JWT.PrivateKey == "" {
+		data, err := os.ReadFile(cfg.JWT.PrivateKeyFile)
+		if err != nil {
+			return cfg, fmt.Errorf("reading JWT private key file: %w", err)
+		}
+		cfg.JWT.PrivateKey = string(data)
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// readFile decodes the YAML file at path into cfg, keeping the fields it doesn't set
+func (c *Config) readFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening config file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate checks every setting and reports all the invalid ones together.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Server.Address != "", "server.address is required")
+	for _, timeout := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"server.read_timeout", c.Server.ReadTimeout},
+		{"server.read_header_timeout", c.Server.ReadHeaderTimeout},
+		{"server.write_timeout", c.Server.WriteTimeout},
+		{"server.idle_timeout", c.Server.IdleTimeout},
+		{"shutdown.readiness_delay", c.Shutdown.ReadinessDelay},
+		{"health.cache_ttl", c.Health.CacheTTL},
+		{"mongo.max_conn_idle_time", c.Mongo.MaxConnIdleTime},
+		{"cors.max_age", c.CORS.MaxAge},
+	} {
+		check(timeout.value >= 0, "%s can't be negative", timeout.name)
+	}
+
+	check(c.Shutdown.DrainTimeout > 0 && c.Shutdown.HookTimeout > 0, "shutdown.drain_timeout and shutdown.hook_timeout must be positive")
+
+	check(c.Health.CheckTimeout > 0, "health.check_timeout must be positive")
+
+	check(strings.HasPrefix(c.Mongo.URI, "mongodb://") || strings.HasPrefix(c.Mongo.URI, "mongodb+srv://"),
+		"mongo.uri must start with mongodb:// or mongodb+srv://")
+	check(c.Mongo.Database != "", "mongo.database is required")
+	check(c.Mongo.ConnectTimeout > 0, "mongo.connect_timeout must be positive")
+	check(c.Mongo.MaxPoolSize == 0 || c.Mongo.MinPoolSize <= c.Mongo.MaxPoolSize,
+		"mongo.min_pool_size can't exceed mongo.max_pool_size")
+
+	check(c.JWT.Issuer != "" && c.JWT.Audience != "", "jwt.issuer and jwt.audience are required")
+	check(c.JWT.ClockSkew >= 0, "jwt.clock_skew can't be negative")
+	check(c.JWT.AccessTokenTTL > 0 && c.JWT.RefreshTokenTTL > 0, "jwt.access_token_ttl and jwt.refresh_token_ttl must be positive")
+	check(c.JWT.KeyAlgorithm == jwks.AlgorithmEdDSA || c.JWT.KeyAlgorithm == jwks.AlgorithmRS256,
+		"jwt.key_algorithm must be %s or %s", jwks.AlgorithmEdDSA, jwks.AlgorithmRS256)
+	check(!c.JWT.Keys().Generated() || c.JWT.GenerateKeys,
+		"jwt.keys_dir or jwt.private_key is required, or jwt.generate_keys for development")
+	check(c.JWT.RotationInterval > 0, "jwt.key_rotation_interval must be positive")
+	// Replaced keys must keep verifying every token they signed
+	check(c.JWT.GracePeriod >= c.JWT.AccessTokenTTL && c.JWT.GracePeriod >= c.JWT.RefreshTokenTTL,
+		"jwt.key_grace_period must cover jwt.access_token_ttl and jwt.refresh_token_ttl")
+
+	for _, origin := range c.CORS.AllowedOrigins {
+		check(origin == "*" || isAbsoluteURL(origin), "cors.allowed_origins has an invalid origin %q", origin)
+		check(origin != "*" || !c.CORS.AllowCredentials, "cors.allow_credentials can't be used with the * origin")
+	}
+
+	var level slog.Level
+	check(level.UnmarshalText([]byte(c.Log.Level)) == nil, "log.level must be debug, info, warn or error")
+	check(c.Log.Format == "text" || c.Log.Format == "json", "log.format must be text or json")
+
+	check(c.Mail.Host != "", "mail.host is required")
+	check(c.Mail.Port > 0 && c.Mail.Port <= 65535, "mail.port must be between 1 and 65535")
+	check(c.Mail.From != "", "mail.from is required")
+
+	_, err := time.LoadLocation(c.Scheduling.Timezone)
+	check(c.Scheduling.Timezone != "" && err == nil, "scheduling.timezone must be an IANA timezone such as America/Sao_Paulo")
+	check(c.Scheduling.SlotDuration > 0, "scheduling.slot_duration must be positive")
+	specialities := make([]string, 0, len(c.Scheduling.SpecialitySlotDurations))
+	for speciality := range c.Scheduling.SpecialitySlotDurations {
+		specialities = append(specialities, speciality)
+	}
+	// Specialities are matched ignoring case, so two keys may not name the same one
+	slices.Sort(specialities)
+	seen := make(map[string]string, len(specialities))
+	for _, speciality := range specialities {
+		check(c.Scheduling.SpecialitySlotDurations[speciality] > 0,
+			"scheduling.speciality_slot_durations of %q must be positive", speciality)
+		key := strings.ToLower(strings.TrimSpace(speciality))
+		other, duplicate := seen[key]
+		check(!duplicate, "scheduling.speciality_slot_durations has both %q and %q", other, speciality)
+		seen[key] = speciality
+	}
+
+	check(c.LoginThrottle.AccountFreeAttempts >= 0 && c.LoginThrottle.IPFreeAttempts >= 0,
+		"login_throttle.account_free_attempts and login_throttle.ip_free_attempts can't be negative")
+	check(c.LoginThrottle.BlockThreshold > 0, "login_throttle.block_threshold must be positive")
+	check(c.LoginThrottle.BaseDelay > 0 && c.LoginThrottle.MaxDelay > 0,
+		"login_throttle.base_delay and login_throttle.max_delay must be positive")
+	check(c.LoginThrottle.MaxDelay >= c.LoginThrottle.BaseDelay, "login_throttle.max_delay can't be less than login_throttle.base_delay")
+	check(c.LoginThrottle.Window > 0, "login_throttle.window must be positive")
+
+	check(isAbsoluteURL(c.FrontendURL), "frontend_url must be an absolute URL")
+
+	return errors.Join(errs...)
+}
+
+// Timeouts returns the durations of the phases of the shutdown
+func (c ShutdownConfig) Timeouts() shutdown.Config {
+	return shutdown.Config{
+		ReadinessDelay: c.ReadinessDelay,
+		DrainTimeout:   c.DrainTimeout,
+		HookTimeout:    c.HookTimeout,
+	}
+}
+
+// Connection returns the settings of the MongoDB client
+func (c MongoConfig) Connection() database.MongoConfig {
+	return database.MongoConfig{
+		URI:             c.URI,
+		ConnectTimeout:  c.ConnectTimeout,
+		MaxPoolSize:     c.MaxPoolSize,
+		MinPoolSize:     c.MinPoolSize,
+		MaxConnIdleTime: c.MaxConnIdleTime,
+	}
+}
+
+// Tokens returns the claims and lifetimes of the tokens issued by the JWT manager
+func (c JWTConfig) Tokens() pkg.JWTConfig {
+	return pkg.JWTConfig{
+		Issuer:          c.Issuer,
+		Audience:        c.Audience,
+		ClockSkew:       c.ClockSkew,
+		AccessTokenTTL:  c.AccessTokenTTL,
+		RefreshTokenTTL: c.RefreshTokenTTL,
+	}
+}
+
+// Keys returns where the signing keys come from and how they rotate
+func (c JWTConfig) Keys() jwks.Config {
+	keys := jwks.DefaultConfig()
+	keys.Dir = c.KeysDir
+	keys.PrivateKey = c.PrivateKey
+	keys.KeyID = c.KeyID
+	keys.Algorithm = c.KeyAlgorithm
+	keys.RotationInterval = c.RotationInterval
+	keys.GracePeriod = c.GracePeriod
+	return keys
+}
+
+// Logger returns a logger writing to w at the configured level and format
+func (c LogConfig) Logger(w io.Writer) *slog.Logger {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(c.Level))
+
+	options := &slog.HandlerOptions{Level: level}
+	if c.Format == "json" {
+		return slog.New(slog.NewJSONHandler(w, options))
+	}
+	return slog.New(slog.NewTextHandler(w, options))
+}
+
+// Location returns the timezone of the clinic, which Validate checks
+func (c SchedulingConfig) Location() *time.Location {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Link returns the frontend URL of path, such as the page that resets a password
+func (c Config) Link(path string) string {
+	return strings.TrimSuffix(c.FrontendURL, "/") + path
+}
+
+func isAbsoluteURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validConfig returns the defaults with generated signing keys allowed
+func validConfig() Config {
+	cfg := Default()
+	cfg.JWT.GenerateKeys = true
+	return cfg
+}
+
+func Test_Default(t *testing.T) {
+	assert.NoError(t, validConfig().Validate())
+}
+
+func Test_Config_Validate_signingKeys(t *testing.T) {
+	// Keys generated by each replica would reject the tokens of the others
+	cfg := Default()
+	assert.Error(t, cfg.Validate())
+
+	cfg.JWT.KeysDir = "/etc/vida-plus/keys"
+	assert.NoError(t, cfg.Validate())
+}
+
+func Test_Load(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+server:
+  address: ":9090"
+  write_timeout: 1m
+mongo:
+  uri: mongodb://mongo:27017
+  max_pool_size: 50
+cors:
+  allowed_origins: [https://app.vidaplus.com]
+log:
+  format: json
+scheduling:
+  speciality_slot_durations:
+    Psiquiatria: 50m
+`), 0o600))
+
+	t.Setenv(FileEnv, file)
+	t.Setenv("MONGO_URI", "mongodb://replica:27017")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://a.vidaplus.com, https://b.vidaplus.com")
+	t.Setenv("MIGRATE_ON_START", "false")
+	t.Setenv("JWT_ACCESS_TOKEN_TTL", "15m")
+	t.Setenv("CLINIC_TIMEZONE", "America/Manaus")
+	t.Setenv("JWT_GENERATE_KEYS", "true")
+	t.Setenv("LOGIN_BLOCK_THRESHOLD", "5")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	// From the file
+	assert.Equal(t, ":9090", cfg.Server.Address)
+	assert.Equal(t, time.Minute, cfg.Server.WriteTimeout)
+	assert.Equal(t, uint64(50), cfg.Mongo.MaxPoolSize)
+	assert.Equal(t, "json", cfg.Log.Format)
+	assert.Equal(t, map[string]time.Duration{"Psiquiatria": 50 * time.Minute}, cfg.Scheduling.SpecialitySlotDurations)
+	// The environment wins over the file
+	assert.Equal(t, "mongodb://replica:27017", cfg.Mongo.URI)
+	assert.Equal(t, []string{"https://a.vidaplus.com", "https://b.vidaplus.com"}, cfg.CORS.AllowedOrigins)
+	assert.False(t, cfg.MigrateOnStart)
+	assert.Equal(t, 15*time.Minute, cfg.JWT.AccessTokenTTL)
+	assert.Equal(t, "America/Manaus", cfg.Scheduling.Location().String())
+	assert.Equal(t, 5, cfg.LoginThrottle.BlockThreshold)
+	// Defaults fill the rest
+	assert.Equal(t, "vida_plus", cfg.Mongo.Database)
+	assert.Equal(t, 15*time.Second, cfg.Server.ReadTimeout)
+}
+
+func Test_Load_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		env  map[string]string
+	}{
+		{"UNKNOWN_FIELD", "server:\n  adress: \":9090\"\n", nil},
+		{"INVALID_DURATION", "", map[string]string{"SERVER_READ_TIMEOUT": "soon"}},
+		{"INVALID_NUMBER", "", map[string]string{"SMTP_PORT": "smtp"}},
+		{"INVALID_VALUE", "", map[string]string{"LOG_LEVEL": "verbose"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.file != "" {
+				file := filepath.Join(t.TempDir(), "config.yaml")
+				require.NoError(t, os.WriteFile(file, []byte(tt.file), 0o600))
+				t.Setenv(FileEnv, file)
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			_, err := Load()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_Config_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+	}{
+		{"ADDRESS", func(cfg *Config) { cfg.Server.Address = "" }},
+		{"NEGATIVE_TIMEOUT", func(cfg *Config) { cfg.Server.IdleTimeout = -time.Second }},
+		{"DRAIN_TIMEOUT", func(cfg *Config) { cfg.Shutdown.DrainTimeout = 0 }},
+		{"MONGO_URI", func(cfg *Config) { cfg.Mongo.URI = "localhost:27017" }},
+		{"POOL_SIZE", func(cfg *Config) { cfg.Mongo.MinPoolSize = 200 }},
+		{"TOKEN_TTL", func(cfg *Config) { cfg.JWT.AccessTokenTTL = 0 }},
+		{"KEY_ALGORITHM", func(cfg *Config) { cfg.JWT.KeyAlgorithm = "HS256" }},
+		{"GRACE_PERIOD", func(cfg *Config) { cfg.JWT.RefreshTokenTTL = 30 * 24 * time.Hour }},
+		{"CORS_ORIGIN", func(cfg *Config) { cfg.CORS.AllowedOrigins = []string{"vidaplus.com"} }},
+		{"CORS_CREDENTIALS", func(cfg *Config) {
+			cfg.CORS.AllowedOrigins = []string{"*"}
+			cfg.CORS.AllowCredentials = true
+		}},
+		{"LOG_FORMAT", func(cfg *Config) { cfg.Log.Format = "xml" }},
+		{"SMTP_PORT", func(cfg *Config) { cfg.Mail.Port = 0 }},
+		{"TIMEZONE", func(cfg *Config) { cfg.Scheduling.Timezone = "Brasilia" }},
+		{"SLOT_DURATION", func(cfg *Config) {
+			cfg.Scheduling.SpecialitySlotDurations = map[string]time.Duration{"Cardiologia": 0}
+		}},
+		{"DUPLICATE_SPECIALITY", func(cfg *Config) {
+			cfg.Scheduling.SpecialitySlotDurations = map[string]time.Duration{"Psiquiatria": 50 * time.Minute, "psiquiatria ": time.Hour}
+		}},
+		{"BLOCK_THRESHOLD", func(cfg *Config) { cfg.LoginThrottle.BlockThreshold = 0 }},
+		{"LOGIN_DELAY", func(cfg *Config) { cfg.LoginThrottle.BaseDelay = 0 }},
+		{"LOGIN_MAX_DELAY", func(cfg *Config) { cfg.LoginThrottle.MaxDelay = cfg.LoginThrottle.BaseDelay / 2 }},
+		{"FRONTEND_URL", func(cfg *Config) { cfg.FrontendURL = "/app" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.modify(&cfg)
+			assert.Error(t, cfg.Validate())
+		})
+	}
+}
+
+func Test_Config_Link(t *testing.T) {
+	cfg := validConfig()
+	cfg.FrontendURL = "https://app.vidaplus.com/"
+	assert.Equal(t, "https://app.vidaplus.com/reset-password", cfg.Link("/reset-password"))
+}
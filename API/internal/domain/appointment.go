@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// AppointmentStatus represents the state of an appointment
+type AppointmentStatus string
+
+const (
+	AppointmentStatusBooked      AppointmentStatus = "booked"
+	AppointmentStatusRescheduled AppointmentStatus = "rescheduled" // booked, then moved to another time
+	AppointmentStatusCancelled   AppointmentStatus = "cancelled"
+	AppointmentStatusCheckedIn   AppointmentStatus = "checked_in" // the patient arrived
+	AppointmentStatusNoShow      AppointmentStatus = "no_show"
+)
+
+// AppointmentStatuses lists every valid appointment status
+var AppointmentStatuses = []AppointmentStatus{
+	AppointmentStatusBooked,
+	AppointmentStatusRescheduled,
+	AppointmentStatusCancelled,
+	AppointmentStatusCheckedIn,
+	AppointmentStatusNoShow,
+}
+
+// appointmentTransitions lists the statuses each status can change to. Cancelled, checked-in
+// and no-show appointments are final.
+var appointmentTransitions = map[AppointmentStatus][]AppointmentStatus{
+	AppointmentStatusBooked: {AppointmentStatusRescheduled, AppointmentStatusCancelled, AppointmentStatusCheckedIn,
+		AppointmentStatusNoShow},
+	AppointmentStatusRescheduled: {AppointmentStatusRescheduled, AppointmentStatusCancelled, AppointmentStatusCheckedIn,
+		AppointmentStatusNoShow},
+}
+
+// IsValid checks if the status is one of AppointmentStatuses
+func (s AppointmentStatus) IsValid() bool {
+	return slices.Contains(AppointmentStatuses, s)
+}
+
+// CanChangeTo reports whether an appointment with status s can move to next
+func (s AppointmentStatus) CanChangeTo(next AppointmentStatus) bool {
+	return slices.Contains(appointmentTransitions[s], next)
+}
+
+// AppointmentStatusesFrom returns the statuses that can change to next
+func AppointmentStatusesFrom(next AppointmentStatus) []AppointmentStatus {
+	var from []AppointmentStatus
+	for _, status := range AppointmentStatuses {
+		if status.CanChangeTo(next) {
+			from = append(from, status)
+		}
+	}
+	return from
+}
+
+// BlockingAppointmentStatuses are the statuses whose appointments take up the time of the doctor
+var BlockingAppointmentStatuses = []AppointmentStatus{
+	AppointmentStatusBooked,
+	AppointmentStatusRescheduled,
+	AppointmentStatusCheckedIn,
+}
+
+// Appointment is a visit of a patient to a doctor from StartsAt until EndsAt.
+type Appointment struct {
+	ID        string            `bson:"_id" json:"id"`
+	PatientID string            `bson:"patient_id" json:"patient_id"`
+	DoctorID  string            `bson:"doctor_id" json:"doctor_id"`
+	StartsAt  time.Time         `bson:"starts_at" json:"starts_at"`
+	EndsAt    time.Time         `bson:"ends_at" json:"ends_at"`
+	Status    AppointmentStatus `bson:"status" json:"status"`
+	Reason    string            `bson:"reason,omitempty" json:"reason,omitempty"` // why the patient is coming
+	BookedBy  string            `bson:"booked_by" json:"booked_by"`
+	CreatedAt time.Time         `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time         `bson:"updated_at" json:"updated_at"`
+	// History records every change of status, oldest first
+	History []AppointmentChange `bson:"history" json:"history"`
+}
+
+// AppointmentChange is an entry of the history of an appointment. Reschedules keep the
+// previous time.
+type AppointmentChange struct {
+	Status       AppointmentStatus `bson:"status" json:"status"`
+	ChangedBy    string            `bson:"changed_by" json:"changed_by"`
+	ChangedAt    time.Time         `bson:"changed_at" json:"changed_at"`
+	Reason       string            `bson:"reason,omitempty" json:"reason,omitempty"`
+	PrevStartsAt *time.Time        `bson:"prev_starts_at,omitempty" json:"prev_starts_at,omitempty"`
+	PrevEndsAt   *time.Time        `bson:"prev_ends_at,omitempty" json:"prev_ends_at,omitempty"`
+}
+
+// Overlaps reports whether the appointment takes up any of the time from start until end
+func (a *Appointment) Overlaps(start, end time.Time) bool {
+	return a.StartsAt.Before(end) && start.Before(a.EndsAt)
+}
+
+// AppointmentFilter narrows the appointments returned by AppointmentRepository.List. Empty fields
+// match everything.
+type AppointmentFilter struct {
+	PatientID string            `query:"patient_id"`
+	DoctorID  string            `query:"doctor_id"`
+	Status    AppointmentStatus `query:"status" validate:"omitempty,oneof=booked rescheduled cancelled checked_in no_show"`
+	From      *time.Time        `query:"from"` // starting at or after, RFC 3339
+	To        *time.Time        `query:"to"`   // starting before, RFC 3339
+}
+
+// AppointmentPage is a page of the appointment list
+type AppointmentPage = Page[*Appointment]
+
+// AppointmentSortFields are the fields appointments can be sorted by
+var AppointmentSortFields = []string{"starts_at", "created_at"}
+
+// BookAppointmentRequest represents the request structure for booking an appointment.
+type BookAppointmentRequest struct {
+	// PatientID is required when staff book for a patient. Patients book for themselves.
+	PatientID string    `json:"patient_id,omitempty" example:"9f86d081884c7d659a2feaa0c55ad015"`
+	DoctorID  string    `json:"doctor_id" validate:"required" example:"2c26b46b68ffc68ff99b453c1d304134"`
+	StartsAt  time.Time `json:"starts_at" validate:"required" example:"2026-11-03T14:00:00-03:00"`
+	EndsAt    time.Time `json:"ends_at" validate:"required,gtfield=StartsAt" example:"2026-11-03T14:30:00-03:00"`
+	Reason    string    `json:"reason,omitempty" validate:"max=500" example:"Dor de cabeça recorrente"`
+}
+
+// RescheduleAppointmentRequest represents the request structure for moving an appointment.
+type RescheduleAppointmentRequest struct {
+	StartsAt time.Time `json:"starts_at" validate:"required" example:"2026-11-05T09:00:00-03:00"`
+	EndsAt   time.Time `json:"ends_at" validate:"required,gtfield=StartsAt" example:"2026-11-05T09:30:00-03:00"`
+	Reason   string    `json:"reason,omitempty" validate:"max=500" example:"Paciente pediu outro horário"`
+}
+
+// CancelAppointmentRequest represents the request structure for cancelling an appointment.
+type CancelAppointmentRequest struct {
+	Reason string `json:"reason" validate:"required,max=500" example:"Paciente viajou"`
+}
+
+// AppointmentService defines appointment scheduling. Patients with manage_own_appointments only
+// see and change their own appointments, while users with manage_appointments manage anyone's.
+type AppointmentService interface {
+	// Book schedules an appointment, failing with a conflict when the doctor already has one
+	// at that time.
+	Book(ctx context.Context, req BookAppointmentRequest, actor *AuthClaims) (*Appointment, error)
+	Get(ctx context.Context, id string, actor *AuthClaims) (*Appointment, error)
+	// List returns a page of the appointments matching filter, soonest first unless query sorts
+	// by one of AppointmentSortFields.
+	List(ctx context.Context, filter AppointmentFilter, query ListQuery, actor *AuthClaims) (*AppointmentPage, error)
+	// Reschedule moves an appointment to another time of the same doctor.
+	Reschedule(ctx context.Context, id string, req RescheduleAppointmentRequest, actor *AuthClaims) (*Appointment, error)
+	Cancel(ctx context.Context, id string, req CancelAppointmentRequest, actor *AuthClaims) (*Appointment, error)
+	// CheckIn records that the patient arrived. Only staff can check patients in.
+	CheckIn(ctx context.Context, id string, actor *AuthClaims) (*Appointment, error)
+	// NoShow records that the patient didn't come, once the appointment has started. Only staff
+	// can mark no-shows.
+	NoShow(ctx context.Context, id string, actor *AuthClaims) (*Appointment, error)
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AppointmentStatus_CanChangeTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from AppointmentStatus
+		to   AppointmentStatus
+		want bool
+	}{
+		{"BOOKED TO CANCELLED", AppointmentStatusBooked, AppointmentStatusCancelled, true},
+		{"BOOKED TO RESCHEDULED", AppointmentStatusBooked, AppointmentStatusRescheduled, true},
+		{"RESCHEDULED AGAIN", AppointmentStatusRescheduled, AppointmentStatusRescheduled, true},
+		{"RESCHEDULED TO CHECKED IN", AppointmentStatusRescheduled, AppointmentStatusCheckedIn, true},
+		{"BOOKED TO NO SHOW", AppointmentStatusBooked, AppointmentStatusNoShow, true},
+		{"BOOKED TO BOOKED", AppointmentStatusBooked, AppointmentStatusBooked, false},
+		{"CANCELLED IS FINAL", AppointmentStatusCancelled, AppointmentStatusBooked, false},
+		{"CHECKED IN IS FINAL", AppointmentStatusCheckedIn, AppointmentStatusNoShow, false},
+		{"NO SHOW IS FINAL", AppointmentStatusNoShow, AppointmentStatusRescheduled, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.from.CanChangeTo(tt.to))
+		})
+	}
+}
+
+func Test_AppointmentStatusesFrom(t *testing.T) {
+	assert.Equal(t, []AppointmentStatus{AppointmentStatusBooked, AppointmentStatusRescheduled},
+		AppointmentStatusesFrom(AppointmentStatusCancelled))
+	assert.Empty(t, AppointmentStatusesFrom(AppointmentStatusBooked))
+}
+
+func Test_Appointment_Overlaps(t *testing.T) {
+	start := time.Date(2026, 11, 3, 14, 0, 0, 0, time.UTC)
+	appointment := &Appointment{StartsAt: start, EndsAt: start.Add(30 * time.Minute)}
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  bool
+	}{
+		{"SAME TIME", start, start.Add(30 * time.Minute), true},
+		{"STARTS DURING", start.Add(15 * time.Minute), start.Add(45 * time.Minute), true},
+		{"CONTAINS", start.Add(-time.Hour), start.Add(time.Hour), true},
+		{"ENDS WHEN IT STARTS", start.Add(-30 * time.Minute), start, false},
+		{"STARTS WHEN IT ENDS", start.Add(30 * time.Minute), start.Add(time.Hour), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, appointment.Overlaps(tt.start, tt.end))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// SystemActor identifies changes made automatically by the system rather than a user
+const SystemActor = "system"
+
+// AuditAction identifies the kind of event recorded in the audit trail
+type AuditAction string
+
+const (
+	AuditActionLoginLocked     AuditAction = "login.locked"     // failed attempts triggered a temporary lock
+	AuditActionAccountLocked   AuditAction = "account.locked"   // failed attempts blocked the account
+	AuditActionAccountUnlocked AuditAction = "account.unlocked" // an admin cleared a lockout
+	AuditActionUserCreated     AuditAction = "user.created"
+	AuditActionUserUpdated     AuditAction = "user.updated"
+	AuditActionStatusChanged   AuditAction = "user.status_changed"
+	AuditActionUserDeleted     AuditAction = "user.deleted"
+	AuditActionUserRestored    AuditAction = "user.restored"
+	AuditActionUserInvited     AuditAction = "user.invited"
+	AuditActionInviteAccepted  AuditAction = "user.invitation_accepted"
+)
+
+// AuditEvent represents an entry of the audit trail.
+type AuditEvent struct {
+	ID        string            `bson:"_id" json:"id"`
+	Action    AuditAction       `bson:"action" json:"action"`
+	ActorID   string            `bson:"actor_id" json:"actor_id"`
+	TargetID  string            `bson:"target_id,omitempty" json:"target_id,omitempty"`
+	IP        string            `bson:"ip,omitempty" json:"ip,omitempty"`
+	Details   map[string]string `bson:"details,omitempty" json:"details,omitempty"`
+	CreatedAt time.Time         `bson:"created_at" json:"created_at"`
+}
+
+// AuditFilter narrows the events returned from the audit trail. Empty fields match everything.
+type AuditFilter struct {
+	TargetID string      `query:"target_id"`
+	ActorID  string      `query:"actor_id"`
+	Action   AuditAction `query:"action"`
+}
@@ -4,17 +4,47 @@ package domain
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
 var ErrUnauthorized = errors.New("unauthorized access")
 
-// AuthClaims represents JWT claims for authentication.
+// TokenType tells apart the kinds of tokens signed by the API, so one kind can't be used as another
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+	TokenTypeScoped  TokenType = "scoped"
+)
+
+// AuthClaims represents JWT claims for authentication. The subject claim carries the user ID.
 type AuthClaims struct {
-	UserID   string   `json:"user_id"`
-	Email    string   `json:"email"`
-	UserType UserType `json:"user_type"`
+	Type     TokenType `json:"typ"`
+	UserID   string    `json:"user_id"`
+	Email    string    `json:"email,omitempty"`
+	UserType UserType  `json:"user_type,omitempty"`
+	FamilyID string    `json:"fid,omitempty"` // refresh token family
+	jwt.RegisteredClaims
+}
+
+// TokenPurpose identifies the single action a scoped token can be used for
+type TokenPurpose string
+
+const (
+	TokenPurposeEmailVerification TokenPurpose = "email_verification"
+	TokenPurposeMFAChallenge      TokenPurpose = "mfa_challenge"
+	TokenPurposeEmailChange       TokenPurpose = "email_change"
+)
+
+// ScopedClaims represents JWT claims for single-purpose tokens such as email verification links.
+// The user ID is carried in the subject claim.
+type ScopedClaims struct {
+	Type    TokenType    `json:"typ"`
+	Email   string       `json:"email"`
+	Purpose TokenPurpose `json:"purpose"`
 	jwt.RegisteredClaims
 }
 
@@ -36,7 +66,12 @@ func GetAuthClaims(claims interface{}) (*AuthClaims, error) {
 type AuthService interface {
 	Register(ctx context.Context, email, password string) (*User, error)
 	RegisterWithProfile(ctx context.Context, req RegisterRequest) (*User, error)
-	Login(ctx context.Context, email, password string) (token string, err error)
+	Login(ctx context.Context, email, password, ip string) (*LoginResult, error)
+	SetupMFA(ctx context.Context, mfaToken, ip string) (*MFASetup, error)
+	VerifyMFA(ctx context.Context, mfaToken, code, ip string) (*TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (*TokenPair, error)
+	Logout(ctx context.Context, claims *AuthClaims, refreshToken string) error
+	LogoutAll(ctx context.Context, claims *AuthClaims) error
 	GenerateRefreshToken(ctx context.Context, user *User) (string, error)
 	ValidateRefreshToken(ctx context.Context, token string) (*User, error)
 }
@@ -45,6 +80,8 @@ type AuthService interface {
 type JWTManager interface {
 	Generate(user *User) (string, error)
 	Validate(token string) (*AuthClaims, error)
-	GenerateRefreshToken(user *User) (string, error)
+	GenerateRefreshToken(token *RefreshToken) (string, error)
 	ValidateRefreshToken(token string) (*AuthClaims, error)
+	GenerateScopedToken(userID, email string, purpose TokenPurpose, ttl time.Duration) (string, error)
+	ValidateScopedToken(token string, purpose TokenPurpose) (*ScopedClaims, error)
 }
@@ -0,0 +1,285 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Layouts of the days and times of day of the calendars, in the timezone of the clinic
+const (
+	DateLayout  = "2006-01-02"
+	ClockLayout = "15:04"
+	// EndOfDay ends hours at midnight, when the day ends, as 1440 minutes since it started. It is
+	// only accepted as the end of hours.
+	EndOfDay = "24:00"
+)
+
+// MaxSlotRangeDays bounds how many days a single slot search covers
+const MaxSlotRangeDays = 31
+
+// TimeWindow is a period of a day, from Start until End, in the timezone of the clinic. End is
+// EndOfDay for hours lasting until midnight.
+type TimeWindow struct {
+	Start string `bson:"start" json:"start" validate:"required,datetime=15:04" example:"08:00"`
+	End   string `bson:"end" json:"end" validate:"required,clock_end" example:"12:00"`
+}
+
+// WeeklyHours is a period in which a doctor works every week. End is EndOfDay for hours lasting
+// until midnight.
+type WeeklyHours struct {
+	Weekday time.Weekday `bson:"weekday" json:"weekday" validate:"min=0,max=6" swaggertype:"integer" example:"1"` // 0 is Sunday
+	Start   string       `bson:"start" json:"start" validate:"required,datetime=15:04" example:"08:00"`
+	End     string       `bson:"end" json:"end" validate:"required,clock_end" example:"12:00"`
+}
+
+// Availability holds the weekly working hours of a doctor.
+type Availability struct {
+	DoctorID  string        `bson:"_id" json:"doctor_id"`
+	Weekly    []WeeklyHours `bson:"weekly" json:"weekly"`
+	UpdatedBy string        `bson:"updated_by,omitempty" json:"updated_by,omitempty"`
+	UpdatedAt *time.Time    `bson:"updated_at,omitempty" json:"updated_at,omitempty"`
+}
+
+// AvailabilityException replaces the weekly hours of a doctor from StartDate to EndDate, both
+// inclusive, such as on holidays and vacations. When several exceptions cover a day, the most
+// recent one applies.
+type AvailabilityException struct {
+	ID        string `bson:"_id" json:"id"`
+	DoctorID  string `bson:"doctor_id" json:"doctor_id"`
+	StartDate string `bson:"start_date" json:"start_date"` // YYYY-MM-DD
+	EndDate   string `bson:"end_date" json:"end_date"`     // YYYY-MM-DD
+	Reason    string `bson:"reason" json:"reason"`
+	// Hours are worked instead of the weekly hours. The doctor doesn't work when it is empty.
+	Hours     []TimeWindow `bson:"hours,omitempty" json:"hours,omitempty"`
+	CreatedBy string       `bson:"created_by" json:"created_by"`
+	CreatedAt time.Time    `bson:"created_at" json:"created_at"`
+}
+
+// Covers reports whether the exception applies to date, given as YYYY-MM-DD
+func (e *AvailabilityException) Covers(date string) bool {
+	return e.StartDate <= date && date <= e.EndDate
+}
+
+// Slot is a free period of the calendar of a doctor that an appointment can be booked in.
+type Slot struct {
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+// SlotList is the result of a slot search. The times are in Timezone.
+type SlotList struct {
+	DoctorID    string `json:"doctor_id"`
+	Timezone    string `json:"timezone" example:"America/Sao_Paulo"`
+	SlotMinutes int    `json:"slot_minutes" example:"30"`
+	Slots       []Slot `json:"slots"`
+}
+
+// DateRange selects the days from From to To, both inclusive and given as YYYY-MM-DD.
+type DateRange struct {
+	From string `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To   string `query:"to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// SetAvailabilityRequest represents the request structure for replacing the weekly hours of a doctor.
+type SetAvailabilityRequest struct {
+	Weekly []WeeklyHours `json:"weekly" validate:"max=50,dive"`
+}
+
+// CreateAvailabilityExceptionRequest represents the request structure for adding an exception to
+// the weekly hours of a doctor.
+type CreateAvailabilityExceptionRequest struct {
+	StartDate string       `json:"start_date" validate:"required,datetime=2006-01-02" example:"2026-12-24"`
+	EndDate   string       `json:"end_date" validate:"required,datetime=2006-01-02" example:"2026-12-26"`
+	Reason    string       `json:"reason" validate:"required,max=200" example:"Natal"`
+	Hours     []TimeWindow `json:"hours,omitempty" validate:"max=10,dive"`
+}
+
+// clockMinutes returns the minutes since midnight of a time of day written as HH:MM
+func clockMinutes(clock string) (int, error) {
+	t, err := time.Parse(ClockLayout, clock)
+	if err != nil {
+		return 0, NewBadRequestError(fmt.Sprintf("invalid time of day %q, use HH:MM", clock))
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// endMinutes returns the minutes since midnight of the end of hours, which may be EndOfDay
+func endMinutes(clock string) (int, error) {
+	if clock == EndOfDay {
+		return 24 * 60, nil
+	}
+	return clockMinutes(clock)
+}
+
+// IsValidEnd reports whether clock is a valid end of hours: a time of day as HH:MM or EndOfDay
+func IsValidEnd(clock string) bool {
+	_, err := endMinutes(clock)
+	return err == nil
+}
+
+// ValidateWindows checks that each window ends after it starts and that they don't overlap
+func ValidateWindows(windows []TimeWindow) error {
+	type span struct{ start, end int }
+	spans := make([]span, 0, len(windows))
+	for _, w := range windows {
+		start, err := clockMinutes(w.Start)
+		if err != nil {
+			return err
+		}
+		end, err := endMinutes(w.End)
+		if err != nil {
+			return err
+		}
+		if end <= start {
+			return NewBadRequestError(fmt.Sprintf("%s-%s must end after it starts", w.Start, w.End))
+		}
+		spans = append(spans, span{start, end})
+	}
+
+	slices.SortFunc(spans, func(a, b span) int { return a.start - b.start })
+	for i := 1; i < len(spans); i++ {
+		if spans[i].start < spans[i-1].end {
+			return NewBadRequestError("working hours overlap")
+		}
+	}
+	return nil
+}
+
+// ValidateWeekly checks the hours of each weekday with ValidateWindows
+func ValidateWeekly(weekly []WeeklyHours) error {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if err := ValidateWindows(windowsOn(weekly, day)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// windowsOn returns the weekly hours of day
+func windowsOn(weekly []WeeklyHours, day time.Weekday) []TimeWindow {
+	var windows []TimeWindow
+	for _, hours := range weekly {
+		if hours.Weekday == day {
+			windows = append(windows, TimeWindow{Start: hours.Start, End: hours.End})
+		}
+	}
+	return windows
+}
+
+// SlotSearch holds the calendar of a doctor that free slots are computed from.
+type SlotSearch struct {
+	Weekly     []WeeklyHours
+	Exceptions []*AvailabilityException
+	// Booked are the appointments that take up the time of the doctor
+	Booked []*Appointment
+	// From and To are the first and last days searched, in Location
+	From, To time.Time
+	Location *time.Location
+	Duration time.Duration
+	// NotBefore excludes the slots starting before it, such as the ones already past
+	NotBefore time.Time
+}
+
+// FreeSlots splits the working hours of each day into slots of Duration, leaving out the ones
+// overlapping a booked appointment. Hours are wall clock times of Location, and slots are laid out
+// in elapsed time from the start of each period, so a period spanning a DST change has as many
+// slots as fit in the time that actually passes.
+func (s SlotSearch) FreeSlots() []Slot {
+	slots := []Slot{}
+	if s.Duration <= 0 {
+		return slots
+	}
+
+	first := time.Date(s.From.Year(), s.From.Month(), s.From.Day(), 0, 0, 0, 0, s.Location)
+	last := time.Date(s.To.Year(), s.To.Month(), s.To.Day(), 0, 0, 0, 0, s.Location)
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		for _, window := range s.windowsOf(day) {
+			start, end, ok := s.bounds(day, window)
+			if !ok {
+				continue
+			}
+			for at := start; !at.Add(s.Duration).After(end); at = at.Add(s.Duration) {
+				slot := Slot{StartsAt: at, EndsAt: at.Add(s.Duration)}
+				if at.Before(s.NotBefore) || s.booked(slot) {
+					continue
+				}
+				slots = append(slots, slot)
+			}
+		}
+	}
+
+	slices.SortFunc(slots, func(a, b Slot) int { return a.StartsAt.Compare(b.StartsAt) })
+	return slots
+}
+
+// Offers reports whether slot is one of FreeSlots, starting and ending at the same instants
+func (s SlotSearch) Offers(slot Slot) bool {
+	return slices.ContainsFunc(s.FreeSlots(), func(free Slot) bool {
+		return free.StartsAt.Equal(slot.StartsAt) && free.EndsAt.Equal(slot.EndsAt)
+	})
+}
+
+// windowsOf returns the working hours of day, from the most recent exception covering it or else
+// from the weekly hours
+func (s SlotSearch) windowsOf(day time.Time) []TimeWindow {
+	date := day.Format(DateLayout)
+	var latest *AvailabilityException
+	for _, e := range s.Exceptions {
+		if e.Covers(date) && (latest == nil || e.CreatedAt.After(latest.CreatedAt)) {
+			latest = e
+		}
+	}
+	if latest != nil {
+		return latest.Hours
+	}
+	return windowsOn(s.Weekly, day.Weekday())
+}
+
+// bounds returns the instants window starts and ends on day, EndOfDay being the midnight that
+// starts the next day. Times skipped by a DST change are normalized by time.Date.
+func (s SlotSearch) bounds(day time.Time, window TimeWindow) (time.Time, time.Time, bool) {
+	start, err := clockMinutes(window.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err := endMinutes(window.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	y, m, d := day.Date()
+	return time.Date(y, m, d, start/60, start%60, 0, 0, s.Location), time.Date(y, m, d, end/60, end%60, 0, 0, s.Location), true
+}
+
+func (s SlotSearch) booked(slot Slot) bool {
+	for _, appointment := range s.Booked {
+		if appointment.Overlaps(slot.StartsAt, slot.EndsAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// AvailabilityService defines the calendars of the doctors. Doctors manage their own calendar,
+// and admins and receptionists manage anyone's.
+type AvailabilityService interface {
+	// GetAvailability returns the weekly hours of a doctor, which are empty until set.
+	GetAvailability(ctx context.Context, doctorID string, actor *AuthClaims) (*Availability, error)
+	// SetAvailability replaces the weekly hours of a doctor.
+	SetAvailability(ctx context.Context, doctorID string, req SetAvailabilityRequest, actor *AuthClaims) (*Availability, error)
+	// ListExceptions returns the exceptions of a doctor covering any day of r, oldest first.
+	ListExceptions(ctx context.Context, doctorID string, r DateRange, actor *AuthClaims) ([]*AvailabilityException, error)
+	AddException(ctx context.Context, doctorID string, req CreateAvailabilityExceptionRequest, actor *AuthClaims) (*AvailabilityException, error)
+	DeleteException(ctx context.Context, doctorID, id string, actor *AuthClaims) error
+	// Slots returns the free slots of a doctor on the days of r, from today through the next 6
+	// days by default. Any authenticated user can search slots.
+	Slots(ctx context.Context, doctorID string, r DateRange) (*SlotList, error)
+	// NextSlots returns the first free slot within NextSlotDays days of each of the doctors, by
+	// doctor ID. Doctors without free slots are left out.
+	NextSlots(ctx context.Context, doctors []*User) (map[string]*Slot, error)
+	// CheckSlot fails with a bad request unless startsAt to endsAt is one of the slots of the doctor
+	// by its weekly hours, exceptions and slot duration. Booked appointments aren't looked at.
+	CheckSlot(ctx context.Context, doctorID string, startsAt, endsAt time.Time) error
+}
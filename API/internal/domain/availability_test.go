@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateWindows(t *testing.T) {
+	tests := []struct {
+		name    string
+		windows []TimeWindow
+		wantErr bool
+	}{
+		{"EMPTY", nil, false},
+		{"BACK TO BACK", []TimeWindow{{"08:00", "12:00"}, {"12:00", "18:00"}}, false},
+		{"UNSORTED", []TimeWindow{{"14:00", "18:00"}, {"08:00", "12:00"}}, false},
+		{"ENDS BEFORE STARTING", []TimeWindow{{"12:00", "08:00"}}, true},
+		{"EMPTY WINDOW", []TimeWindow{{"08:00", "08:00"}}, true},
+		{"OVERLAPPING", []TimeWindow{{"08:00", "12:00"}, {"11:30", "14:00"}}, true},
+		{"INVALID TIME", []TimeWindow{{"8h", "12:00"}}, true},
+		{"UNTIL MIDNIGHT", []TimeWindow{{"18:00", EndOfDay}}, false},
+		{"STARTS AT END OF DAY", []TimeWindow{{EndOfDay, EndOfDay}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateWindows(tt.windows)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_ValidateWeekly(t *testing.T) {
+	// The same hours on different days don't overlap
+	assert.NoError(t, ValidateWeekly([]WeeklyHours{
+		{Weekday: time.Monday, Start: "08:00", End: "12:00"},
+		{Weekday: time.Tuesday, Start: "08:00", End: "12:00"},
+	}))
+	assert.Error(t, ValidateWeekly([]WeeklyHours{
+		{Weekday: time.Monday, Start: "08:00", End: "12:00"},
+		{Weekday: time.Monday, Start: "10:00", End: "14:00"},
+	}))
+}
+
+func Test_SlotSearch_FreeSlots(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// Monday, November 2nd 2026
+	monday := time.Date(2026, 11, 2, 0, 0, 0, 0, saoPaulo)
+	weekly := []WeeklyHours{
+		{Weekday: time.Monday, Start: "08:00", End: "10:00"},
+		{Weekday: time.Monday, Start: "14:00", End: "15:00"},
+		{Weekday: time.Tuesday, Start: "09:00", End: "10:00"},
+	}
+	at := func(loc *time.Location, day time.Time, hour, minute int) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+	}
+	starts := func(slots []Slot, loc *time.Location) []string {
+		var out []string
+		for _, slot := range slots {
+			out = append(out, slot.StartsAt.In(loc).Format("01-02 15:04"))
+		}
+		return out
+	}
+
+	t.Run("WEEKLY HOURS", func(t *testing.T) {
+		slots := SlotSearch{
+			Weekly:   weekly,
+			From:     monday,
+			To:       monday.AddDate(0, 0, 2),
+			Location: saoPaulo,
+			Duration: 30 * time.Minute,
+		}.FreeSlots()
+
+		assert.Equal(t, []string{
+			"11-02 08:00", "11-02 08:30", "11-02 09:00", "11-02 09:30", "11-02 14:00", "11-02 14:30",
+			"11-03 09:00", "11-03 09:30",
+		}, starts(slots, saoPaulo))
+		assert.Equal(t, at(saoPaulo, monday, 8, 30), slots[0].EndsAt)
+	})
+
+	t.Run("SLOTS THAT DON'T FIT ARE LEFT OUT", func(t *testing.T) {
+		slots := SlotSearch{
+			Weekly:   weekly,
+			From:     monday,
+			To:       monday,
+			Location: saoPaulo,
+			Duration: 50 * time.Minute,
+		}.FreeSlots()
+
+		assert.Equal(t, []string{"11-02 08:00", "11-02 08:50", "11-02 14:00"}, starts(slots, saoPaulo))
+	})
+
+	t.Run("BOOKINGS AND PAST SLOTS", func(t *testing.T) {
+		slots := SlotSearch{
+			Weekly: weekly,
+			Booked: []*Appointment{
+				{StartsAt: at(saoPaulo, monday, 9, 0), EndsAt: at(saoPaulo, monday, 9, 45)},
+				{StartsAt: at(saoPaulo, monday, 14, 0), EndsAt: at(saoPaulo, monday, 14, 30)},
+			},
+			From:      monday,
+			To:        monday,
+			Location:  saoPaulo,
+			Duration:  30 * time.Minute,
+			NotBefore: at(saoPaulo, monday, 8, 10),
+		}.FreeSlots()
+
+		assert.Equal(t, []string{"11-02 08:30", "11-02 14:30"}, starts(slots, saoPaulo))
+	})
+
+	t.Run("UNTIL MIDNIGHT", func(t *testing.T) {
+		slots := SlotSearch{
+			Weekly:   []WeeklyHours{{Weekday: time.Monday, Start: "23:00", End: EndOfDay}},
+			From:     monday,
+			To:       monday,
+			Location: saoPaulo,
+			Duration: 30 * time.Minute,
+		}.FreeSlots()
+
+		assert.Equal(t, []string{"11-02 23:00", "11-02 23:30"}, starts(slots, saoPaulo))
+		assert.Equal(t, at(saoPaulo, monday.AddDate(0, 0, 1), 0, 0), slots[1].EndsAt)
+	})
+
+	t.Run("LATEST EXCEPTION WINS", func(t *testing.T) {
+		created := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		slots := SlotSearch{
+			Weekly: weekly,
+			Exceptions: []*AvailabilityException{
+				{StartDate: "2026-11-02", EndDate: "2026-11-03", CreatedAt: created},
+				{StartDate: "2026-11-02", EndDate: "2026-11-02", Hours: []TimeWindow{{"16:00", "17:00"}},
+					CreatedAt: created.Add(time.Hour)},
+			},
+			From:     monday,
+			To:       monday.AddDate(0, 0, 1),
+			Location: saoPaulo,
+			Duration: 30 * time.Minute,
+		}.FreeSlots()
+
+		// Monday has the hours of the newer exception and Tuesday is off
+		assert.Equal(t, []string{"11-02 16:00", "11-02 16:30"}, starts(slots, saoPaulo))
+	})
+
+	t.Run("SPRING FORWARD", func(t *testing.T) {
+		// Clocks jump from 02:00 to 03:00 on Sunday, March 8th 2026
+		sunday := time.Date(2026, 3, 8, 0, 0, 0, 0, newYork)
+		slots := SlotSearch{
+			Weekly:   []WeeklyHours{{Weekday: time.Sunday, Start: "01:00", End: "04:00"}},
+			From:     sunday,
+			To:       sunday,
+			Location: newYork,
+			Duration: 30 * time.Minute,
+		}.FreeSlots()
+
+		assert.Equal(t, []string{"03-08 01:00", "03-08 01:30", "03-08 03:00", "03-08 03:30"}, starts(slots, newYork))
+		for _, slot := range slots {
+			assert.Equal(t, 30*time.Minute, slot.EndsAt.Sub(slot.StartsAt))
+		}
+	})
+
+	t.Run("FALL BACK", func(t *testing.T) {
+		// Clocks go back from 02:00 to 01:00 on Sunday, November 1st 2026, so 01:00 to 03:00 lasts 3 hours
+		sunday := time.Date(2026, 11, 1, 0, 0, 0, 0, newYork)
+		slots := SlotSearch{
+			Weekly:   []WeeklyHours{{Weekday: time.Sunday, Start: "01:00", End: "03:00"}},
+			From:     sunday,
+			To:       sunday,
+			Location: newYork,
+			Duration: time.Hour,
+		}.FreeSlots()
+
+		require.Len(t, slots, 3)
+		assert.Equal(t, []string{"11-01 01:00", "11-01 01:00", "11-01 02:00"}, starts(slots, newYork))
+		assert.Equal(t, time.Hour, slots[1].StartsAt.Sub(slots[0].StartsAt))
+	})
+}
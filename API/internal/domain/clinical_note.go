@@ -0,0 +1,262 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// maxDiffCells bounds the table of the line diff of an amendment. Longer changes are shown as
+// their old lines removed and their new lines added.
+const maxDiffCells = 1 << 22
+
+// NoteStatus represents the state of a clinical note. Drafts can be changed by their author until
+// they sign them, after which the note only changes through amendments.
+type NoteStatus string
+
+const (
+	NoteDraft  NoteStatus = "draft"
+	NoteSigned NoteStatus = "signed"
+)
+
+// NoteContent is the text of a clinical note.
+type NoteContent struct {
+	Title string `bson:"title" json:"title" validate:"required,max=200" example:"Evolução"`
+	Body  string `bson:"body" json:"body" validate:"required,max=20000"`
+}
+
+// DiffOp tells whether a line of a diff was kept, added or removed
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "="
+	DiffInsert DiffOp = "+"
+	DiffDelete DiffOp = "-"
+)
+
+// DiffLine is a line of the difference between two texts
+type DiffLine struct {
+	Op   DiffOp `bson:"op" json:"op" enums:"=,+,-"`
+	Text string `bson:"text" json:"text"`
+}
+
+// NoteDiff is the difference of a version of a note from the previous one. Unchanged fields are omitted.
+type NoteDiff struct {
+	Title []DiffLine `bson:"title,omitempty" json:"title,omitempty"`
+	Body  []DiffLine `bson:"body,omitempty" json:"body,omitempty"`
+}
+
+// DiffFrom returns the difference of c from prev, or nil when they are the same
+func (c NoteContent) DiffFrom(prev NoteContent) *NoteDiff {
+	if c == prev {
+		return nil
+	}
+	diff := &NoteDiff{}
+	if c.Title != prev.Title {
+		diff.Title = DiffLines(prev.Title, c.Title)
+	}
+	if c.Body != prev.Body {
+		diff.Body = DiffLines(prev.Body, c.Body)
+	}
+	return diff
+}
+
+// DiffLines returns the lines of after with the lines of before that were removed, keeping the
+// most lines of before unchanged.
+func DiffLines(before, after string) []DiffLine {
+	a, b := strings.Split(before, "\n"), strings.Split(after, "\n")
+
+	// Lines shared at the start and at the end are kept as they are
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	diff := make([]DiffLine, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		diff = append(diff, DiffLine{Op: DiffEqual, Text: line})
+	}
+	diff = append(diff, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		diff = append(diff, DiffLine{Op: DiffEqual, Text: line})
+	}
+	return diff
+}
+
+// diffMiddle diffs the lines between the shared start and end with their longest common subsequence
+func diffMiddle(a, b []string) []DiffLine {
+	var diff []DiffLine
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, line := range a {
+			diff = append(diff, DiffLine{Op: DiffDelete, Text: line})
+		}
+		for _, line := range b {
+			diff = append(diff, DiffLine{Op: DiffInsert, Text: line})
+		}
+		return diff
+	}
+
+	// common[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	width := len(b) + 1
+	common := make([]int32, (len(a)+1)*width)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				common[i*width+j] = common[(i+1)*width+j+1] + 1
+			} else {
+				common[i*width+j] = max(common[(i+1)*width+j], common[i*width+j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			diff = append(diff, DiffLine{Op: DiffEqual, Text: a[i]})
+			i++
+			j++
+		case common[(i+1)*width+j] >= common[i*width+j+1]:
+			diff = append(diff, DiffLine{Op: DiffDelete, Text: a[i]})
+			i++
+		default:
+			diff = append(diff, DiffLine{Op: DiffInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		diff = append(diff, DiffLine{Op: DiffDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		diff = append(diff, DiffLine{Op: DiffInsert, Text: b[j]})
+	}
+	return diff
+}
+
+// NoteAmendment corrects a signed clinical note. Amendments are only ever added, never changed,
+// and each one amends the version before it: the signed original is version 1.
+type NoteAmendment struct {
+	ID     string `bson:"_id" json:"id"`
+	NoteID string `bson:"note_id" json:"note_id"`
+	// Version is the version of the note this amendment creates
+	Version int `bson:"version" json:"version" example:"2"`
+	// Amends is the version this amendment corrects, the latest one when it was written
+	Amends      int `bson:"amends" json:"amends" example:"1"`
+	NoteContent `bson:",inline"`
+	Reason      string `bson:"reason" json:"reason"`
+	// Diff is the change from the amended version
+	Diff      NoteDiff  `bson:"diff" json:"diff"`
+	AuthorID  string    `bson:"author_id" json:"author_id"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// ClinicalNote is the narrative of a doctor about a patient. Its text is that of the draft until
+// it is signed, and never changes afterwards: corrections are amendments stored on their own.
+type ClinicalNote struct {
+	ID        string     `bson:"_id" json:"id"`
+	PatientID string     `bson:"patient_id" json:"patient_id"`
+	Status    NoteStatus `bson:"status" json:"status"`
+	// EncounterID is the encounter the note was written in, if any
+	EncounterID string `bson:"encounter_id,omitempty" json:"encounter_id,omitempty"`
+	NoteContent `bson:",inline"`
+	// AuthorID is the doctor who wrote and signed the note
+	AuthorID  string     `bson:"author_id" json:"author_id"`
+	CreatedAt time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time  `bson:"updated_at" json:"updated_at"`
+	SignedAt  *time.Time `bson:"signed_at,omitempty" json:"signed_at,omitempty"`
+	// Version is the latest version of the note: 0 for drafts, 1 for the signed original and one
+	// more for each amendment
+	Version int `bson:"-" json:"version"`
+	// Amendments are the corrections of the note, oldest first. The latest one has its current text.
+	Amendments []*NoteAmendment `bson:"-" json:"amendments"`
+}
+
+// SetAmendments sets the amendments of n, oldest first, and its latest version.
+func (n *ClinicalNote) SetAmendments(amendments []*NoteAmendment) {
+	n.Amendments = amendments
+	n.Version = 0
+	if n.Status == NoteSigned {
+		n.Version = 1 + len(amendments)
+	}
+}
+
+// CurrentContent returns the text of the latest version of n
+func (n *ClinicalNote) CurrentContent() NoteContent {
+	if len(n.Amendments) == 0 {
+		return n.NoteContent
+	}
+	return n.Amendments[len(n.Amendments)-1].NoteContent
+}
+
+// ClinicalNoteFilter narrows the clinical notes of a patient. Empty fields match everything.
+type ClinicalNoteFilter struct {
+	Status      NoteStatus `query:"status" validate:"omitempty,oneof=draft signed"`
+	EncounterID string     `query:"encounter_id"`
+}
+
+// ClinicalNoteQuery selects the notes of PatientID returned by ClinicalNoteRepository. Empty
+// fields match everything.
+type ClinicalNoteQuery struct {
+	PatientID   string
+	Status      NoteStatus
+	EncounterID string
+}
+
+// ClinicalNotePage is a page of clinical notes
+type ClinicalNotePage = Page[*ClinicalNote]
+
+// ClinicalNoteSortFields are the fields clinical notes can be sorted by
+var ClinicalNoteSortFields = []string{"created_at"}
+
+// CreateClinicalNoteRequest represents the request structure for writing a clinical note.
+type CreateClinicalNoteRequest struct {
+	EncounterID string `json:"encounter_id,omitempty"`
+	NoteContent
+	// Sign signs the note right away instead of keeping it as a draft
+	Sign bool `json:"sign,omitempty"`
+}
+
+// UpdateClinicalNoteRequest represents the request structure for replacing the text of a draft.
+type UpdateClinicalNoteRequest struct {
+	EncounterID string `json:"encounter_id,omitempty"`
+	NoteContent
+}
+
+// SignClinicalNoteRequest represents the request structure for signing a draft.
+type SignClinicalNoteRequest struct {
+	// UpdatedAt is the time the draft was last updated when the author read it. Signing fails if
+	// the draft changed since, so that a change is never signed without being seen.
+	UpdatedAt time.Time `json:"updated_at" validate:"required" example:"2026-11-02T11:30:00Z"`
+}
+
+// AmendClinicalNoteRequest represents the request structure for correcting a signed note.
+type AmendClinicalNoteRequest struct {
+	NoteContent
+	Reason string `json:"reason" validate:"required,max=500" example:"Dose corrigida"`
+	// Amends is the version being corrected. When set, the amendment fails if the note has a
+	// later version, so that a correction is never made without seeing the previous one.
+	Amends int `json:"amends,omitempty" validate:"omitempty,min=1" example:"1"`
+}
+
+// ClinicalNoteService defines the clinical notes of the medical records. Doctors read every note
+// and write them, and patients read the signed notes of their own record. Nurses and other users
+// have no access.
+type ClinicalNoteService interface {
+	// ListNotes returns a page of the notes of a patient that actor can see, newest first by default.
+	ListNotes(ctx context.Context, patientID string, filter ClinicalNoteFilter, query ListQuery, actor *AuthClaims) (*ClinicalNotePage, error)
+	// GetNote returns a note with its amendments.
+	GetNote(ctx context.Context, patientID, id string, actor *AuthClaims) (*ClinicalNote, error)
+	// CreateNote writes a note by the doctor actor, as a draft unless signed right away.
+	CreateNote(ctx context.Context, patientID string, req CreateClinicalNoteRequest, actor *AuthClaims) (*ClinicalNote, error)
+	// UpdateNote replaces the text of a draft of actor.
+	UpdateNote(ctx context.Context, patientID, id string, req UpdateClinicalNoteRequest, actor *AuthClaims) (*ClinicalNote, error)
+	// SignNote signs a draft of actor as its first version, after which it never changes.
+	SignNote(ctx context.Context, patientID, id string, req SignClinicalNoteRequest, actor *AuthClaims) (*ClinicalNote, error)
+	// AmendNote adds an amendment by the author of a signed note with the corrected text, the
+	// reason and the diff from the latest version.
+	AmendNote(ctx context.Context, patientID, id string, req AmendClinicalNoteRequest, actor *AuthClaims) (*ClinicalNote, error)
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DiffLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		want   []DiffLine
+	}{
+		{"LINE CHANGED", "Queixa: tosse\nConduta: repouso", "Queixa: tosse\nConduta: salbutamol", []DiffLine{
+			{DiffEqual, "Queixa: tosse"},
+			{DiffDelete, "Conduta: repouso"},
+			{DiffInsert, "Conduta: salbutamol"},
+		}},
+		{"LINE ADDED IN THE MIDDLE", "a\nc", "a\nb\nc", []DiffLine{
+			{DiffEqual, "a"},
+			{DiffInsert, "b"},
+			{DiffEqual, "c"},
+		}},
+		{"LINES MOVED", "a\nb\nc", "b\nc\na", []DiffLine{
+			{DiffDelete, "a"},
+			{DiffEqual, "b"},
+			{DiffEqual, "c"},
+			{DiffInsert, "a"},
+		}},
+		{"SINGLE LINE", "Evolução", "Evolução diária", []DiffLine{
+			{DiffDelete, "Evolução"},
+			{DiffInsert, "Evolução diária"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DiffLines(tt.before, tt.after))
+		})
+	}
+}
+
+func Test_DiffLines_TooLong(t *testing.T) {
+	// Long changes are shown as replaced instead of diffed line by line
+	before := strings.Repeat("a\n", 3000) + "x"
+	after := strings.Repeat("b\n", 3000) + "x"
+
+	diff := DiffLines(before, after)
+	assert.Len(t, diff, 6001)
+	assert.Equal(t, DiffLine{DiffDelete, "a"}, diff[0])
+	assert.Equal(t, DiffLine{DiffInsert, "b"}, diff[3000])
+	assert.Equal(t, DiffLine{DiffEqual, "x"}, diff[6000])
+}
+
+func Test_NoteContent_DiffFrom(t *testing.T) {
+	prev := NoteContent{Title: "Evolução", Body: "Estável"}
+
+	assert.Nil(t, prev.DiffFrom(prev))
+
+	diff := NoteContent{Title: "Evolução", Body: "Estável\nAfebril"}.DiffFrom(prev)
+	assert.Empty(t, diff.Title)
+	assert.Equal(t, []DiffLine{{DiffEqual, "Estável"}, {DiffInsert, "Afebril"}}, diff.Body)
+
+	diff = NoteContent{Title: "Alta", Body: "Estável"}.DiffFrom(prev)
+	assert.Equal(t, []DiffLine{{DiffDelete, "Evolução"}, {DiffInsert, "Alta"}}, diff.Title)
+	assert.Empty(t, diff.Body)
+}
@@ -0,0 +1,56 @@
+package domain
+
+import "context"
+
+// NextSlotDays is how many days, from today, the directory looks ahead for the next free slot of a doctor
+const NextSlotDays = 14
+
+// DoctorProfile is the public view of a doctor in the directory. It only has professional
+// details, never contact information or documents such as the email, phone or CPF.
+type DoctorProfile struct {
+	ID         string `json:"id"`
+	FirstName  string `json:"first_name" example:"Ana"`
+	LastName   string `json:"last_name" example:"Souza"`
+	Speciality string `json:"speciality,omitempty" example:"Cardiologia"`
+	Department string `json:"department,omitempty" example:"Ambulatório"`
+	CRM        string `json:"crm" example:"CRM/SP 123456"`
+	// NextSlot is the first free slot within NextSlotDays days. It is omitted when there is none.
+	NextSlot *Slot `json:"next_slot,omitempty"`
+}
+
+// NewDoctorProfile copies the public details of a doctor
+func NewDoctorProfile(doctor *User) *DoctorProfile {
+	return &DoctorProfile{
+		ID:         doctor.ID,
+		FirstName:  doctor.Profile.FirstName,
+		LastName:   doctor.Profile.LastName,
+		Speciality: doctor.Profile.Speciality,
+		Department: doctor.Profile.Department,
+		CRM:        doctor.Profile.CRM,
+	}
+}
+
+// DoctorFilter narrows the doctors of the directory. Empty fields match everything.
+type DoctorFilter struct {
+	// Search splits into words, each of which must begin a word of the name, speciality or
+	// department, ignoring case
+	Search string `query:"search" validate:"max=100"`
+	// Speciality and Department match the whole value, ignoring case
+	Speciality string `query:"speciality" validate:"max=100"`
+	Department string `query:"department" validate:"max=100"`
+}
+
+// DoctorPage is a page of the doctor directory
+type DoctorPage = Page[*DoctorProfile]
+
+// DoctorSortFields are the fields the directory can be sorted by
+var DoctorSortFields = []string{"first_name", "last_name"}
+
+// DoctorDirectoryService lets any authenticated user find the active doctors of the clinic.
+type DoctorDirectoryService interface {
+	// List returns a page of the doctors matching filter, sorted by one of DoctorSortFields, with
+	// their next free slot.
+	List(ctx context.Context, filter DoctorFilter, query ListQuery) (*DoctorPage, error)
+	// Get returns an active doctor with its next free slot.
+	Get(ctx context.Context, id string) (*DoctorProfile, error)
+}
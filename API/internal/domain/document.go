@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// States are the Brazilian federative units, which suffix professional registrations
+var States = []string{
+	"AC", "AL", "AP", "AM", "BA", "CE", "DF", "ES", "GO", "MA", "MT", "MS", "MG", "PA",
+	"PB", "PR", "PE", "PI", "RJ", "RN", "RS", "RO", "RR", "SC", "SP", "SE", "TO",
+}
+
+var (
+	// crmPattern matches registrations such as "CRM/SP 123456", "CRM-SP 123456" or "CRMSP123456"
+	crmPattern = regexp.MustCompile(`^CRM[/\- ]?([A-Z]{2})[ \-]?(\d{1,8})$`)
+	// corenPattern matches registrations such as "COREN-SP 123456", optionally followed by the
+	// category of the professional, as in "COREN-SP 123456-ENF"
+	corenPattern = regexp.MustCompile(`^COREN[/\- ]?([A-Z]{2})[ \-]?(\d{1,9})(?:-([A-Z]{2,4}))?$`)
+	// phoneSeparators are the characters people type between the digits of a phone number
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+)
+
+// DateOfBirthLayout is the ISO 8601 format of UserProfile.DateOfBirth
+const DateOfBirthLayout = "2006-01-02"
+
+// NormalizeCPF checks the check digits of a CPF, with or without punctuation, and formats it
+// as "000.000.000-00".
+func NormalizeCPF(cpf string) (string, bool) {
+	digits := strings.NewReplacer(".", "", "-", "", " ", "").Replace(cpf)
+	if len(digits) != 11 || !isDigits(digits) {
+		return "", false
+	}
+	// Numbers made of a single repeated digit pass the check digits but aren't issued
+	if strings.Count(digits, digits[:1]) == len(digits) {
+		return "", false
+	}
+
+	for _, n := range []int{9, 10} {
+		sum := 0
+		for i := 0; i < n; i++ {
+			sum += int(digits[i]-'0') * (n + 1 - i)
+		}
+		check := 11 - sum%11
+		if check >= 10 {
+			check = 0
+		}
+		if int(digits[n]-'0') != check {
+			return "", false
+		}
+	}
+
+	return digits[:3] + "." + digits[3:6] + "." + digits[6:9] + "-" + digits[9:], true
+}
+
+// NormalizeCRM checks that a CRM has a valid state and formats it as "CRM/SP 123456".
+func NormalizeCRM(crm string) (string, bool) {
+	match := crmPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(crm)))
+	if match == nil || !slices.Contains(States, match[1]) {
+		return "", false
+	}
+	return fmt.Sprintf("CRM/%s %s", match[1], match[2]), true
+}
+
+// NormalizeCOREN checks that a COREN has a valid state and formats it as "COREN-SP 123456",
+// keeping the category suffix when there is one.
+func NormalizeCOREN(coren string) (string, bool) {
+	match := corenPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(coren)))
+	if match == nil || !slices.Contains(States, match[1]) {
+		return "", false
+	}
+	normalized := fmt.Sprintf("COREN-%s %s", match[1], match[2])
+	if match[3] != "" {
+		normalized += "-" + match[3]
+	}
+	return normalized, true
+}
+
+// NormalizePhone formats a phone number in E.164, as in "+5511999999999". Numbers without a
+// country code are taken as Brazilian, with the area code and an optional leading trunk 0.
+// Brazilian numbers must have a two digit area code followed by 8 digits, or 9 for mobiles.
+func NormalizePhone(phone string) (string, bool) {
+	digits := phoneSeparators.Replace(strings.TrimSpace(phone))
+	international := strings.HasPrefix(digits, "+")
+	digits = strings.TrimPrefix(digits, "+")
+	if digits == "" || !isDigits(digits) {
+		return "", false
+	}
+
+	if !international {
+		digits = "55" + strings.TrimPrefix(digits, "0")
+	}
+	if !strings.HasPrefix(digits, "55") {
+		// E.164 numbers have up to 15 digits and country codes don't start with 0
+		if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+			return "", false
+		}
+		return "+" + digits, true
+	}
+
+	national := digits[2:]
+	if national[0] == '0' || (len(national) != 10 && len(national) != 11) {
+		return "", false
+	}
+	if len(national) == 11 && national[2] != '9' {
+		return "", false
+	}
+	return "+" + digits, true
+}
+
+// ValidDateOfBirth checks that a date of birth is an ISO 8601 date that isn't in the future.
+func ValidDateOfBirth(date string) bool {
+	born, err := time.Parse(DateOfBirthLayout, date)
+	if err != nil {
+		return false
+	}
+	return born.Year() >= 1900 && !born.After(time.Now())
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
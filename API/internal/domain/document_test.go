@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NormalizeCPF(t *testing.T) {
+	tests := []struct {
+		name  string
+		cpf   string
+		want  string
+		valid bool
+	}{
+		{"FORMATTED", "123.456.789-09", "123.456.789-09", true},
+		{"DIGITS ONLY", "52998224725", "529.982.247-25", true},
+		{"WRONG FIRST DIGIT", "123.456.789-19", "", false},
+		{"WRONG SECOND DIGIT", "123.456.789-00", "", false},
+		{"REPEATED DIGITS", "111.111.111-11", "", false},
+		{"TOO SHORT", "1234567890", "", false},
+		{"LETTERS", "123.456.789-0A", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizeCPF(tt.cpf)
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_NormalizeCRM(t *testing.T) {
+	tests := []struct {
+		name  string
+		crm   string
+		want  string
+		valid bool
+	}{
+		{"CANONICAL", "CRM/SP 123456", "CRM/SP 123456", true},
+		{"DASH", "crm-rj 1234", "CRM/RJ 1234", true},
+		{"COMPACT", "CRMMG123456", "CRM/MG 123456", true},
+		{"UNKNOWN STATE", "CRM/XX 123456", "", false},
+		{"NO STATE", "CRM12345", "", false},
+		{"NO NUMBER", "CRM/SP", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizeCRM(tt.crm)
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_NormalizeCOREN(t *testing.T) {
+	tests := []struct {
+		name  string
+		coren string
+		want  string
+		valid bool
+	}{
+		{"CANONICAL", "COREN-SP 123456", "COREN-SP 123456", true},
+		{"SLASH", "coren/ba 98765", "COREN-BA 98765", true},
+		{"CATEGORY", "COREN-SP 123456-ENF", "COREN-SP 123456-ENF", true},
+		{"UNKNOWN STATE", "COREN-ZZ 123456", "", false},
+		{"CRM", "CRM/SP 123456", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizeCOREN(tt.coren)
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_NormalizePhone(t *testing.T) {
+	tests := []struct {
+		name  string
+		phone string
+		want  string
+		valid bool
+	}{
+		{"E164", "+5511999999999", "+5511999999999", true},
+		{"FORMATTED", "+55 (11) 99999-9999", "+5511999999999", true},
+		{"DASHES", "+55-11-99999-9999", "+5511999999999", true},
+		{"NATIONAL MOBILE", "11999999999", "+5511999999999", true},
+		{"NATIONAL LANDLINE", "(11) 3333-4444", "+551133334444", true},
+		{"TRUNK PREFIX", "011 99999-9999", "+5511999999999", true},
+		{"FOREIGN", "+1 415 555 2671", "+14155552671", true},
+		{"TOO SHORT", "123456789", "", false},
+		{"MOBILE WITHOUT 9", "11888888888", "", false},
+		{"NO AREA CODE", "+55 99999-9999", "", false},
+		{"LETTERS", "+55 11 CALL-NOW", "", false},
+		{"TOO LONG", "+1234567890123456", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizePhone(tt.phone)
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_ValidDateOfBirth(t *testing.T) {
+	assert.True(t, ValidDateOfBirth("1990-05-15"))
+	assert.False(t, ValidDateOfBirth("15/05/1990"))
+	assert.False(t, ValidDateOfBirth("1990-02-30"))
+	assert.False(t, ValidDateOfBirth("1850-01-01"))
+	assert.False(t, ValidDateOfBirth("2999-01-01"))
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "context"
+
+// EmailVerificationService defines the email verification flow for new accounts.
+type EmailVerificationService interface {
+	SendVerification(ctx context.Context, user *User) error
+	Resend(ctx context.Context, email string) error
+	Verify(ctx context.Context, token string) (*User, error)
+	VerifyByAdmin(ctx context.Context, userID, adminID string) (*User, error)
+}
@@ -24,6 +24,13 @@ var (
 	ErrDocumentNotFound = errors.New("document not found")
 )
 
+// Problem types for failures that share a status code but need distinct handling by clients
+const (
+	ProblemTypeAccountInactive = "https://vidaplus.com/problems/account-inactive"
+	ProblemTypeAccountPending  = "https://vidaplus.com/problems/account-pending"
+	ProblemTypeAccountBlocked  = "https://vidaplus.com/problems/account-blocked"
+)
+
 func NewAPIError(statusCode int, details any) *APIError {
 	return &APIError{
 		Type:    getTypeByStatusCode(statusCode),
@@ -58,6 +65,33 @@ func NewUnauthorizedError(message string) *APIError {
 	return NewAPIError(http.StatusUnauthorized, message)
 }
 
+// NewForbiddenError creates a forbidden error
+func NewForbiddenError(message string) *APIError {
+	return NewAPIError(http.StatusForbidden, message)
+}
+
+// NewTooManyRequestsError creates a too many requests error
+func NewTooManyRequestsError(message string) *APIError {
+	return NewAPIError(http.StatusTooManyRequests, message)
+}
+
+// NewAccountStatusError creates a forbidden error whose type identifies why the account can't be used
+func NewAccountStatusError(status UserStatus) *APIError {
+	err := NewForbiddenError("account is not active")
+	switch status {
+	case UserStatusInactive:
+		err.Type = ProblemTypeAccountInactive
+		err.Details = "account is inactive"
+	case UserStatusPending:
+		err.Type = ProblemTypeAccountPending
+		err.Details = "account is pending activation"
+	case UserStatusBlocked:
+		err.Type = ProblemTypeAccountBlocked
+		err.Details = "account is blocked"
+	}
+	return err
+}
+
 func getTypeByStatusCode(statusCode int) string {
 	switch statusCode {
 	case http.StatusBadRequest:
@@ -70,6 +104,8 @@ func getTypeByStatusCode(statusCode int) string {
 		return "https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/404"
 	case http.StatusConflict:
 		return "https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/409"
+	case http.StatusTooManyRequests:
+		return "https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/429"
 	case http.StatusInternalServerError:
 		return "https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/500"
 	}
@@ -27,3 +27,23 @@ func Test_Errors_getTypeByStatusCode(t *testing.T) {
 		})
 	}
 }
+
+func Test_Errors_NewAccountStatusError(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   UserStatus
+		wantType string
+	}{
+		{"INACTIVE", UserStatusInactive, ProblemTypeAccountInactive},
+		{"PENDING", UserStatusPending, ProblemTypeAccountPending},
+		{"BLOCKED", UserStatusBlocked, ProblemTypeAccountBlocked},
+		{"UNKNOWN", UserStatus("unknown"), "https://developer.mozilla.org/en-US/docs/Web/HTTP/Status/403"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewAccountStatusError(tt.status)
+			assert.Equal(t, http.StatusForbidden, err.Status)
+			assert.Equalf(t, tt.wantType, err.Type, "NewAccountStatusError(%v)", tt.status)
+		})
+	}
+}
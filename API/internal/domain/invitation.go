@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Invitation lets someone create an account of a type chosen by an admin, such as a doctor
+// or nurse, which can't self-register. Only the SHA-256 hash of the token sent by email is stored.
+type Invitation struct {
+	ID         string     `bson:"_id" json:"-"`
+	Email      string     `bson:"email" json:"email"`
+	Type       UserType   `bson:"type" json:"type"`
+	InvitedBy  string     `bson:"invited_by" json:"invited_by"`
+	ExpiresAt  time.Time  `bson:"expires_at" json:"expires_at"`
+	CreatedAt  time.Time  `bson:"created_at" json:"created_at"`
+	AcceptedAt *time.Time `bson:"accepted_at,omitempty" json:"accepted_at,omitempty"`
+}
+
+// InvitationService defines staff onboarding through invitation links.
+type InvitationService interface {
+	// Invite emails an invitation link to req.Email, replacing earlier invitations to the same address.
+	Invite(ctx context.Context, req InviteUserRequest, invitedBy string) (*Invitation, error)
+	// Accept creates an active account with the email and type of the invitation.
+	Accept(ctx context.Context, req AcceptInvitationRequest) (*User, error)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// LoginAttempts counts consecutive failed logins for a key, such as an account or an IP address.
+type LoginAttempts struct {
+	Key           string    `bson:"_id" json:"key"`
+	Failures      int       `bson:"failures" json:"failures"`
+	LastFailureAt time.Time `bson:"last_failure_at" json:"last_failure_at"`
+	ExpiresAt     time.Time `bson:"expires_at" json:"expires_at"`
+}
+
+// LoginAttemptStore persists failed login counters. Counters are forgotten once they expire.
+type LoginAttemptStore interface {
+	// RegisterFailure increments the counter of key, starting a new one when it expired,
+	// and keeps it until expiresAt.
+	RegisterFailure(ctx context.Context, key string, at, expiresAt time.Time) (*LoginAttempts, error)
+	// Get returns the counter of key, or nil when there is no unexpired counter.
+	Get(ctx context.Context, key string) (*LoginAttempts, error)
+	Reset(ctx context.Context, key string) error
+}
+
+// LoginThrottle protects logins against brute force by delaying and blocking repeated failures.
+type LoginThrottle interface {
+	Check(ctx context.Context, email, ip string) error
+	RecordFailure(ctx context.Context, email, ip string, user *User) error
+	RecordSuccess(ctx context.Context, email string) error
+	// RecordChallengeFailure counts a wrong code entered for the MFA challenge challengeID,
+	// kept until the challenge expires, and returns how many were entered so far.
+	RecordChallengeFailure(ctx context.Context, challengeID string, expiresAt time.Time) (int, error)
+	Unlock(ctx context.Context, userID, adminID string) (*User, error)
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// Email represents an outgoing plain text email.
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer defines methods for delivering emails.
+type Mailer interface {
+	Send(ctx context.Context, email *Email) error
+}
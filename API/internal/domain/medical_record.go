@@ -0,0 +1,239 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// RecordEntryKind is the kind of an entry of a medical record
+type RecordEntryKind string
+
+const (
+	RecordEntryEncounter  RecordEntryKind = "encounter" // a visit or contact with the patient
+	RecordEntryDiagnosis  RecordEntryKind = "diagnosis"
+	RecordEntryNote       RecordEntryKind = "note" // clinical note from before ClinicalNote, read only until migrated
+	RecordEntryAllergy    RecordEntryKind = "allergy"
+	RecordEntryMedication RecordEntryKind = "medication"
+)
+
+// RecordEntryKinds lists every valid entry kind
+var RecordEntryKinds = []RecordEntryKind{
+	RecordEntryEncounter,
+	RecordEntryDiagnosis,
+	RecordEntryNote,
+	RecordEntryAllergy,
+	RecordEntryMedication,
+}
+
+// BasicRecordEntryKinds are the kinds in the basic view of a medical record, which nurses get
+var BasicRecordEntryKinds = []RecordEntryKind{
+	RecordEntryEncounter,
+	RecordEntryDiagnosis,
+	RecordEntryAllergy,
+	RecordEntryMedication,
+}
+
+// RecordEntryStatus represents the state of an entry. Drafts can be changed by their author until
+// they are finalized, and only final entries are seen by nurses and patients.
+type RecordEntryStatus string
+
+const (
+	RecordEntryDraft RecordEntryStatus = "draft"
+	RecordEntryFinal RecordEntryStatus = "final"
+)
+
+// RecordAccess is how much of a medical record a user can see
+type RecordAccess int
+
+const (
+	RecordAccessNone  RecordAccess = iota
+	RecordAccessOwn                // the final entries of the user's own record
+	RecordAccessBasic              // the basic view of the final entries of any record, without clinical notes
+	RecordAccessFull               // every entry of any record
+)
+
+// RecordAccessOf returns the access to medical records of userType. It is mapped from the user
+// type rather than from permissions, since admins hold every permission but don't see records.
+func RecordAccessOf(userType UserType) RecordAccess {
+	switch userType {
+	case UserTypeDoctor:
+		return RecordAccessFull
+	case UserTypeNurse:
+		return RecordAccessBasic
+	case UserTypePatient:
+		return RecordAccessOwn
+	default:
+		return RecordAccessNone
+	}
+}
+
+// Encounter is a visit or contact with the patient.
+type Encounter struct {
+	// AppointmentID is the appointment of the visit, if it was booked
+	AppointmentID string    `bson:"appointment_id,omitempty" json:"appointment_id,omitempty"`
+	OccurredAt    time.Time `bson:"occurred_at" json:"occurred_at" validate:"required"`
+	Reason        string    `bson:"reason" json:"reason" validate:"required,max=200" example:"Dor no peito"`
+	// Summary is left out of the basic view
+	Summary string `bson:"summary,omitempty" json:"summary,omitempty" validate:"max=5000"`
+}
+
+// Diagnosis is a condition of the patient.
+type Diagnosis struct {
+	Code        string `bson:"code,omitempty" json:"code,omitempty" validate:"max=10" example:"I10"` // ICD-10
+	Description string `bson:"description" json:"description" validate:"required,max=200" example:"Hipertensão essencial"`
+	// Notes are left out of the basic view
+	Notes string `bson:"notes,omitempty" json:"notes,omitempty" validate:"max=2000"`
+}
+
+// AllergySeverity represents how severe the reactions to an allergen are
+type AllergySeverity string
+
+const (
+	AllergySeverityMild     AllergySeverity = "mild"
+	AllergySeverityModerate AllergySeverity = "moderate"
+	AllergySeveritySevere   AllergySeverity = "severe"
+)
+
+// Allergy is a substance the patient reacts to.
+type Allergy struct {
+	Substance string          `bson:"substance" json:"substance" validate:"required,max=100" example:"Penicilina"`
+	Reaction  string          `bson:"reaction,omitempty" json:"reaction,omitempty" validate:"max=200" example:"Urticária"`
+	Severity  AllergySeverity `bson:"severity" json:"severity" validate:"required,oneof=mild moderate severe" example:"severe"`
+}
+
+// Medication is a drug the patient takes.
+type Medication struct {
+	Name      string `bson:"name" json:"name" validate:"required,max=100" example:"Losartana"`
+	Dosage    string `bson:"dosage" json:"dosage" validate:"required,max=100" example:"50 mg"`
+	Frequency string `bson:"frequency" json:"frequency" validate:"required,max=100" example:"1 vez ao dia"`
+	StartDate string `bson:"start_date,omitempty" json:"start_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	EndDate   string `bson:"end_date,omitempty" json:"end_date,omitempty" validate:"omitempty,datetime=2006-01-02"` // empty while in use
+}
+
+// RecordContent holds the details of an entry. Only the field of the kind of the entry is set.
+type RecordContent struct {
+	Encounter  *Encounter   `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Diagnosis  *Diagnosis   `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
+	Note       *NoteContent `bson:"note,omitempty" json:"note,omitempty"` // only on note entries not yet migrated
+	Allergy    *Allergy     `bson:"allergy,omitempty" json:"allergy,omitempty"`
+	Medication *Medication  `bson:"medication,omitempty" json:"medication,omitempty"`
+}
+
+// CheckKind checks that the content only has the details of kind
+func (c RecordContent) CheckKind(kind RecordEntryKind) error {
+	set := map[RecordEntryKind]bool{
+		RecordEntryEncounter:  c.Encounter != nil,
+		RecordEntryDiagnosis:  c.Diagnosis != nil,
+		RecordEntryNote:       c.Note != nil,
+		RecordEntryAllergy:    c.Allergy != nil,
+		RecordEntryMedication: c.Medication != nil,
+	}
+	for other, present := range set {
+		if present != (other == kind) {
+			return NewBadRequestError(fmt.Sprintf("a %s entry must have only its %s details", kind, kind))
+		}
+	}
+	return nil
+}
+
+// RecordEntry is an entry of the medical record of a patient.
+type RecordEntry struct {
+	ID        string            `bson:"_id" json:"id"`
+	PatientID string            `bson:"patient_id" json:"patient_id"`
+	Kind      RecordEntryKind   `bson:"kind" json:"kind"`
+	Status    RecordEntryStatus `bson:"status" json:"status"`
+	// EncounterID is the encounter the entry was recorded in, if any
+	EncounterID   string `bson:"encounter_id,omitempty" json:"encounter_id,omitempty"`
+	RecordContent `bson:",inline"`
+	AuthorID      string     `bson:"author_id" json:"author_id"`
+	CreatedAt     time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time  `bson:"updated_at" json:"updated_at"`
+	FinalizedAt   *time.Time `bson:"finalized_at,omitempty" json:"finalized_at,omitempty"`
+}
+
+// IsBasic reports whether the entry is part of the basic view of a medical record
+func (e *RecordEntry) IsBasic() bool {
+	return slices.Contains(BasicRecordEntryKinds, e.Kind)
+}
+
+// Basic returns a copy of the entry without the narrative text left out of the basic view
+func (e *RecordEntry) Basic() *RecordEntry {
+	basic := *e
+	basic.Note = nil
+	if e.Encounter != nil {
+		encounter := *e.Encounter
+		encounter.Summary = ""
+		basic.Encounter = &encounter
+	}
+	if e.Diagnosis != nil {
+		diagnosis := *e.Diagnosis
+		diagnosis.Notes = ""
+		basic.Diagnosis = &diagnosis
+	}
+	return &basic
+}
+
+// MedicalRecord is the overview of the record of a patient, with the final allergies,
+// medications and diagnoses, newest first.
+type MedicalRecord struct {
+	PatientID   string         `json:"patient_id"`
+	Allergies   []*RecordEntry `json:"allergies"`
+	Medications []*RecordEntry `json:"medications"`
+	Diagnoses   []*RecordEntry `json:"diagnoses"`
+}
+
+// RecordEntryFilter narrows the entries of a medical record. Empty fields match everything.
+type RecordEntryFilter struct {
+	Kind        RecordEntryKind   `query:"kind" validate:"omitempty,oneof=encounter diagnosis note allergy medication"`
+	Status      RecordEntryStatus `query:"status" validate:"omitempty,oneof=draft final"`
+	EncounterID string            `query:"encounter_id"`
+}
+
+// RecordEntryQuery selects the entries of the record of PatientID returned by
+// MedicalRecordRepository. Empty fields match everything.
+type RecordEntryQuery struct {
+	PatientID   string
+	Kinds       []RecordEntryKind
+	Status      RecordEntryStatus
+	EncounterID string
+}
+
+// RecordEntryPage is a page of the entries of a medical record
+type RecordEntryPage = Page[*RecordEntry]
+
+// RecordEntrySortFields are the fields the entries of a medical record can be sorted by
+var RecordEntrySortFields = []string{"created_at"}
+
+// CreateRecordEntryRequest represents the request structure for adding an entry to a medical record.
+type CreateRecordEntryRequest struct {
+	Kind        RecordEntryKind `json:"kind" validate:"required,oneof=encounter diagnosis allergy medication" example:"allergy"`
+	EncounterID string          `json:"encounter_id,omitempty"`
+	RecordContent
+	// Finalize records the entry as final right away instead of as a draft
+	Finalize bool `json:"finalize,omitempty"`
+}
+
+// UpdateRecordEntryRequest represents the request structure for replacing the details of a draft.
+type UpdateRecordEntryRequest struct {
+	EncounterID string `json:"encounter_id,omitempty"`
+	RecordContent
+}
+
+// MedicalRecordService defines the medical records of patients. Doctors read every entry and
+// write them, nurses read the basic view of final entries and patients read the final entries of
+// their own record. Other users have no access.
+type MedicalRecordService interface {
+	// GetRecord returns the overview of the record of a patient.
+	GetRecord(ctx context.Context, patientID string, actor *AuthClaims) (*MedicalRecord, error)
+	// ListEntries returns a page of the entries of a patient that actor can see, newest first by default.
+	ListEntries(ctx context.Context, patientID string, filter RecordEntryFilter, query ListQuery, actor *AuthClaims) (*RecordEntryPage, error)
+	GetEntry(ctx context.Context, patientID, id string, actor *AuthClaims) (*RecordEntry, error)
+	// CreateEntry adds an entry written by the doctor actor, as a draft unless finalized right away.
+	CreateEntry(ctx context.Context, patientID string, req CreateRecordEntryRequest, actor *AuthClaims) (*RecordEntry, error)
+	// UpdateEntry replaces the details of a draft of actor.
+	UpdateEntry(ctx context.Context, patientID, id string, req UpdateRecordEntryRequest, actor *AuthClaims) (*RecordEntry, error)
+	// FinalizeEntry makes a draft of actor final, after which it can't change.
+	FinalizeEntry(ctx context.Context, patientID, id string, actor *AuthClaims) (*RecordEntry, error)
+}
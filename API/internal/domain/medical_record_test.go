@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RecordAccessOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		userType UserType
+		want     RecordAccess
+	}{
+		{"ADMIN", UserTypeAdmin, RecordAccessNone},
+		{"DOCTOR", UserTypeDoctor, RecordAccessFull},
+		{"NURSE", UserTypeNurse, RecordAccessBasic},
+		{"PATIENT", UserTypePatient, RecordAccessOwn},
+		{"RECEPTIONIST", UserTypeReceptionist, RecordAccessNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RecordAccessOf(tt.userType))
+		})
+	}
+}
+
+func Test_RecordContent_CheckKind(t *testing.T) {
+	tests := []struct {
+		name    string
+		content RecordContent
+		kind    RecordEntryKind
+		wantErr bool
+	}{
+		{"MATCHING DETAILS", RecordContent{Allergy: &Allergy{Substance: "Penicilina"}}, RecordEntryAllergy, false},
+		{"MISSING DETAILS", RecordContent{}, RecordEntryEncounter, true},
+		{"DETAILS OF ANOTHER KIND", RecordContent{Diagnosis: &Diagnosis{Description: "Asma"}}, RecordEntryMedication, true},
+		{"EXTRA DETAILS", RecordContent{Medication: &Medication{Name: "Losartana"}, Allergy: &Allergy{Substance: "Látex"}},
+			RecordEntryMedication, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.content.CheckKind(tt.kind)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_RecordEntry_Basic(t *testing.T) {
+	entry := &RecordEntry{
+		ID:   "entry-1",
+		Kind: RecordEntryDiagnosis,
+		RecordContent: RecordContent{
+			Diagnosis: &Diagnosis{Code: "I10", Description: "Hipertensão essencial", Notes: "Histórico familiar"},
+		},
+	}
+
+	basic := entry.Basic()
+	assert.Equal(t, "I10", basic.Diagnosis.Code)
+	assert.Empty(t, basic.Diagnosis.Notes)
+	// The original entry is left as it was
+	assert.Equal(t, "Histórico familiar", entry.Diagnosis.Notes)
+
+	encounter := &RecordEntry{Kind: RecordEntryEncounter, RecordContent: RecordContent{Encounter: &Encounter{Reason: "Dor no peito", Summary: "ECG normal"}}}
+	assert.Equal(t, "Dor no peito", encounter.Basic().Encounter.Reason)
+	assert.Empty(t, encounter.Basic().Encounter.Summary)
+	assert.True(t, encounter.IsBasic())
+	assert.False(t, (&RecordEntry{Kind: RecordEntryNote}).IsBasic())
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MFAEnrollment holds the TOTP secret of a user. The enrollment only protects logins once
+// it has been confirmed with a valid code.
+type MFAEnrollment struct {
+	UserID        string     `bson:"_id" json:"user_id"`
+	Secret        string     `bson:"secret" json:"-"`
+	RecoveryCodes []string   `bson:"recovery_codes" json:"-"` // SHA-256 hashes of unused codes
+	LastUsedStep  int64      `bson:"last_used_step" json:"-"` // rejects replayed codes
+	ConfirmedAt   *time.Time `bson:"confirmed_at,omitempty" json:"confirmed_at,omitempty"`
+	CreatedAt     time.Time  `bson:"created_at" json:"created_at"`
+}
+
+// IsConfirmed checks if the enrollment has been confirmed
+func (e *MFAEnrollment) IsConfirmed() bool {
+	return e.ConfirmedAt != nil
+}
+
+// MFAPolicy defines whether users of a type must use MFA to log in.
+type MFAPolicy struct {
+	UserType  UserType  `bson:"_id" json:"user_type"`
+	Required  bool      `bson:"required" json:"required"`
+	UpdatedBy string    `bson:"updated_by,omitempty" json:"updated_by,omitempty"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// MFASetup is returned when an enrollment starts. The recovery codes are only shown once.
+type MFASetup struct {
+	Secret        string   `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	URI           string   `json:"otpauth_uri" example:"otpauth://totp/Vida%20Plus:doctor@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Vida+Plus"`
+	RecoveryCodes []string `json:"recovery_codes" example:"K7QX-M2PA,9DLW-R4TE"`
+}
+
+// MFAService defines TOTP enrollment, code verification and the per type policy.
+type MFAService interface {
+	Enroll(ctx context.Context, userID string) (*MFASetup, error)
+	Confirm(ctx context.Context, userID, code string) error
+	Disable(ctx context.Context, userID, code string) error
+	Verify(ctx context.Context, userID, code string) error
+	IsEnabled(ctx context.Context, userID string) (bool, error)
+	IsRequired(ctx context.Context, userType UserType) (bool, error)
+	GetPolicies(ctx context.Context) ([]*MFAPolicy, error)
+	SetPolicy(ctx context.Context, userType UserType, required bool, adminID string) (*MFAPolicy, error)
+}
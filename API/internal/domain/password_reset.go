@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PasswordResetToken represents a single-use password reset token. Only the
+// SHA-256 hash of the token sent by email is stored.
+type PasswordResetToken struct {
+	ID        string     `bson:"_id" json:"-"`
+	UserID    string     `bson:"user_id" json:"user_id"`
+	ExpiresAt time.Time  `bson:"expires_at" json:"expires_at"`
+	CreatedAt time.Time  `bson:"created_at" json:"created_at"`
+	UsedAt    *time.Time `bson:"used_at,omitempty" json:"used_at,omitempty"`
+}
+
+// PasswordResetService defines the forgot password flow.
+type PasswordResetService interface {
+	RequestReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+}
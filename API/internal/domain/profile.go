@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// profileFieldsByType lists the profile fields each user type may change on their own profile,
+// besides the name and phone that every user can change. Professional registrations such as
+// CRM and COREN only belong to the matching staff type.
+var profileFieldsByType = map[UserType][]string{
+	UserTypePatient:      {"date_of_birth", "cpf"},
+	UserTypeDoctor:       {"crm", "speciality", "department"},
+	UserTypeNurse:        {"coren", "department"},
+	UserTypeAdmin:        {"department"},
+	UserTypeReceptionist: {"department"},
+}
+
+// requiredProfileFields lists the profile fields each user type must fill, such as the
+// professional registration of doctors and nurses.
+var requiredProfileFields = map[UserType][]string{
+	UserTypeDoctor: {"crm"},
+	UserTypeNurse:  {"coren"},
+}
+
+// ProfileService defines the self-service account management of the authenticated user.
+type ProfileService interface {
+	GetProfile(ctx context.Context, userID string) (*User, error)
+	UpdateProfile(ctx context.Context, userID string, req UpdateProfileRequest) (*User, error)
+	// ChangePassword checks the current password, sets the new one and ends every session of the user.
+	ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error
+	// RequestEmailChange checks the password and emails a confirmation link to the new address.
+	// The current email keeps working until the link is used.
+	RequestEmailChange(ctx context.Context, userID, password, newEmail string) error
+	ConfirmEmailChange(ctx context.Context, token string) (*User, error)
+}
+
+// fields returns the JSON name of every field set in the request and where it is applied.
+func (r UpdateProfileRequest) fields(profile *UserProfile) map[string]struct {
+	value  *string
+	target *string
+} {
+	all := map[string]struct {
+		value  *string
+		target *string
+	}{
+		"first_name":    {r.FirstName, &profile.FirstName},
+		"last_name":     {r.LastName, &profile.LastName},
+		"phone":         {r.Phone, &profile.Phone},
+		"date_of_birth": {r.DateOfBirth, &profile.DateOfBirth},
+		"cpf":           {r.CPF, &profile.CPF},
+		"crm":           {r.CRM, &profile.CRM},
+		"coren":         {r.COREN, &profile.COREN},
+		"speciality":    {r.Speciality, &profile.Speciality},
+		"department":    {r.Department, &profile.Department},
+	}
+	for name, field := range all {
+		if field.value == nil {
+			delete(all, name)
+		}
+	}
+	return all
+}
+
+// ForbiddenFields returns the fields of the request that userType can't change on its own profile.
+func (r UpdateProfileRequest) ForbiddenFields(userType UserType) []string {
+	allowed := map[string]bool{"first_name": true, "last_name": true, "phone": true}
+	for _, name := range profileFieldsByType[userType] {
+		allowed[name] = true
+	}
+
+	var forbidden []string
+	for name := range r.fields(&UserProfile{}) {
+		if !allowed[name] {
+			forbidden = append(forbidden, name)
+		}
+	}
+	sort.Strings(forbidden)
+	return forbidden
+}
+
+// Apply copies the fields set in the request to profile.
+func (r UpdateProfileRequest) Apply(profile *UserProfile) {
+	for _, field := range r.fields(profile) {
+		*field.target = *field.value
+	}
+}
+
+// MissingFields returns the fields userType requires that the profile doesn't have.
+func (p UserProfile) MissingFields(userType UserType) []string {
+	values := map[string]string{"crm": p.CRM, "coren": p.COREN}
+
+	var missing []string
+	for _, name := range requiredProfileFields[userType] {
+		if strings.TrimSpace(values[name]) == "" {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// Normalize checks the documents and phone of the profile and puts them in their canonical
+// format. It fails when one is invalid or when userType requires a field the profile doesn't have.
+func (p *UserProfile) Normalize(userType UserType) error {
+	if missing := p.MissingFields(userType); len(missing) > 0 {
+		return NewBadRequestError(fmt.Sprintf("%s profiles require: %s", userType, strings.Join(missing, ", ")))
+	}
+
+	documents := []struct {
+		name      string
+		value     *string
+		normalize func(string) (string, bool)
+	}{
+		{"phone", &p.Phone, NormalizePhone},
+		{"cpf", &p.CPF, NormalizeCPF},
+		{"crm", &p.CRM, NormalizeCRM},
+		{"coren", &p.COREN, NormalizeCOREN},
+	}
+	for _, document := range documents {
+		if *document.value == "" {
+			continue
+		}
+		normalized, ok := document.normalize(*document.value)
+		if !ok {
+			return NewBadRequestError(fmt.Sprintf("invalid %s", document.name))
+		}
+		*document.value = normalized
+	}
+
+	if p.DateOfBirth != "" && !ValidDateOfBirth(p.DateOfBirth) {
+		return NewBadRequestError("invalid date_of_birth")
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Profile_ForbiddenFields(t *testing.T) {
+	value := "value"
+
+	tests := []struct {
+		name     string
+		userType UserType
+		req      UpdateProfileRequest
+		want     []string
+	}{
+		{"PATIENT COMMON FIELDS", UserTypePatient, UpdateProfileRequest{FirstName: &value, Phone: &value, CPF: &value}, nil},
+		{"PATIENT CRM AND COREN", UserTypePatient, UpdateProfileRequest{COREN: &value, CRM: &value, Phone: &value}, []string{"coren", "crm"}},
+		{"DOCTOR CRM", UserTypeDoctor, UpdateProfileRequest{CRM: &value, Speciality: &value}, nil},
+		{"DOCTOR COREN", UserTypeDoctor, UpdateProfileRequest{COREN: &value}, []string{"coren"}},
+		{"NURSE COREN", UserTypeNurse, UpdateProfileRequest{COREN: &value, Department: &value}, nil},
+		{"RECEPTIONIST CPF", UserTypeReceptionist, UpdateProfileRequest{CPF: &value}, []string{"cpf"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.req.ForbiddenFields(tt.userType))
+		})
+	}
+}
+
+func Test_Profile_Apply(t *testing.T) {
+	phone := "+55-11-98888-7777"
+	profile := UserProfile{FirstName: "Maria", Phone: "+55-11-99999-9999"}
+
+	UpdateProfileRequest{Phone: &phone}.Apply(&profile)
+
+	assert.Equal(t, UserProfile{FirstName: "Maria", Phone: phone}, profile)
+}
+
+func Test_Profile_Normalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		userType UserType
+		profile  UserProfile
+		want     UserProfile
+		wantErr  bool
+	}{
+		{"PATIENT", UserTypePatient, UserProfile{Phone: "(11) 99999-9999", CPF: "12345678909", DateOfBirth: "1990-05-15"},
+			UserProfile{Phone: "+5511999999999", CPF: "123.456.789-09", DateOfBirth: "1990-05-15"}, false},
+		{"DOCTOR", UserTypeDoctor, UserProfile{CRM: "crm-sp 123456"}, UserProfile{CRM: "CRM/SP 123456"}, false},
+		{"DOCTOR WITHOUT CRM", UserTypeDoctor, UserProfile{COREN: "COREN-SP 123456"}, UserProfile{}, true},
+		{"NURSE WITHOUT COREN", UserTypeNurse, UserProfile{}, UserProfile{}, true},
+		{"RECEPTIONIST", UserTypeReceptionist, UserProfile{FirstName: "Caio"}, UserProfile{FirstName: "Caio"}, false},
+		{"INVALID CPF", UserTypePatient, UserProfile{CPF: "123.456.789-00"}, UserProfile{}, true},
+		{"INVALID DATE OF BIRTH", UserTypePatient, UserProfile{DateOfBirth: "15/05/1990"}, UserProfile{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := tt.profile
+			err := profile.Normalize(tt.userType)
+			if tt.wantErr {
+				var apiErr *APIError
+				assert.ErrorAs(t, err, &apiErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, profile)
+		})
+	}
+}
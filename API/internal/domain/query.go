@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Page sizes of list endpoints
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// ListQuery holds the paging and sorting parameters shared by list endpoints. Sort is the name
+// of a field, prefixed with "-" for descending order, and Cursor is the NextCursor of the
+// previous page.
+type ListQuery struct {
+	Limit  int    `query:"limit"`
+	Cursor string `query:"cursor"`
+	Sort   string `query:"sort"`
+}
+
+// SortOrder is the field a list is sorted by
+type SortOrder struct {
+	Field string
+	Desc  bool
+}
+
+// PageRequest is a validated ListQuery
+type PageRequest struct {
+	Limit  int
+	Cursor string
+	Sort   SortOrder
+}
+
+// PageRequest validates q against the fields a list can be sorted by. An empty limit or sort
+// falls back to DefaultPageLimit and def.
+func (q ListQuery) PageRequest(sortable []string, def SortOrder) (PageRequest, error) {
+	page := PageRequest{Limit: q.Limit, Cursor: q.Cursor, Sort: def}
+	if page.Limit == 0 {
+		page.Limit = DefaultPageLimit
+	}
+	if page.Limit < 0 || page.Limit > MaxPageLimit {
+		return PageRequest{}, NewBadRequestError(fmt.Sprintf("limit must be between 1 and %d", MaxPageLimit))
+	}
+
+	if q.Sort != "" {
+		field := strings.TrimPrefix(q.Sort, "-")
+		if !slices.Contains(sortable, field) {
+			return PageRequest{}, NewBadRequestError(fmt.Sprintf("cannot sort by %q, use one of %s", field, strings.Join(sortable, ", ")))
+		}
+		page.Sort = SortOrder{Field: field, Desc: strings.HasPrefix(q.Sort, "-")}
+	}
+
+	return page, nil
+}
+
+// Page is a page of a list endpoint. NextCursor is empty on the last page, and Total counts
+// every item matching the filters, not only the ones in the page.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      int64  `json:"total"`
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListQuery_PageRequest(t *testing.T) {
+	sortable := []string{"created_at", "email"}
+	def := SortOrder{Field: "created_at", Desc: true}
+
+	tests := []struct {
+		name    string
+		query   ListQuery
+		want    PageRequest
+		wantErr bool
+	}{
+		{"DEFAULTS", ListQuery{}, PageRequest{Limit: DefaultPageLimit, Sort: def}, false},
+		{"ASCENDING", ListQuery{Limit: 5, Sort: "email", Cursor: "abc"}, PageRequest{Limit: 5, Cursor: "abc", Sort: SortOrder{Field: "email"}}, false},
+		{"DESCENDING", ListQuery{Sort: "-email"}, PageRequest{Limit: DefaultPageLimit, Sort: SortOrder{Field: "email", Desc: true}}, false},
+		{"MAX LIMIT", ListQuery{Limit: MaxPageLimit}, PageRequest{Limit: MaxPageLimit, Sort: def}, false},
+		{"LIMIT TOO HIGH", ListQuery{Limit: MaxPageLimit + 1}, PageRequest{}, true},
+		{"NEGATIVE LIMIT", ListQuery{Limit: -1}, PageRequest{}, true},
+		{"UNKNOWN FIELD", ListQuery{Sort: "-password"}, PageRequest{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.PageRequest(sortable, def)
+			if tt.wantErr {
+				var apiErr *APIError
+				require.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, 400, apiErr.Status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
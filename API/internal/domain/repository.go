@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // Repository defines generic database operations
@@ -15,5 +16,176 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *User) error
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
 	GetByID(ctx context.Context, id string) (*User, error)
-	GetAllUsers(ctx context.Context) ([]*User, error)
+	// ListUsers returns a page of the users matching filter
+	ListUsers(ctx context.Context, filter UserFilter, page PageRequest) (*UserPage, error)
+	// ListDoctors returns a page of the active doctors matching filter
+	ListDoctors(ctx context.Context, filter DoctorFilter, page PageRequest) (*UserPage, error)
+	UpdateStatus(ctx context.Context, id string, status UserStatus, reason, changedBy string) (*User, error)
+	UpdatePassword(ctx context.Context, id, hashedPassword string) error
+	RecordActivity(ctx context.Context, id string, at time.Time) error
+	// GetStats aggregates the counts of SystemStats over the users that weren't soft-deleted. Only
+	// the buckets of the period with registrations are returned.
+	GetStats(ctx context.Context, period StatsPeriod) (*SystemStats, error)
+	// MarkEmailVerified activates a pending account whose email hasn't been verified yet. An empty
+	// email matches any address. It returns nil when no such account exists.
+	MarkEmailVerified(ctx context.Context, id, email, reason, changedBy string) (*User, error)
+	// MarkVerificationSent records that a verification email is being sent, unless the account is
+	// no longer pending or the previous email was sent after notAfter.
+	MarkVerificationSent(ctx context.Context, id string, sentAt, notAfter time.Time) (bool, error)
+	// MarkResetSent records that a password reset email is being sent, unless the previous one was
+	// sent after notAfter.
+	MarkResetSent(ctx context.Context, id string, sentAt, notAfter time.Time) (bool, error)
+	// UpdateUser applies update to the user and returns the updated user, or nil when it doesn't exist.
+	UpdateUser(ctx context.Context, id string, update UserUpdate) (*User, error)
+	// ConfirmEmailChange replaces the email of the user with its pending email, if it still equals
+	// email, and marks it verified. It returns nil when there is no such pending change.
+	ConfirmEmailChange(ctx context.Context, id, email string) (*User, error)
+	// SoftDelete marks a user as deleted. It returns nil when the user doesn't exist or is already deleted.
+	SoftDelete(ctx context.Context, id, deletedBy string) (*User, error)
+	// Restore undoes SoftDelete. It returns nil when the user doesn't exist or isn't deleted.
+	Restore(ctx context.Context, id string) (*User, error)
+}
+
+// RefreshTokenRepository defines refresh token persistence operations
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *RefreshToken) error
+	GetByID(ctx context.Context, id string) (*RefreshToken, error)
+	// MarkUsed flags the token as used and reports false when it was already used or revoked.
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeAllForUser(ctx context.Context, userID string) error
+}
+
+// TokenRevocationRepository defines access token revocation persistence operations
+type TokenRevocationRepository interface {
+	RevokeToken(ctx context.Context, token *RevokedToken) (bool, error)
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+	RevokeSessions(ctx context.Context, revocation *SessionRevocation) error
+	GetSessionRevocation(ctx context.Context, userID string) (*SessionRevocation, error)
+}
+
+// PasswordResetRepository defines password reset token persistence operations
+type PasswordResetRepository interface {
+	Create(ctx context.Context, token *PasswordResetToken) error
+	// Consume marks an unused, unexpired token as used and returns it, or nil when no such token exists.
+	Consume(ctx context.Context, id string, usedAt time.Time) (*PasswordResetToken, error)
+	// Release makes a consumed token usable again, for when the reset it was consumed for failed.
+	Release(ctx context.Context, id string) error
+	DeleteByUser(ctx context.Context, userID string) error
+}
+
+// InvitationRepository defines invitation persistence operations
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *Invitation) error
+	// GetPending returns an unaccepted invitation that hasn't expired at now, or nil when no such invitation exists.
+	GetPending(ctx context.Context, id string, now time.Time) (*Invitation, error)
+	// Consume marks an unaccepted, unexpired invitation as accepted and returns it, or nil when no such invitation exists.
+	Consume(ctx context.Context, id string, acceptedAt time.Time) (*Invitation, error)
+	DeleteByEmail(ctx context.Context, email string) error
+}
+
+// MFARepository defines TOTP enrollment persistence operations
+type MFARepository interface {
+	SaveEnrollment(ctx context.Context, enrollment *MFAEnrollment) error
+	GetEnrollment(ctx context.Context, userID string) (*MFAEnrollment, error)
+	ConfirmEnrollment(ctx context.Context, userID string, confirmedAt time.Time) error
+	// UseStep records a TOTP time step as used. It returns false when the step, or a
+	// later one, was already used.
+	UseStep(ctx context.Context, userID string, step int64) (bool, error)
+	// UseRecoveryCode removes a recovery code from a confirmed enrollment. It returns false
+	// when the code doesn't exist.
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+	DeleteEnrollment(ctx context.Context, userID string) error
+}
+
+// MFAPolicyRepository defines MFA policy persistence operations
+type MFAPolicyRepository interface {
+	GetPolicy(ctx context.Context, userType UserType) (*MFAPolicy, error)
+	GetPolicies(ctx context.Context) ([]*MFAPolicy, error)
+	SetPolicy(ctx context.Context, policy *MFAPolicy) error
+}
+
+// AuditRepository defines audit trail persistence operations. Events are never updated or deleted.
+type AuditRepository interface {
+	Record(ctx context.Context, event *AuditEvent) error
+	List(ctx context.Context, filter AuditFilter, limit int) ([]*AuditEvent, error)
+}
+
+// AppointmentRepository defines appointment persistence operations. Appointments with one of
+// BlockingAppointmentStatuses never overlap on the calendar of a doctor.
+type AppointmentRepository interface {
+	// Create stores an appointment, failing with a conflict when it overlaps another one of the doctor.
+	// check runs first while the calendar of the doctor is locked, and its error is returned unchanged.
+	Create(ctx context.Context, appointment *Appointment, check func(ctx context.Context) error) error
+	GetByID(ctx context.Context, id string) (*Appointment, error)
+	// List returns a page of the appointments matching filter
+	List(ctx context.Context, filter AppointmentFilter, page PageRequest) (*AppointmentPage, error)
+	// Reschedule moves current to another time, failing with a conflict when it overlaps another
+	// appointment of the doctor. It returns nil when the appointment changed since current was
+	// read or can't be rescheduled. check runs as in Create.
+	Reschedule(ctx context.Context, current *Appointment, startsAt, endsAt time.Time, change AppointmentChange,
+		check func(ctx context.Context) error) (*Appointment, error)
+	// ListBlocking returns the appointments with one of BlockingAppointmentStatuses that overlap the
+	// time of the doctor from from until to, soonest first.
+	ListBlocking(ctx context.Context, doctorID string, from, to time.Time) ([]*Appointment, error)
+	// ListBlockingForDoctors is ListBlocking for several doctors at once.
+	ListBlockingForDoctors(ctx context.Context, doctorIDs []string, from, to time.Time) ([]*Appointment, error)
+	// UpdateStatus changes the status of an appointment to change.Status and records change in its
+	// history. It returns nil when the appointment doesn't exist or its status can't change to change.Status.
+	UpdateStatus(ctx context.Context, id string, change AppointmentChange) (*Appointment, error)
+}
+
+// AvailabilityRepository defines the persistence of the calendars of the doctors
+type AvailabilityRepository interface {
+	// GetAvailability returns the weekly hours of a doctor, or nil when they were never set.
+	GetAvailability(ctx context.Context, doctorID string) (*Availability, error)
+	// ListAvailabilities returns the weekly hours of those of the doctors that set them.
+	ListAvailabilities(ctx context.Context, doctorIDs []string) ([]*Availability, error)
+	SaveAvailability(ctx context.Context, availability *Availability) error
+	CreateException(ctx context.Context, exception *AvailabilityException) error
+	// ListExceptions returns the exceptions of a doctor covering any day from startDate to endDate,
+	// oldest first. Dates are given as YYYY-MM-DD.
+	ListExceptions(ctx context.Context, doctorID, startDate, endDate string) ([]*AvailabilityException, error)
+	// ListExceptionsForDoctors is ListExceptions for several doctors at once.
+	ListExceptionsForDoctors(ctx context.Context, doctorIDs []string, startDate, endDate string) ([]*AvailabilityException, error)
+	// DeleteException reports false when the doctor has no such exception.
+	DeleteException(ctx context.Context, doctorID, id string) (bool, error)
+}
+
+// MedicalRecordRepository defines the persistence of the entries of medical records. Final
+// entries never change.
+type MedicalRecordRepository interface {
+	Create(ctx context.Context, entry *RecordEntry) error
+	// GetByID returns an entry of the record of a patient, or nil when it doesn't exist.
+	GetByID(ctx context.Context, patientID, id string) (*RecordEntry, error)
+	// List returns a page of the entries matching query
+	List(ctx context.Context, query RecordEntryQuery, page PageRequest) (*RecordEntryPage, error)
+	// ListAll returns every entry matching query, newest first.
+	ListAll(ctx context.Context, query RecordEntryQuery) ([]*RecordEntry, error)
+	// UpdateDraft replaces the details of a draft with those of entry. It returns nil when the
+	// entry doesn't exist or is no longer a draft.
+	UpdateDraft(ctx context.Context, entry *RecordEntry) (*RecordEntry, error)
+	// Finalize makes a draft final. It returns nil when the entry doesn't exist or is no longer a draft.
+	Finalize(ctx context.Context, patientID, id string, at time.Time) (*RecordEntry, error)
+}
+
+// ClinicalNoteRepository defines the persistence of clinical notes. Signed notes are never
+// changed, and their amendments are only ever added.
+type ClinicalNoteRepository interface {
+	Create(ctx context.Context, note *ClinicalNote) error
+	// GetByID returns a note of the record of a patient, or nil when it doesn't exist.
+	GetByID(ctx context.Context, patientID, id string) (*ClinicalNote, error)
+	// List returns a page of the notes matching query
+	List(ctx context.Context, query ClinicalNoteQuery, page PageRequest) (*ClinicalNotePage, error)
+	// UpdateDraft replaces the text of a draft with that of note. It returns nil when the note
+	// doesn't exist or is no longer a draft.
+	UpdateDraft(ctx context.Context, note *ClinicalNote) (*ClinicalNote, error)
+	// Sign signs a draft at the given time if it wasn't changed since updatedAt. It returns nil
+	// when the note doesn't exist, is no longer a draft or was changed.
+	Sign(ctx context.Context, patientID, id string, updatedAt, at time.Time) (*ClinicalNote, error)
+	// CreateAmendment adds an amendment to a note. It fails with a conflict when the note already
+	// has an amendment with the same version.
+	CreateAmendment(ctx context.Context, amendment *NoteAmendment) error
+	// ListAmendments returns the amendments of the given notes, oldest first.
+	ListAmendments(ctx context.Context, noteIDs []string) ([]*NoteAmendment, error)
 }
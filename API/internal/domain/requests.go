@@ -5,7 +5,7 @@ package domain
 type RegisterRequest struct {
 	Email    string      `json:"email" validate:"required,email" example:"user@example.com"`
 	Password string      `json:"password" validate:"required,min=8,max=128" example:"mypassword123"`
-	Type     UserType    `json:"type" validate:"required" example:"patient"`
+	Type     UserType    `json:"type,omitempty" validate:"omitempty,user_type" example:"patient"` // only patient is accepted
 	Profile  UserProfile `json:"profile" validate:"required"`
 }
 
@@ -15,17 +15,137 @@ type LoginRequest struct {
 	Password string `json:"password" validate:"required,min=1" example:"mypassword123"`
 }
 
+// RefreshRequest represents the request structure for refreshing tokens.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
+
+// LogoutRequest represents the request structure for logging out.
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token,omitempty" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
+
+// UpdateStatusRequest represents the request structure for changing a user's status.
+type UpdateStatusRequest struct {
+	Status UserStatus `json:"status" validate:"required,oneof=active inactive pending blocked" example:"blocked"`
+	Reason string     `json:"reason" validate:"required,max=500" example:"Suspicious activity reported by the clinic"`
+}
+
+// ForgotPasswordRequest represents the request structure for starting a password reset.
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email" example:"user@example.com"`
+}
+
+// ResetPasswordRequest represents the request structure for completing a password reset.
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required" example:"Vx3q0m5...t8Kw"`
+	Password string `json:"password" validate:"required,min=8,max=128" example:"mynewpassword123"`
+}
+
+// VerifyEmailRequest represents the request structure for confirming an email address.
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
+
+// ResendVerificationRequest represents the request structure for resending the verification email.
+type ResendVerificationRequest struct {
+	Email string `json:"email" validate:"required,email" example:"user@example.com"`
+}
+
+// MFACodeRequest represents the request structure for confirming or disabling MFA.
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required,max=16" example:"123456"`
+}
+
+// MFASetupRequest represents the request structure for enrolling in MFA during login.
+type MFASetupRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
+
+// MFAVerifyRequest represents the request structure for completing an MFA login.
+type MFAVerifyRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	Code     string `json:"code" validate:"required,max=16" example:"123456"`
+}
+
+// MFAPolicyRequest represents the request structure for changing the MFA policy of a user type.
+type MFAPolicyRequest struct {
+	Type     UserType `param:"type" json:"-" validate:"user_type" swaggerignore:"true"`
+	Required *bool    `json:"required" validate:"required" example:"true"`
+}
+
+// UpdateProfileRequest represents a partial update of the authenticated user's profile. Omitted
+// fields are left unchanged.
+type UpdateProfileRequest struct {
+	FirstName   *string `json:"first_name,omitempty" validate:"omitempty,min=1,max=100" example:"Maria"`
+	LastName    *string `json:"last_name,omitempty" validate:"omitempty,min=1,max=100" example:"Silva"`
+	Phone       *string `json:"phone,omitempty" validate:"omitempty,phone" example:"+5511999999999"`
+	DateOfBirth *string `json:"date_of_birth,omitempty" validate:"omitempty,date_of_birth" example:"1990-05-15"`
+	CPF         *string `json:"cpf,omitempty" validate:"omitempty,cpf" example:"123.456.789-09"`
+	CRM         *string `json:"crm,omitempty" validate:"omitempty,crm" example:"CRM/SP 123456"`
+	COREN       *string `json:"coren,omitempty" validate:"omitempty,coren" example:"COREN-SP 123456"`
+	Speciality  *string `json:"speciality,omitempty" validate:"omitempty,max=100" example:"Cardiologia"`
+	Department  *string `json:"department,omitempty" validate:"omitempty,max=100" example:"Emergência"`
+}
+
+// ChangePasswordRequest represents the request structure for changing the password of the authenticated user.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required" example:"mypassword123"`
+	NewPassword     string `json:"new_password" validate:"required,min=8,max=128" example:"mynewpassword123"`
+}
+
+// ChangeEmailRequest represents the request structure for changing the email of the authenticated user.
+type ChangeEmailRequest struct {
+	Password string `json:"password" validate:"required" example:"mypassword123"`
+	NewEmail string `json:"new_email" validate:"required,email" example:"new@example.com"`
+}
+
+// CreateUserRequest represents the request structure for an admin creating an account.
+type CreateUserRequest struct {
+	Email    string      `json:"email" validate:"required,email" example:"doctor@example.com"`
+	Password string      `json:"password" validate:"required,min=8,max=128" example:"initialpassword123"`
+	Type     UserType    `json:"type" validate:"required,user_type" example:"doctor"`
+	Profile  UserProfile `json:"profile" validate:"required"`
+}
+
+// AdminUpdateUserRequest represents the request structure for an admin changing the type or
+// profile of a user. Unlike UpdateProfileRequest, any profile field can be changed.
+type AdminUpdateUserRequest struct {
+	Type    *UserType             `json:"type,omitempty" validate:"omitempty,user_type" example:"nurse"`
+	Profile *UpdateProfileRequest `json:"profile,omitempty" validate:"omitempty"`
+}
+
+// InviteUserRequest represents the request structure for inviting someone to create an account.
+type InviteUserRequest struct {
+	Email string   `json:"email" validate:"required,email" example:"nurse@example.com"`
+	Type  UserType `json:"type" validate:"required,user_type" example:"nurse"`
+}
+
+// AcceptInvitationRequest represents the request structure for creating an account from an invitation.
+// The email and type come from the invitation.
+type AcceptInvitationRequest struct {
+	Token    string      `json:"token" validate:"required" example:"Vx3q0m5...t8Kw"`
+	Password string      `json:"password" validate:"required,min=8,max=128" example:"mypassword123"`
+	Profile  UserProfile `json:"profile" validate:"required"`
+}
+
 // RegisterResponse represents the response structure for user registration.
 type RegisterResponse struct {
 	ID      string      `json:"id" example:"user123"`
 	Email   string      `json:"email" example:"user@example.com"`
 	Type    UserType    `json:"type" example:"patient"`
+	Status  UserStatus  `json:"status" example:"pending"`
 	Profile UserProfile `json:"profile"`
 }
 
-// LoginResponse represents the response structure for user login.
+// LoginResponse represents the response structure for user login and token refresh.
+// When MFA is required only the MFA fields are set.
 type LoginResponse struct {
-	Token string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	Token            string `json:"token,omitempty" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	RefreshToken     string `json:"refresh_token,omitempty" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	MFARequired      bool   `json:"mfa_required,omitempty" example:"false"`
+	MFASetupRequired bool   `json:"mfa_setup_required,omitempty" example:"false"`
+	MFAToken         string `json:"mfa_token,omitempty" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
 }
 
 // ErrorResponse represents the error response structure.
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// StatsInterval is the length of the buckets of a time series
+type StatsInterval string
+
+const (
+	StatsIntervalDay  StatsInterval = "day"
+	StatsIntervalWeek StatsInterval = "week" // weeks start on Monday
+)
+
+// MaxStatsBuckets limits the length of the time series of SystemStats
+const MaxStatsBuckets = 366
+
+// ActivityWindows are the windows in which SystemStats counts active users, by the name it reports them with.
+var ActivityWindows = map[string]time.Duration{
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// StatsQuery selects the period of the time series of SystemStats. From is inclusive and To
+// exclusive; they default to the 30 days, or 12 weeks, until now.
+type StatsQuery struct {
+	From     *time.Time    `query:"from"`
+	To       *time.Time    `query:"to"`
+	Interval StatsInterval `query:"interval" validate:"omitempty,oneof=day week"`
+}
+
+// StatsPeriod is a validated StatsQuery, with Now ending the activity windows.
+type StatsPeriod struct {
+	From     time.Time
+	To       time.Time
+	Interval StatsInterval
+	Now      time.Time
+}
+
+// Period validates q, with now ending the period by default. From is moved back to the start
+// of its bucket so every bucket of the time series covers a whole day or week.
+func (q StatsQuery) Period(now time.Time) (StatsPeriod, error) {
+	now = now.UTC()
+	period := StatsPeriod{Interval: q.Interval, Now: now, To: now}
+	if period.Interval == "" {
+		period.Interval = StatsIntervalDay
+	}
+	if q.To != nil {
+		period.To = q.To.UTC()
+	}
+	if q.From != nil {
+		period.From = q.From.UTC()
+	} else if period.Interval == StatsIntervalWeek {
+		period.From = period.To.AddDate(0, 0, -12*7)
+	} else {
+		period.From = period.To.AddDate(0, 0, -30)
+	}
+	period.From = period.bucketStart(period.From)
+
+	if !period.From.Before(period.To) {
+		return StatsPeriod{}, NewBadRequestError("from must be before to")
+	}
+	if len(period.Buckets()) > MaxStatsBuckets {
+		return StatsPeriod{}, NewBadRequestError(fmt.Sprintf("the period can't have more than %d buckets, use a longer interval", MaxStatsBuckets))
+	}
+
+	return period, nil
+}
+
+// bucketStart truncates t to the start of its bucket, in UTC
+func (p StatsPeriod) bucketStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if p.Interval == StatsIntervalWeek {
+		// time.Weekday starts on Sunday
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return day
+}
+
+// Buckets returns the start of every bucket of the period
+func (p StatsPeriod) Buckets() []time.Time {
+	days := 1
+	if p.Interval == StatsIntervalWeek {
+		days = 7
+	}
+
+	var starts []time.Time
+	for start := p.From; start.Before(p.To); start = start.AddDate(0, 0, days) {
+		starts = append(starts, start)
+		if len(starts) > MaxStatsBuckets {
+			break
+		}
+	}
+	return starts
+}
+
+// Fill returns a bucket of the period for every start of Buckets, with the counts of series
+// and zero for the ones it doesn't have.
+func (p StatsPeriod) Fill(series []TimeBucket) []TimeBucket {
+	counts := make(map[time.Time]int64, len(series))
+	for _, bucket := range series {
+		counts[bucket.Start.UTC()] = bucket.Count
+	}
+
+	starts := p.Buckets()
+	filled := make([]TimeBucket, len(starts))
+	for i, start := range starts {
+		filled[i] = TimeBucket{Start: start, Count: counts[start]}
+	}
+	return filled
+}
+
+// TimeBucket is a point of a time series, counting the events from Start until the next bucket.
+type TimeBucket struct {
+	Start time.Time `bson:"_id" json:"start"`
+	Count int64     `bson:"count" json:"count"`
+}
+
+// SystemStats are aggregated counts of the users that weren't soft-deleted.
+type SystemStats struct {
+	TotalUsers   int64                `json:"total_users"`
+	ByType       map[UserType]int64   `json:"by_type"`
+	ByStatus     map[UserStatus]int64 `json:"by_status"`
+	ByDepartment map[string]int64     `json:"by_department"`
+	// ActiveUsers counts the users who logged in or refreshed their session in each of ActivityWindows
+	ActiveUsers map[string]int64 `json:"active_users"`
+	// Registrations counts the users created in each bucket of the period, including empty ones
+	Registrations []TimeBucket  `json:"registrations"`
+	From          time.Time     `json:"from"`
+	To            time.Time     `json:"to"`
+	Interval      StatsInterval `json:"interval"`
+}
+
+// StatsService aggregates the statistics shown in the admin dashboard
+type StatsService interface {
+	GetSystemStats(ctx context.Context, query StatsQuery) (*SystemStats, error)
+}
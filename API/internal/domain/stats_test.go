@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StatsQuery_Period(t *testing.T) {
+	// A Thursday
+	now := time.Date(2025, 3, 13, 15, 30, 0, 0, time.UTC)
+	date := func(month time.Month, day int) *time.Time {
+		d := time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	longAgo := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		query   StatsQuery
+		from    time.Time
+		to      time.Time
+		buckets int
+		wantErr bool
+	}{
+		{"DEFAULT DAYS", StatsQuery{}, *date(2, 11), now, 31, false},
+		{"DEFAULT WEEKS", StatsQuery{Interval: StatsIntervalWeek}, time.Date(2024, 12, 16, 0, 0, 0, 0, time.UTC), now, 13, false},
+		{"RANGE", StatsQuery{From: date(3, 1), To: date(3, 8)}, *date(3, 1), *date(3, 8), 7, false},
+		{"FROM MID WEEK", StatsQuery{From: date(3, 5), To: date(3, 10), Interval: StatsIntervalWeek}, *date(3, 3), *date(3, 10), 1, false},
+		{"FROM MID DAY", StatsQuery{From: &now, To: date(3, 14)}, *date(3, 13), *date(3, 14), 1, false},
+		{"FROM AFTER TO", StatsQuery{From: date(3, 8), To: date(3, 1)}, time.Time{}, time.Time{}, 0, true},
+		{"EMPTY", StatsQuery{From: date(3, 1), To: date(3, 1)}, time.Time{}, time.Time{}, 0, true},
+		{"TOO MANY BUCKETS", StatsQuery{From: &longAgo, To: date(3, 1)}, time.Time{}, time.Time{}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.Period(now)
+			if tt.wantErr {
+				var apiErr *APIError
+				require.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, 400, apiErr.Status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.from, got.From)
+			assert.Equal(t, tt.to, got.To)
+			assert.Len(t, got.Buckets(), tt.buckets)
+		})
+	}
+}
+
+func Test_StatsPeriod_Fill(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+	period := StatsPeriod{From: day(1), To: day(4), Interval: StatsIntervalDay}
+
+	got := period.Fill([]TimeBucket{{Start: day(2), Count: 5}})
+	assert.Equal(t, []TimeBucket{
+		{Start: day(1), Count: 0},
+		{Start: day(2), Count: 5},
+		{Start: day(3), Count: 0},
+	}, got)
+}
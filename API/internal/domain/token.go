@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RefreshToken represents a persisted refresh token. Tokens issued from the same
+// login share a FamilyID so the whole chain can be revoked when a replay is detected.
+type RefreshToken struct {
+	ID        string     `bson:"_id" json:"id"`
+	FamilyID  string     `bson:"family_id" json:"family_id"`
+	UserID    string     `bson:"user_id" json:"user_id"`
+	ExpiresAt time.Time  `bson:"expires_at" json:"expires_at"`
+	CreatedAt time.Time  `bson:"created_at" json:"created_at"`
+	UsedAt    *time.Time `bson:"used_at,omitempty" json:"used_at,omitempty"`
+	RevokedAt *time.Time `bson:"revoked_at,omitempty" json:"revoked_at,omitempty"`
+}
+
+// IsUsed checks if the refresh token was already exchanged for a new pair
+func (t *RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsRevoked checks if the refresh token was revoked
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// LoginResult is the outcome of a password login. When MFA is needed Tokens is nil and
+// MFAToken must be exchanged for tokens together with a valid code.
+type LoginResult struct {
+	Tokens           *TokenPair
+	MFAToken         string
+	MFASetupRequired bool // the user must enroll before verifying
+}
+
+// TokenPair holds an access token and the refresh token issued with it.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// RevokedToken represents an access token revoked before its expiration.
+type RevokedToken struct {
+	ID        string    `bson:"_id" json:"id"` // token jti
+	UserID    string    `bson:"user_id" json:"user_id"`
+	ExpiresAt time.Time `bson:"expires_at" json:"expires_at"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// SessionRevocation invalidates every access token a user obtained before RevokedBefore.
+type SessionRevocation struct {
+	UserID        string    `bson:"_id" json:"user_id"`
+	RevokedBefore time.Time `bson:"revoked_before" json:"revoked_before"`
+	ExpiresAt     time.Time `bson:"expires_at" json:"expires_at"`
+}
+
+// TokenRevocationStore decides whether access tokens were revoked before expiring.
+type TokenRevocationStore interface {
+	// Revoke revokes the token by its jti and reports whether this call was the first to revoke it
+	Revoke(ctx context.Context, claims *AuthClaims) (bool, error)
+	RevokeAllForUser(ctx context.Context, userID string) error
+	IsRevoked(ctx context.Context, claims *AuthClaims) (bool, error)
+}
@@ -17,6 +17,19 @@ const (
 	UserTypeReceptionist UserType = "receptionist" // Receptionist
 )
 
+// UserTypes lists every valid user type
+var UserTypes = []UserType{UserTypePatient, UserTypeDoctor, UserTypeNurse, UserTypeAdmin, UserTypeReceptionist}
+
+// IsValid checks if the user type is one of UserTypes
+func (t UserType) IsValid() bool {
+	for _, userType := range UserTypes {
+		if t == userType {
+			return true
+		}
+	}
+	return false
+}
+
 // UserStatus represents the status of a user account
 type UserStatus string
 
@@ -37,34 +50,82 @@ type User struct {
 	Profile   UserProfile `bson:"profile" json:"profile"`
 	CreatedAt time.Time   `bson:"created_at" json:"created_at"`
 	UpdatedAt time.Time   `bson:"updated_at" json:"updated_at"`
+
+	StatusReason    string     `bson:"status_reason,omitempty" json:"status_reason,omitempty"`
+	StatusChangedBy string     `bson:"status_changed_by,omitempty" json:"status_changed_by,omitempty"`
+	StatusChangedAt *time.Time `bson:"status_changed_at,omitempty" json:"status_changed_at,omitempty"`
+
+	EmailVerifiedAt    *time.Time `bson:"email_verified_at,omitempty" json:"email_verified_at,omitempty"`
+	VerificationSentAt *time.Time `bson:"verification_sent_at,omitempty" json:"-"`
+	ResetSentAt        *time.Time `bson:"reset_sent_at,omitempty" json:"-"`
+	PendingEmail       string     `bson:"pending_email,omitempty" json:"pending_email,omitempty"`   // awaiting confirmation
+	LastActiveAt       *time.Time `bson:"last_active_at,omitempty" json:"last_active_at,omitempty"` // last login or session refresh
+
+	DeletedAt *time.Time `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy string     `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+}
+
+// UserUpdate lists the fields to change with UserRepository.UpdateUser. Nil fields are left unchanged.
+type UserUpdate struct {
+	Type         *UserType
+	Profile      *UserProfile
+	PendingEmail *string
+}
+
+// UserFilter narrows the users returned by UserRepository.ListUsers. Empty fields match everything.
+type UserFilter struct {
+	Type        UserType   `query:"type" validate:"omitempty,user_type"`
+	Status      UserStatus `query:"status" validate:"omitempty,oneof=active inactive pending blocked"`
+	CreatedFrom *time.Time `query:"created_from"` // inclusive, RFC 3339
+	CreatedTo   *time.Time `query:"created_to"`   // exclusive, RFC 3339
+	// Search matches the beginning of the email, first name or last name, ignoring case
+	Search string `query:"search" validate:"max=100"`
+	// Deleted lists soft-deleted users instead of the others
+	Deleted bool `query:"deleted"`
 }
 
+// UserPage is a page of the user list
+type UserPage = Page[*User]
+
+// UserSortFields are the fields users can be sorted by
+var UserSortFields = []string{"created_at", "email", "first_name", "last_name"}
+
 // UserProfile contains profile information for all user types
 type UserProfile struct {
 	FirstName   string `bson:"first_name" json:"first_name"`
 	LastName    string `bson:"last_name" json:"last_name"`
-	Phone       string `bson:"phone" json:"phone"`
-	DateOfBirth string `bson:"date_of_birth,omitempty" json:"date_of_birth,omitempty"` // For patients
-	CPF         string `bson:"cpf,omitempty" json:"cpf,omitempty"`
-	CRM         string `bson:"crm,omitempty" json:"crm,omitempty"`               // For doctors
-	COREN       string `bson:"coren,omitempty" json:"coren,omitempty"`           // For nurses
-	Speciality  string `bson:"speciality,omitempty" json:"speciality,omitempty"` // For doctors
-	Department  string `bson:"department,omitempty" json:"department,omitempty"` // For staff
+	Phone       string `bson:"phone" json:"phone" validate:"omitempty,phone"`                                             // E.164
+	DateOfBirth string `bson:"date_of_birth,omitempty" json:"date_of_birth,omitempty" validate:"omitempty,date_of_birth"` // For patients, YYYY-MM-DD
+	CPF         string `bson:"cpf,omitempty" json:"cpf,omitempty" validate:"omitempty,cpf"`
+	CRM         string `bson:"crm,omitempty" json:"crm,omitempty" validate:"omitempty,crm"`       // Required for doctors
+	COREN       string `bson:"coren,omitempty" json:"coren,omitempty" validate:"omitempty,coren"` // Required for nurses
+	Speciality  string `bson:"speciality,omitempty" json:"speciality,omitempty"`                  // For doctors
+	Department  string `bson:"department,omitempty" json:"department,omitempty"`                  // For staff
 }
 
+// Permissions checked with HasPermission
+const (
+	PermissionViewPatients          = "view_patients"
+	PermissionManageAppointments    = "manage_appointments"     // anyone's appointments
+	PermissionManageOwnAppointments = "manage_own_appointments" // the appointments of the user as patient
+	PermissionViewMedicalRecords    = "view_medical_records"
+	PermissionViewBasicRecords      = "view_basic_records"
+	PermissionViewOwnRecords        = "view_own_records"
+)
+
 // HasPermission checks if user has permission for a specific action
 func (u *User) HasPermission(permission string) bool {
 	switch u.Type {
 	case UserTypeAdmin:
 		return true // Admin tem acesso total
 	case UserTypeDoctor:
-		return permission == "view_patients" || permission == "manage_appointments" || permission == "view_medical_records"
+		return permission == PermissionViewPatients || permission == PermissionManageAppointments || permission == PermissionViewMedicalRecords
 	case UserTypeNurse:
-		return permission == "view_patients" || permission == "view_basic_records"
+		return permission == PermissionViewPatients || permission == PermissionViewBasicRecords
 	case UserTypeReceptionist:
-		return permission == "manage_appointments" || permission == "view_patients"
+		return permission == PermissionManageAppointments || permission == PermissionViewPatients
 	case UserTypePatient:
-		return permission == "view_own_records" || permission == "manage_own_appointments"
+		return permission == PermissionViewOwnRecords || permission == PermissionManageOwnAppointments
 	default:
 		return false
 	}
@@ -75,6 +136,12 @@ func (u *User) IsActive() bool {
 	return u.Status == UserStatusActive
 }
 
+// IsDeleted checks if user account was soft-deleted. Deleted accounts keep their email
+// reserved so they can be restored, but can't be used.
+func (u *User) IsDeleted() bool {
+	return u.DeletedAt != nil
+}
+
 // GetFullName returns the full name of the user
 func (u *User) GetFullName() string {
 	return u.Profile.FirstName + " " + u.Profile.LastName
@@ -90,4 +157,13 @@ type UserStore interface {
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetByID(ctx context.Context, id string) (*User, error)
 	Create(ctx context.Context, user *User) error
+	UpdateStatus(ctx context.Context, id string, status UserStatus, reason, changedBy string) (*User, error)
+	// RecordActivity marks the user as active at the given time, when it logs in or refreshes its session.
+	RecordActivity(ctx context.Context, id string, at time.Time) error
+}
+
+// UserStatusCache resolves the current status of a user, caching lookups for a short time.
+type UserStatusCache interface {
+	GetStatus(ctx context.Context, userID string) (UserStatus, error)
+	Invalidate(userID string)
 }
@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+// UserAdminService defines how admins manage the accounts of other users. Every change is
+// recorded in the audit trail with the admin as actor.
+type UserAdminService interface {
+	// ListUsers returns a page of the users matching filter, newest first unless query sorts by
+	// one of UserSortFields.
+	ListUsers(ctx context.Context, filter UserFilter, query ListQuery) (*UserPage, error)
+	// GetUser returns a user, including soft-deleted ones.
+	GetUser(ctx context.Context, id string) (*User, error)
+	// CreateUser creates an active account of any type with the initial password set by the admin.
+	CreateUser(ctx context.Context, req CreateUserRequest, adminID string) (*User, error)
+	// UpdateUser changes the type and profile of a user. Changing the type ends every session of the user.
+	UpdateUser(ctx context.Context, id string, req AdminUpdateUserRequest, adminID string) (*User, error)
+	UpdateStatus(ctx context.Context, id string, req UpdateStatusRequest, adminID string) (*User, error)
+	// DeleteUser soft-deletes a user and ends every session. The email stays reserved until
+	// the user is restored.
+	DeleteUser(ctx context.Context, id, adminID string) (*User, error)
+	RestoreUser(ctx context.Context, id, adminID string) (*User, error)
+}
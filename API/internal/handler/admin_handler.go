@@ -10,30 +10,53 @@ import (
 
 // AdminHandler handles admin-specific endpoints
 type AdminHandler struct {
-	userRepo domain.UserRepository
+	stats         domain.StatsService
+	users         domain.UserAdminService
+	verifications domain.EmailVerificationService
+	throttle      domain.LoginThrottle
+	audit         domain.AuditRepository
 }
 
+// auditLogLimit is the maximum number of audit events returned at once
+const auditLogLimit = 100
+
 // NewAdminHandler creates a new instance of AdminHandler
-func NewAdminHandler(userRepo domain.UserRepository) *AdminHandler {
+func NewAdminHandler(stats domain.StatsService, users domain.UserAdminService, verifications domain.EmailVerificationService,
+	throttle domain.LoginThrottle, audit domain.AuditRepository) *AdminHandler {
 	return &AdminHandler{
-		userRepo: userRepo,
+		stats:         stats,
+		users:         users,
+		verifications: verifications,
+		throttle:      throttle,
+		audit:         audit,
 	}
 }
 
-// GetAllUsers godoc
-// @Summary Get all users (Admin only)
-// @Description Get list of all users in the system
+// ListUsers godoc
+// @Summary List users (Admin only)
+// @Description List users page by page, newest first by default. Follow next_cursor to get the next page with the same filters and sort; it is omitted on the last page.
 // @Tags admin
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} map[string]interface{} "List of all users"
+// @Param type query string false "Filter by user type" Enums(patient, doctor, nurse, admin, receptionist)
+// @Param status query string false "Filter by status" Enums(active, inactive, pending, blocked)
+// @Param created_from query string false "Created at or after (RFC 3339)"
+// @Param created_to query string false "Created before (RFC 3339)"
+// @Param search query string false "Prefix of the email, first name or last name, ignoring case"
+// @Param deleted query bool false "List soft-deleted users instead"
+// @Param sort query string false "Sort field, prefixed with - for descending order" Enums(created_at, -created_at, email, -email, first_name, -first_name, last_name, -last_name)
+// @Param limit query int false "Page size, up to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Success 200 {object} domain.UserPage "Page of users"
+// @Failure 400 {object} domain.APIError "Invalid filter, sort or cursor"
 // @Failure 401 {object} domain.APIError "Unauthorized"
 // @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 500 {object} domain.APIError "Internal server error"
 // @Router /admin/users [get]
-func (h *AdminHandler) GetAllUsers(c echo.Context) error {
+func (h *AdminHandler) ListUsers(c echo.Context) error {
 	logger := slog.With(
 		slog.String("handler", "AdminHandler"),
-		slog.String("func", "GetAllUsers"),
+		slog.String("func", "ListUsers"),
 	)
 
 	claims, err := domain.GetAuthClaims(c.Get("claims"))
@@ -42,36 +65,41 @@ func (h *AdminHandler) GetAllUsers(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
 	}
 
-	// Busca real de usuários do banco de dados
-	users, err := h.userRepo.GetAllUsers(c.Request().Context())
+	var filter domain.UserFilter
+	query, err := bindList(c, &filter)
 	if err != nil {
-		logger.Error("failed to get all users", slog.Any("error", err))
-		return c.JSON(http.StatusInternalServerError, domain.NewAPIError(http.StatusInternalServerError, "failed to retrieve users"))
+		logger.Error("invalid list parameters", slog.Any("error", err))
+		return respondError(c, err)
 	}
 
-	response := map[string]interface{}{
-		"admin_id":    claims.UserID,
-		"users":       users,
-		"total_count": len(users),
+	users, err := h.users.ListUsers(c.Request().Context(), filter, query)
+	if err != nil {
+		logger.Error("failed to list users", slog.Any("error", err))
+		return respondError(c, err)
 	}
 
-	logger.Info("admin accessed all users",
+	logger.Info("admin listed users",
 		slog.String("adminID", claims.UserID),
-		slog.Int("userCount", len(users)),
+		slog.Int("userCount", len(users.Items)),
 	)
 
-	return c.JSON(http.StatusOK, response)
+	return c.JSON(http.StatusOK, users)
 }
 
 // GetSystemStats godoc
 // @Summary Get system statistics (Admin only)
-// @Description Get system usage statistics and metrics
+// @Description Count the users by type, status and department, the users active in the last 24 hours, 7 days and 30 days, and the registrations per day or week of a period. Buckets without registrations are included with a zero count.
 // @Tags admin
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} map[string]interface{} "System statistics"
+// @Param from query string false "Start of the period (RFC 3339), moved back to the start of its bucket. Defaults to 30 days, or 12 weeks, before to"
+// @Param to query string false "End of the period, exclusive (RFC 3339). Defaults to now"
+// @Param interval query string false "Length of the registration buckets; weeks start on Monday, in UTC" Enums(day, week) default(day)
+// @Success 200 {object} domain.SystemStats "System statistics"
+// @Failure 400 {object} domain.APIError "Invalid period"
 // @Failure 401 {object} domain.APIError "Unauthorized"
 // @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 500 {object} domain.APIError "Internal server error"
 // @Router /admin/stats [get]
 func (h *AdminHandler) GetSystemStats(c echo.Context) error {
 	logger := slog.With(
@@ -85,40 +113,418 @@ func (h *AdminHandler) GetSystemStats(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
 	}
 
-	// Get all users to calculate statistics
-	users, err := h.userRepo.GetAllUsers(c.Request().Context())
+	var query domain.StatsQuery
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &query); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(query); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	stats, err := h.stats.GetSystemStats(c.Request().Context(), query)
+	if err != nil {
+		logger.Error("failed to get system stats", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	logger.Info("admin accessed system stats",
+		slog.String("adminID", claims.UserID),
+		slog.Int64("totalUsers", stats.TotalUsers),
+	)
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+// CreateUser godoc
+// @Summary Create user (Admin only)
+// @Description Create an account of any type, such as doctors, nurses and receptionists, with an initial password. The account starts active.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.CreateUserRequest true "Account data"
+// @Success 201 {object} domain.User "Created user"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 409 {object} domain.APIError "User, CPF or CRM already exists"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/users [post]
+func (h *AdminHandler) CreateUser(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AdminHandler"),
+		slog.String("func", "CreateUser"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
 	if err != nil {
-		logger.Error("failed to get all users for stats", slog.Any("error", err))
-		return c.JSON(http.StatusInternalServerError, domain.NewAPIError(http.StatusInternalServerError, "failed to retrieve user statistics"))
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
 	}
 
-	// Count users by type
-	userCounts := map[domain.UserType]int{
-		domain.UserTypePatient:      0,
-		domain.UserTypeDoctor:       0,
-		domain.UserTypeNurse:        0,
-		domain.UserTypeAdmin:        0,
-		domain.UserTypeReceptionist: 0,
+	var req domain.CreateUserRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
 	}
 
-	for _, user := range users {
-		userCounts[user.Type]++
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
 	}
 
-	stats := map[string]interface{}{
-		"admin_id":            claims.UserID,
-		"total_users":         len(users),
-		"total_patients":      userCounts[domain.UserTypePatient],
-		"total_doctors":       userCounts[domain.UserTypeDoctor],
-		"total_nurses":        userCounts[domain.UserTypeNurse],
-		"total_admins":        userCounts[domain.UserTypeAdmin],
-		"total_receptionists": userCounts[domain.UserTypeReceptionist],
+	user, err := h.users.CreateUser(c.Request().Context(), req, claims.UserID)
+	if err != nil {
+		logger.Error("failed to create user", slog.Any("error", err))
+		return respondError(c, err)
 	}
 
-	logger.Info("admin accessed system stats",
+	logger.Info("admin created user",
 		slog.String("adminID", claims.UserID),
-		slog.Int("totalUsers", len(users)),
+		slog.String("userID", user.ID),
+		slog.String("type", string(user.Type)),
 	)
 
-	return c.JSON(http.StatusOK, stats)
+	return c.JSON(http.StatusCreated, user)
+}
+
+// GetUser godoc
+// @Summary Get user (Admin only)
+// @Description Get a user by ID, including deleted users
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} domain.User "User"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "User not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/users/{id} [get]
+func (h *AdminHandler) GetUser(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AdminHandler"),
+		slog.String("func", "GetUser"),
+	)
+
+	user, err := h.users.GetUser(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		logger.Error("failed to get user", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// UpdateUser godoc
+// @Summary Update user (Admin only)
+// @Description Change the type or any profile field of a user. Omitted fields are kept. Changing the type ends every session of the user.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body domain.AdminUpdateUserRequest true "Type and profile fields to change"
+// @Success 200 {object} domain.User "Updated user"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "User not found"
+// @Failure 409 {object} domain.APIError "User is deleted, or CPF or CRM already registered"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/users/{id} [patch]
+func (h *AdminHandler) UpdateUser(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AdminHandler"),
+		slog.String("func", "UpdateUser"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.AdminUpdateUserRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	userID := c.Param("id")
+	user, err := h.users.UpdateUser(c.Request().Context(), userID, req, claims.UserID)
+	if err != nil {
+		logger.Error("failed to update user", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	logger.Info("admin updated user",
+		slog.String("adminID", claims.UserID),
+		slog.String("userID", userID),
+	)
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// DeleteUser godoc
+// @Summary Delete user (Admin only)
+// @Description Soft-delete a user and end every session. The account can't log in and its email stays reserved until it is restored.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} domain.User "Deleted user"
+// @Failure 400 {object} domain.APIError "Cannot delete your own account"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "User not found"
+// @Failure 409 {object} domain.APIError "User is already deleted"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/users/{id} [delete]
+func (h *AdminHandler) DeleteUser(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AdminHandler"),
+		slog.String("func", "DeleteUser"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	userID := c.Param("id")
+	user, err := h.users.DeleteUser(c.Request().Context(), userID, claims.UserID)
+	if err != nil {
+		logger.Error("failed to delete user", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	logger.Info("admin deleted user",
+		slog.String("adminID", claims.UserID),
+		slog.String("userID", userID),
+	)
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// RestoreUser godoc
+// @Summary Restore user (Admin only)
+// @Description Undo the deletion of a user. The account keeps the status it had when it was deleted.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} domain.User "Restored user"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "User not found"
+// @Failure 409 {object} domain.APIError "User is not deleted"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/users/{id}/restore [post]
+func (h *AdminHandler) RestoreUser(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AdminHandler"),
+		slog.String("func", "RestoreUser"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	userID := c.Param("id")
+	user, err := h.users.RestoreUser(c.Request().Context(), userID, claims.UserID)
+	if err != nil {
+		logger.Error("failed to restore user", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	logger.Info("admin restored user",
+		slog.String("adminID", claims.UserID),
+		slog.String("userID", userID),
+	)
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// UpdateUserStatus godoc
+// @Summary Change user status (Admin only)
+// @Description Activate, deactivate or block a user account. Leaving the active status revokes every session of the user.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body domain.UpdateStatusRequest true "New status and reason"
+// @Success 200 {object} domain.User "Updated user"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "User not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/users/{id}/status [patch]
+func (h *AdminHandler) UpdateUserStatus(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AdminHandler"),
+		slog.String("func", "UpdateUserStatus"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.UpdateStatusRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	userID := c.Param("id")
+	user, err := h.users.UpdateStatus(c.Request().Context(), userID, req, claims.UserID)
+	if err != nil {
+		logger.Error("failed to update user status", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	logger.Info("admin changed user status",
+		slog.String("adminID", claims.UserID),
+		slog.String("userID", userID),
+		slog.String("status", string(req.Status)),
+	)
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// VerifyUserEmail godoc
+// @Summary Verify user email (Admin only)
+// @Description Mark the email of a pending account as verified and activate it without a verification link
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} domain.User "Verified user"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "User not found"
+// @Failure 409 {object} domain.APIError "User is not awaiting email verification"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/users/{id}/verify-email [post]
+func (h *AdminHandler) VerifyUserEmail(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AdminHandler"),
+		slog.String("func", "VerifyUserEmail"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	userID := c.Param("id")
+	user, err := h.verifications.VerifyByAdmin(c.Request().Context(), userID, claims.UserID)
+	if err != nil {
+		logger.Error("failed to verify user email", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	logger.Info("admin verified user email",
+		slog.String("adminID", claims.UserID),
+		slog.String("userID", userID),
+	)
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// UnlockUser godoc
+// @Summary Unlock user login (Admin only)
+// @Description Clear the failed login counter of an account and reactivate it when it was blocked
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} domain.User "Unlocked user"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "User not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/users/{id}/unlock [post]
+func (h *AdminHandler) UnlockUser(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AdminHandler"),
+		slog.String("func", "UnlockUser"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	userID := c.Param("id")
+	user, err := h.throttle.Unlock(c.Request().Context(), userID, claims.UserID)
+	if err != nil {
+		logger.Error("failed to unlock user", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	logger.Info("admin unlocked user",
+		slog.String("adminID", claims.UserID),
+		slog.String("userID", userID),
+	)
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// GetAuditLogs godoc
+// @Summary List audit logs (Admin only)
+// @Description List the most recent audit events, newest first
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param target_id query string false "Filter by target user ID"
+// @Param actor_id query string false "Filter by actor ID"
+// @Param action query string false "Filter by action"
+// @Success 200 {array} domain.AuditEvent "Audit events"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/audit-logs [get]
+func (h *AdminHandler) GetAuditLogs(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AdminHandler"),
+		slog.String("func", "GetAuditLogs"),
+	)
+
+	var filter domain.AuditFilter
+	if err := c.Bind(&filter); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	events, err := h.audit.List(c.Request().Context(), filter, auditLogLimit)
+	if err != nil {
+		logger.Error("failed to list audit events", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, events)
 }
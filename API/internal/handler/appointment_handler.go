@@ -0,0 +1,322 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// AppointmentHandler handles appointment scheduling
+type AppointmentHandler struct {
+	appointmentService domain.AppointmentService
+}
+
+// NewAppointmentHandler creates a new instance of AppointmentHandler
+func NewAppointmentHandler(appointmentService domain.AppointmentService) *AppointmentHandler {
+	return &AppointmentHandler{appointmentService: appointmentService}
+}
+
+// Book godoc
+// @Summary Book appointment
+// @Description Book an appointment with a doctor. Patients book for themselves; receptionists, doctors and admins set patient_id. The time must be one of the slots of the doctor, by their weekly hours, exceptions and slot length, and fails when the doctor already has an appointment overlapping it.
+// @Tags appointments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.BookAppointmentRequest true "Doctor, patient and time"
+// @Success 201 {object} domain.Appointment "Appointment booked"
+// @Failure 400 {object} domain.APIError "Bad request, time in the past or not a slot of the doctor, or unknown doctor or patient"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 409 {object} domain.APIError "The doctor already has an appointment at this time"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /appointments [post]
+func (h *AppointmentHandler) Book(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AppointmentHandler"),
+		slog.String("func", "Book"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.BookAppointmentRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	appointment, err := h.appointmentService.Book(c.Request().Context(), req, claims)
+	if err != nil {
+		logger.Error("error booking appointment", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, appointment)
+}
+
+// List godoc
+// @Summary List appointments
+// @Description List appointments page by page, soonest first by default. Patients only get their own appointments. Follow next_cursor to get the next page with the same filters and sort; it is omitted on the last page.
+// @Tags appointments
+// @Produce json
+// @Security BearerAuth
+// @Param patient_id query string false "Filter by patient, ignored for patients"
+// @Param doctor_id query string false "Filter by doctor"
+// @Param status query string false "Filter by status" Enums(booked, rescheduled, cancelled, checked_in, no_show)
+// @Param from query string false "Starting at or after (RFC 3339)"
+// @Param to query string false "Starting before (RFC 3339)"
+// @Param sort query string false "Sort field, prefixed with - for descending order" Enums(starts_at, -starts_at, created_at, -created_at)
+// @Param limit query int false "Page size, up to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Success 200 {object} domain.AppointmentPage "Page of appointments"
+// @Failure 400 {object} domain.APIError "Invalid filter, sort or cursor"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /appointments [get]
+func (h *AppointmentHandler) List(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AppointmentHandler"),
+		slog.String("func", "List"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var filter domain.AppointmentFilter
+	query, err := bindList(c, &filter)
+	if err != nil {
+		logger.Error("invalid list parameters", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	appointments, err := h.appointmentService.List(c.Request().Context(), filter, query, claims)
+	if err != nil {
+		logger.Error("failed to list appointments", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, appointments)
+}
+
+// Get godoc
+// @Summary Get appointment
+// @Description Get an appointment and its history. Patients only get their own appointments.
+// @Tags appointments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Appointment ID"
+// @Success 200 {object} domain.Appointment "Appointment"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Appointment not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /appointments/{id} [get]
+func (h *AppointmentHandler) Get(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AppointmentHandler"),
+		slog.String("func", "Get"),
+		slog.String("appointmentID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	appointment, err := h.appointmentService.Get(c.Request().Context(), c.Param("id"), claims)
+	if err != nil {
+		logger.Error("error fetching appointment", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, appointment)
+}
+
+// Reschedule godoc
+// @Summary Reschedule appointment
+// @Description Move a booked or rescheduled appointment to another slot of the same doctor. The previous time is kept in the history.
+// @Tags appointments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Appointment ID"
+// @Param request body domain.RescheduleAppointmentRequest true "New time"
+// @Success 200 {object} domain.Appointment "Appointment rescheduled"
+// @Failure 400 {object} domain.APIError "Bad request, or time in the past or not a slot of the doctor"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Appointment not found"
+// @Failure 409 {object} domain.APIError "Time taken or appointment already cancelled, checked in or missed"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /appointments/{id}/reschedule [post]
+func (h *AppointmentHandler) Reschedule(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AppointmentHandler"),
+		slog.String("func", "Reschedule"),
+		slog.String("appointmentID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.RescheduleAppointmentRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	appointment, err := h.appointmentService.Reschedule(c.Request().Context(), c.Param("id"), req, claims)
+	if err != nil {
+		logger.Error("error rescheduling appointment", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, appointment)
+}
+
+// Cancel godoc
+// @Summary Cancel appointment
+// @Description Cancel a booked or rescheduled appointment, freeing its time on the calendar of the doctor.
+// @Tags appointments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Appointment ID"
+// @Param request body domain.CancelAppointmentRequest true "Reason for the cancellation"
+// @Success 200 {object} domain.Appointment "Appointment cancelled"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Appointment not found"
+// @Failure 409 {object} domain.APIError "Appointment already cancelled, checked in or missed"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /appointments/{id}/cancel [post]
+func (h *AppointmentHandler) Cancel(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AppointmentHandler"),
+		slog.String("func", "Cancel"),
+		slog.String("appointmentID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.CancelAppointmentRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	appointment, err := h.appointmentService.Cancel(c.Request().Context(), c.Param("id"), req, claims)
+	if err != nil {
+		logger.Error("error cancelling appointment", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, appointment)
+}
+
+// CheckIn godoc
+// @Summary Check patient in
+// @Description Record that the patient of a booked or rescheduled appointment arrived (receptionists, doctors and admins), from 30 minutes before it starts until it ends.
+// @Tags appointments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Appointment ID"
+// @Success 200 {object} domain.Appointment "Patient checked in"
+// @Failure 400 {object} domain.APIError "Outside of the check-in window"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Appointment not found"
+// @Failure 409 {object} domain.APIError "Appointment already cancelled, checked in or missed"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /appointments/{id}/check-in [post]
+func (h *AppointmentHandler) CheckIn(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AppointmentHandler"),
+		slog.String("func", "CheckIn"),
+		slog.String("appointmentID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	appointment, err := h.appointmentService.CheckIn(c.Request().Context(), c.Param("id"), claims)
+	if err != nil {
+		logger.Error("error checking patient in", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, appointment)
+}
+
+// NoShow godoc
+// @Summary Mark no-show
+// @Description Record that the patient of a booked or rescheduled appointment didn't come, once it has started (receptionists, doctors and admins).
+// @Tags appointments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Appointment ID"
+// @Success 200 {object} domain.Appointment "Appointment marked as no-show"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Appointment not found"
+// @Failure 409 {object} domain.APIError "Appointment not started yet, or already cancelled, checked in or missed"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /appointments/{id}/no-show [post]
+func (h *AppointmentHandler) NoShow(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AppointmentHandler"),
+		slog.String("func", "NoShow"),
+		slog.String("appointmentID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	appointment, err := h.appointmentService.NoShow(c.Request().Context(), c.Param("id"), claims)
+	if err != nil {
+		logger.Error("error marking no-show", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, appointment)
+}
@@ -4,7 +4,6 @@ package handler
 import (
 	"log/slog"
 	"net/http"
-	"strings"
 
 	"github.com/labstack/echo/v4"
 
@@ -12,25 +11,31 @@ import (
 )
 
 type AuthHandler struct {
-	AuthService domain.AuthService
+	AuthService              domain.AuthService
+	PasswordResetService     domain.PasswordResetService
+	EmailVerificationService domain.EmailVerificationService
 }
 
-func NewAuthHandler(authService domain.AuthService) *AuthHandler {
+func NewAuthHandler(authService domain.AuthService, passwordResetService domain.PasswordResetService,
+	emailVerificationService domain.EmailVerificationService) *AuthHandler {
 	return &AuthHandler{
-		AuthService: authService,
+		AuthService:              authService,
+		PasswordResetService:     passwordResetService,
+		EmailVerificationService: emailVerificationService,
 	}
 }
 
 // Register godoc
-// @Summary Register a new user
-// @Description Register a new user with email, password, type and profile
+// @Summary Register a new patient
+// @Description Register a patient with email, password and profile. Staff accounts can't self-register and are created by admins or from an invitation.
 // @Tags authentication
 // @Accept json
 // @Produce json
 // @Param request body domain.RegisterRequest true "User registration data"
 // @Success 201 {object} domain.RegisterResponse "User registered successfully"
-// @Failure 400 {object} domain.APIError "Bad request"
-// @Failure 409 {object} domain.APIError "User already exists"
+// @Failure 400 {object} domain.APIError "Bad request or unknown user type"
+// @Failure 403 {object} domain.APIError "Type other than patient"
+// @Failure 409 {object} domain.APIError "User or CPF already exists"
 // @Failure 500 {object} domain.APIError "Internal server error"
 // @Router /auth/register [post]
 func (h *AuthHandler) Register(c echo.Context) error {
@@ -54,25 +59,22 @@ func (h *AuthHandler) Register(c echo.Context) error {
 
 	user, err := h.AuthService.RegisterWithProfile(ctx, req)
 	if err != nil {
-		if strings.Contains(err.Error(), "already exists") {
-			logger.Error("user already exists", slog.Any("error", err))
-			return c.JSON(http.StatusConflict, domain.NewAPIError(http.StatusConflict, err.Error()))
-		}
-		logger.Error("internal server error", slog.Any("error", err))
-		return c.JSON(http.StatusInternalServerError, domain.NewAPIError(http.StatusInternalServerError, err.Error()))
+		logger.Error("error registering user", slog.Any("error", err))
+		return respondError(c, err)
 	}
 
 	return c.JSON(http.StatusCreated, domain.RegisterResponse{
 		ID:      user.ID,
 		Email:   user.Email,
 		Type:    user.Type,
+		Status:  user.Status,
 		Profile: user.Profile,
 	})
 }
 
 // Login godoc
 // @Summary Login user
-// @Description Authenticate user and return JWT token
+// @Description Authenticate user and return JWT access and refresh tokens. When MFA is enabled or required for the user type, an MFA token is returned instead and the login is completed on /auth/mfa/verify.
 // @Tags authentication
 // @Accept json
 // @Produce json
@@ -80,6 +82,8 @@ func (h *AuthHandler) Register(c echo.Context) error {
 // @Success 200 {object} domain.LoginResponse "Login successful"
 // @Failure 400 {object} domain.APIError "Bad request"
 // @Failure 401 {object} domain.APIError "Invalid credentials"
+// @Failure 403 {object} domain.APIError "Account is inactive, pending or blocked"
+// @Failure 429 {object} domain.APIError "Too many failed login attempts"
 // @Failure 500 {object} domain.APIError "Internal server error"
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(c echo.Context) error {
@@ -101,13 +105,382 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
 	}
 
-	token, err := h.AuthService.Login(ctx, req.Email, req.Password)
+	result, err := h.AuthService.Login(ctx, req.Email, req.Password, c.RealIP())
 	if err != nil {
 		logger.Error("error during login", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	if result.Tokens == nil {
+		return c.JSON(http.StatusOK, domain.LoginResponse{
+			MFARequired:      true,
+			MFASetupRequired: result.MFASetupRequired,
+			MFAToken:         result.MFAToken,
+		})
+	}
+
+	return c.JSON(http.StatusOK, domain.LoginResponse{
+		Token:        result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
+	})
+}
+
+// Refresh godoc
+// @Summary Refresh tokens
+// @Description Exchange a refresh token for a new access and refresh token pair. Refresh tokens are single use; replaying one revokes the whole session.
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Param request body domain.RefreshRequest true "Refresh token"
+// @Success 200 {object} domain.LoginResponse "Tokens refreshed"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Invalid or reused refresh token"
+// @Failure 403 {object} domain.APIError "Account is inactive, pending or blocked"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/refresh [post]
+func (h *AuthHandler) Refresh(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	logger := slog.With(
+		slog.String("handler", "AuthHandler"),
+		slog.String("func", "Refresh"),
+	)
+
+	var req domain.RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	pair, err := h.AuthService.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		logger.Error("error during token refresh", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, domain.LoginResponse{
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	})
+}
+
+// Logout godoc
+// @Summary Logout
+// @Description Revoke the current access token and, when provided, the refresh token issued with it
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.LogoutRequest false "Refresh token to revoke"
+// @Success 204 "Logged out"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/logout [post]
+func (h *AuthHandler) Logout(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	logger := slog.With(
+		slog.String("handler", "AuthHandler"),
+		slog.String("func", "Logout"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
 		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
 	}
 
+	var req domain.LogoutRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := h.AuthService.Logout(ctx, claims, req.RefreshToken); err != nil {
+		logger.Error("error during logout", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// LogoutAll godoc
+// @Summary Logout from all sessions
+// @Description Revoke every access and refresh token issued to the authenticated user
+// @Tags authentication
+// @Produce json
+// @Security BearerAuth
+// @Success 204 "Logged out from all sessions"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/logout/all [post]
+func (h *AuthHandler) LogoutAll(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	logger := slog.With(
+		slog.String("handler", "AuthHandler"),
+		slog.String("func", "LogoutAll"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	if err := h.AuthService.LogoutAll(ctx, claims); err != nil {
+		logger.Error("error during logout", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ForgotPassword godoc
+// @Summary Request a password reset
+// @Description Email a single-use password reset link. The response is the same whether or not the email is registered.
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Param request body domain.ForgotPasswordRequest true "Account email"
+// @Success 202 {object} map[string]string "Reset link sent if the account exists"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/password/forgot [post]
+func (h *AuthHandler) ForgotPassword(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	logger := slog.With(
+		slog.String("handler", "AuthHandler"),
+		slog.String("func", "ForgotPassword"),
+	)
+
+	var req domain.ForgotPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := h.PasswordResetService.RequestReset(ctx, req.Email); err != nil {
+		logger.Error("error requesting password reset", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{
+		"message": "if the email is registered, a password reset link has been sent",
+	})
+}
+
+// ResetPassword godoc
+// @Summary Reset password
+// @Description Set a new password using a reset token. Every existing session of the user is revoked.
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Param request body domain.ResetPasswordRequest true "Reset token and new password"
+// @Success 204 "Password reset"
+// @Failure 400 {object} domain.APIError "Invalid or expired token"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/password/reset [post]
+func (h *AuthHandler) ResetPassword(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	logger := slog.With(
+		slog.String("handler", "AuthHandler"),
+		slog.String("func", "ResetPassword"),
+	)
+
+	var req domain.ResetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := h.PasswordResetService.ResetPassword(ctx, req.Token, req.Password); err != nil {
+		logger.Error("error resetting password", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// VerifyEmail godoc
+// @Summary Verify email
+// @Description Confirm the email of a new account using the link sent after registration and activate the account
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Param request body domain.VerifyEmailRequest true "Verification token"
+// @Success 204 "Email verified"
+// @Failure 400 {object} domain.APIError "Invalid or expired token"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/verify-email [post]
+func (h *AuthHandler) VerifyEmail(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	logger := slog.With(
+		slog.String("handler", "AuthHandler"),
+		slog.String("func", "VerifyEmail"),
+	)
+
+	var req domain.VerifyEmailRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if _, err := h.EmailVerificationService.Verify(ctx, req.Token); err != nil {
+		logger.Error("error verifying email", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ResendVerification godoc
+// @Summary Resend verification email
+// @Description Send a new verification link to a pending account. The response is the same whether or not the email is registered.
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Param request body domain.ResendVerificationRequest true "Account email"
+// @Success 202 {object} map[string]string "Verification link sent if the account is pending"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/verify-email/resend [post]
+func (h *AuthHandler) ResendVerification(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	logger := slog.With(
+		slog.String("handler", "AuthHandler"),
+		slog.String("func", "ResendVerification"),
+	)
+
+	var req domain.ResendVerificationRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := h.EmailVerificationService.Resend(ctx, req.Email); err != nil {
+		logger.Error("error resending verification email", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{
+		"message": "if the account is awaiting verification, a new link has been sent",
+	})
+}
+
+// SetupMFA godoc
+// @Summary Enroll in MFA during login
+// @Description Start a TOTP enrollment when the login response has mfa_setup_required. The enrollment is confirmed by the first successful /auth/mfa/verify.
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Param request body domain.MFASetupRequest true "MFA token from the login response"
+// @Success 200 {object} domain.MFASetup "Secret, otpauth URI and recovery codes"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Invalid or expired MFA token"
+// @Failure 409 {object} domain.APIError "MFA already enabled"
+// @Failure 429 {object} domain.APIError "Too many failed login attempts"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/mfa/setup [post]
+func (h *AuthHandler) SetupMFA(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	logger := slog.With(
+		slog.String("handler", "AuthHandler"),
+		slog.String("func", "SetupMFA"),
+	)
+
+	var req domain.MFASetupRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	setup, err := h.AuthService.SetupMFA(ctx, req.MFAToken, c.RealIP())
+	if err != nil {
+		logger.Error("error starting mfa enrollment", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, setup)
+}
+
+// VerifyMFA godoc
+// @Summary Complete an MFA login
+// @Description Exchange the MFA token from the login response and a TOTP or recovery code for JWT access and refresh tokens. Wrong codes count as failed logins of the account and the client IP and are throttled the same way. An MFA token stops working once it is used, or after 5 wrong codes, and the user must log in again.
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Param request body domain.MFAVerifyRequest true "MFA token and code"
+// @Success 200 {object} domain.LoginResponse "Login successful"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Invalid MFA token or code"
+// @Failure 403 {object} domain.APIError "Account is inactive, pending or blocked"
+// @Failure 429 {object} domain.APIError "Too many failed login attempts"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/mfa/verify [post]
+func (h *AuthHandler) VerifyMFA(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	logger := slog.With(
+		slog.String("handler", "AuthHandler"),
+		slog.String("func", "VerifyMFA"),
+	)
+
+	var req domain.MFAVerifyRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	pair, err := h.AuthService.VerifyMFA(ctx, req.MFAToken, req.Code, c.RealIP())
+	if err != nil {
+		logger.Error("error verifying mfa code", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
 	return c.JSON(http.StatusOK, domain.LoginResponse{
-		Token: token,
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
 	})
 }
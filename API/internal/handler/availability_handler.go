@@ -0,0 +1,281 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// AvailabilityHandler handles the calendars of the doctors
+type AvailabilityHandler struct {
+	availabilityService domain.AvailabilityService
+}
+
+// NewAvailabilityHandler creates a new instance of AvailabilityHandler
+func NewAvailabilityHandler(availabilityService domain.AvailabilityService) *AvailabilityHandler {
+	return &AvailabilityHandler{availabilityService: availabilityService}
+}
+
+// bindDateRange reads and validates the from and to query parameters
+func bindDateRange(c echo.Context) (domain.DateRange, error) {
+	var r domain.DateRange
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &r); err != nil {
+		return r, domain.NewAPIError(http.StatusBadRequest, err.Error())
+	}
+	if err := GetValidator().Struct(r); err != nil {
+		return r, domain.NewAPIError(http.StatusBadRequest, err.Error())
+	}
+	return r, nil
+}
+
+// GetAvailability godoc
+// @Summary Get weekly hours
+// @Description Get the weekly working hours of a doctor, in the timezone of the clinic. Doctors only get their own hours.
+// @Tags availability
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Doctor ID"
+// @Success 200 {object} domain.Availability "Weekly hours"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Doctor not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /doctors/{id}/availability [get]
+func (h *AvailabilityHandler) GetAvailability(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AvailabilityHandler"),
+		slog.String("func", "GetAvailability"),
+		slog.String("doctorID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	availability, err := h.availabilityService.GetAvailability(c.Request().Context(), c.Param("id"), claims)
+	if err != nil {
+		logger.Error("error getting availability", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, availability)
+}
+
+// SetAvailability godoc
+// @Summary Set weekly hours
+// @Description Replace the weekly working hours of a doctor, given as HH:MM in the timezone of the clinic. Hours of the same weekday can't overlap. Appointments already booked are kept.
+// @Tags availability
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Doctor ID"
+// @Param request body domain.SetAvailabilityRequest true "Weekly hours"
+// @Success 200 {object} domain.Availability "Weekly hours updated"
+// @Failure 400 {object} domain.APIError "Bad request or overlapping hours"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Doctor not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /doctors/{id}/availability [put]
+func (h *AvailabilityHandler) SetAvailability(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AvailabilityHandler"),
+		slog.String("func", "SetAvailability"),
+		slog.String("doctorID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.SetAvailabilityRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	availability, err := h.availabilityService.SetAvailability(c.Request().Context(), c.Param("id"), req, claims)
+	if err != nil {
+		logger.Error("error setting availability", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, availability)
+}
+
+// ListExceptions godoc
+// @Summary List availability exceptions
+// @Description List the exceptions to the weekly hours of a doctor covering any day from from to to, oldest first. From today onwards by default.
+// @Tags availability
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Doctor ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Success 200 {array} domain.AvailabilityException "Exceptions"
+// @Failure 400 {object} domain.APIError "Invalid dates"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Doctor not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /doctors/{id}/availability/exceptions [get]
+func (h *AvailabilityHandler) ListExceptions(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AvailabilityHandler"),
+		slog.String("func", "ListExceptions"),
+		slog.String("doctorID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	r, err := bindDateRange(c)
+	if err != nil {
+		logger.Error("invalid date range", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	exceptions, err := h.availabilityService.ListExceptions(c.Request().Context(), c.Param("id"), r, claims)
+	if err != nil {
+		logger.Error("error listing availability exceptions", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, exceptions)
+}
+
+// AddException godoc
+// @Summary Add availability exception
+// @Description Replace the weekly hours of a doctor from start_date to end_date, both inclusive, such as on holidays and vacations. Without hours the doctor doesn't work on those days. The most recent exception covering a day applies. Appointments already booked are kept.
+// @Tags availability
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Doctor ID"
+// @Param request body domain.CreateAvailabilityExceptionRequest true "Days and hours"
+// @Success 201 {object} domain.AvailabilityException "Exception added"
+// @Failure 400 {object} domain.APIError "Bad request, invalid dates or overlapping hours"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Doctor not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /doctors/{id}/availability/exceptions [post]
+func (h *AvailabilityHandler) AddException(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AvailabilityHandler"),
+		slog.String("func", "AddException"),
+		slog.String("doctorID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.CreateAvailabilityExceptionRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	exception, err := h.availabilityService.AddException(c.Request().Context(), c.Param("id"), req, claims)
+	if err != nil {
+		logger.Error("error adding availability exception", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, exception)
+}
+
+// DeleteException godoc
+// @Summary Delete availability exception
+// @Description Delete an exception, so the weekly hours apply again on its days
+// @Tags availability
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Doctor ID"
+// @Param exceptionId path string true "Exception ID"
+// @Success 204 "Exception deleted"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Doctor or exception not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /doctors/{id}/availability/exceptions/{exceptionId} [delete]
+func (h *AvailabilityHandler) DeleteException(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AvailabilityHandler"),
+		slog.String("func", "DeleteException"),
+		slog.String("doctorID", c.Param("id")),
+		slog.String("exceptionID", c.Param("exceptionId")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	if err := h.availabilityService.DeleteException(c.Request().Context(), c.Param("id"), c.Param("exceptionId"), claims); err != nil {
+		logger.Error("error deleting availability exception", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Slots godoc
+// @Summary List free slots
+// @Description List the slots of a doctor that can be booked from from to to, both inclusive and at most 31 days apart, soonest first. The days are taken in the timezone of the clinic, and slots already past or overlapping an appointment are left out. From today through the next 6 days by default.
+// @Tags availability
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Doctor ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Success 200 {object} domain.SlotList "Free slots"
+// @Failure 400 {object} domain.APIError "Invalid dates or range too long"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 404 {object} domain.APIError "Doctor not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /doctors/{id}/slots [get]
+func (h *AvailabilityHandler) Slots(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "AvailabilityHandler"),
+		slog.String("func", "Slots"),
+		slog.String("doctorID", c.Param("id")),
+	)
+
+	r, err := bindDateRange(c)
+	if err != nil {
+		logger.Error("invalid date range", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	slots, err := h.availabilityService.Slots(c.Request().Context(), c.Param("id"), r)
+	if err != nil {
+		logger.Error("error listing slots", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, slots)
+}
@@ -0,0 +1,309 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// ClinicalNoteHandler handles the clinical notes of the medical records
+type ClinicalNoteHandler struct {
+	noteService domain.ClinicalNoteService
+}
+
+// NewClinicalNoteHandler creates a new instance of ClinicalNoteHandler
+func NewClinicalNoteHandler(noteService domain.ClinicalNoteService) *ClinicalNoteHandler {
+	return &ClinicalNoteHandler{noteService: noteService}
+}
+
+// ListNotes godoc
+// @Summary List clinical notes
+// @Description List the clinical notes of a patient page by page with their amendments, newest first by default. Doctors get every note; patients get the signed notes of their own record. Nurses have no access. Follow next_cursor to get the next page with the same filters and sort; it is omitted on the last page.
+// @Tags clinical-notes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param status query string false "Filter by status, drafts are only seen by doctors and admins" Enums(draft, signed)
+// @Param encounter_id query string false "Filter by the encounter the notes were written in"
+// @Param sort query string false "Sort field, prefixed with - for descending order" Enums(created_at, -created_at)
+// @Param limit query int false "Page size, up to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Success 200 {object} domain.ClinicalNotePage "Page of notes"
+// @Failure 400 {object} domain.APIError "Invalid filter, sort or cursor"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/notes [get]
+func (h *ClinicalNoteHandler) ListNotes(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ClinicalNoteHandler"),
+		slog.String("func", "ListNotes"),
+		slog.String("patientID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var filter domain.ClinicalNoteFilter
+	query, err := bindList(c, &filter)
+	if err != nil {
+		logger.Error("invalid list parameters", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	notes, err := h.noteService.ListNotes(c.Request().Context(), c.Param("id"), filter, query, claims)
+	if err != nil {
+		logger.Error("failed to list clinical notes", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, notes)
+}
+
+// GetNote godoc
+// @Summary Get clinical note
+// @Description Get a clinical note with its signed original and amendments, oldest first, each with the version it amends, its author, time, reason and diff. The current text is that of the latest amendment. Notes the user can't see are reported as not found.
+// @Tags clinical-notes
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param noteId path string true "Note ID"
+// @Success 200 {object} domain.ClinicalNote "Note"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient or note not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/notes/{noteId} [get]
+func (h *ClinicalNoteHandler) GetNote(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ClinicalNoteHandler"),
+		slog.String("func", "GetNote"),
+		slog.String("patientID", c.Param("id")),
+		slog.String("noteID", c.Param("noteId")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	note, err := h.noteService.GetNote(c.Request().Context(), c.Param("id"), c.Param("noteId"), claims)
+	if err != nil {
+		logger.Error("error getting clinical note", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, note)
+}
+
+// CreateNote godoc
+// @Summary Write clinical note
+// @Description Write a clinical note, as a draft unless sign is set. Only doctors write notes.
+// @Tags clinical-notes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param request body domain.CreateClinicalNoteRequest true "Text of the note"
+// @Success 201 {object} domain.ClinicalNote "Note written"
+// @Failure 400 {object} domain.APIError "Bad request or unknown encounter"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/notes [post]
+func (h *ClinicalNoteHandler) CreateNote(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ClinicalNoteHandler"),
+		slog.String("func", "CreateNote"),
+		slog.String("patientID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.CreateClinicalNoteRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	note, err := h.noteService.CreateNote(c.Request().Context(), c.Param("id"), req, claims)
+	if err != nil {
+		logger.Error("error creating clinical note", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, note)
+}
+
+// UpdateNote godoc
+// @Summary Update clinical note draft
+// @Description Replace the text of a draft. Only the doctor who wrote the draft can change it; signed notes are corrected with amendments.
+// @Tags clinical-notes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param noteId path string true "Note ID"
+// @Param request body domain.UpdateClinicalNoteRequest true "New text of the draft"
+// @Success 200 {object} domain.ClinicalNote "Draft updated"
+// @Failure 400 {object} domain.APIError "Bad request or unknown encounter"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient or note not found"
+// @Failure 409 {object} domain.APIError "Note is signed"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/notes/{noteId} [patch]
+func (h *ClinicalNoteHandler) UpdateNote(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ClinicalNoteHandler"),
+		slog.String("func", "UpdateNote"),
+		slog.String("patientID", c.Param("id")),
+		slog.String("noteID", c.Param("noteId")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.UpdateClinicalNoteRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	note, err := h.noteService.UpdateNote(c.Request().Context(), c.Param("id"), c.Param("noteId"), req, claims)
+	if err != nil {
+		logger.Error("error updating clinical note", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, note)
+}
+
+// SignNote godoc
+// @Summary Sign clinical note
+// @Description Sign a draft as the first version of the note, after which its text only changes through amendments. Only the doctor who wrote the draft can sign it. Send the updated_at of the draft as read, so that a draft changed since is not signed unseen.
+// @Tags clinical-notes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param noteId path string true "Note ID"
+// @Param request body domain.SignClinicalNoteRequest true "Last update of the draft as read"
+// @Success 200 {object} domain.ClinicalNote "Note signed"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient or note not found"
+// @Failure 409 {object} domain.APIError "Note is already signed or was changed meanwhile"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/notes/{noteId}/sign [post]
+func (h *ClinicalNoteHandler) SignNote(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ClinicalNoteHandler"),
+		slog.String("func", "SignNote"),
+		slog.String("patientID", c.Param("id")),
+		slog.String("noteID", c.Param("noteId")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.SignClinicalNoteRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	note, err := h.noteService.SignNote(c.Request().Context(), c.Param("id"), c.Param("noteId"), req, claims)
+	if err != nil {
+		logger.Error("error signing clinical note", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, note)
+}
+
+// AmendNote godoc
+// @Summary Amend clinical note
+// @Description Correct a signed note with an amendment holding the full corrected text and the reason. The signed original and earlier amendments are kept unchanged, and the amendment records its ID, the version it amends, its time and the diff from that version. Only the doctor who signed the note can amend it, since they attested its text; other doctors write notes of their own. Set amends to the latest version seen to fail instead of amending a version that was corrected meanwhile.
+// @Tags clinical-notes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param noteId path string true "Note ID"
+// @Param request body domain.AmendClinicalNoteRequest true "Corrected text and reason"
+// @Success 201 {object} domain.ClinicalNote "Note amended"
+// @Failure 400 {object} domain.APIError "Bad request or unchanged text"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient or note not found"
+// @Failure 409 {object} domain.APIError "Note is a draft or has a later version"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/notes/{noteId}/amendments [post]
+func (h *ClinicalNoteHandler) AmendNote(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ClinicalNoteHandler"),
+		slog.String("func", "AmendNote"),
+		slog.String("patientID", c.Param("id")),
+		slog.String("noteID", c.Param("noteId")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.AmendClinicalNoteRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	note, err := h.noteService.AmendNote(c.Request().Context(), c.Param("id"), c.Param("noteId"), req, claims)
+	if err != nil {
+		logger.Error("error amending clinical note", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, note)
+}
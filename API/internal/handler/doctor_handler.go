@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// DoctorHandler handles the doctor directory
+type DoctorHandler struct {
+	directoryService domain.DoctorDirectoryService
+}
+
+// NewDoctorHandler creates a new instance of DoctorHandler
+func NewDoctorHandler(directoryService domain.DoctorDirectoryService) *DoctorHandler {
+	return &DoctorHandler{directoryService: directoryService}
+}
+
+// List godoc
+// @Summary List doctors
+// @Description List the active doctors page by page with their public profile and next free slot within 14 days, by first name by default. Contact details and documents other than the CRM are never included. Follow next_cursor to get the next page with the same filters and sort; it is omitted on the last page.
+// @Tags doctors
+// @Produce json
+// @Security BearerAuth
+// @Param search query string false "Words that each begin a word of the name, speciality or department"
+// @Param speciality query string false "Filter by speciality, ignoring case"
+// @Param department query string false "Filter by department, ignoring case"
+// @Param sort query string false "Sort field, prefixed with - for descending order" Enums(first_name, -first_name, last_name, -last_name)
+// @Param limit query int false "Page size, up to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Success 200 {object} domain.DoctorPage "Page of doctors"
+// @Failure 400 {object} domain.APIError "Invalid filter, sort or cursor"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /doctors [get]
+func (h *DoctorHandler) List(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "DoctorHandler"),
+		slog.String("func", "List"),
+	)
+
+	var filter domain.DoctorFilter
+	query, err := bindList(c, &filter)
+	if err != nil {
+		logger.Error("invalid list parameters", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	doctors, err := h.directoryService.List(c.Request().Context(), filter, query)
+	if err != nil {
+		logger.Error("failed to list doctors", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, doctors)
+}
+
+// Get godoc
+// @Summary Get doctor
+// @Description Get the public profile of an active doctor and its next free slot within 14 days
+// @Tags doctors
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Doctor ID"
+// @Success 200 {object} domain.DoctorProfile "Doctor"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 404 {object} domain.APIError "Doctor not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /doctors/{id} [get]
+func (h *DoctorHandler) Get(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "DoctorHandler"),
+		slog.String("func", "Get"),
+		slog.String("doctorID", c.Param("id")),
+	)
+
+	doctor, err := h.directoryService.Get(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		logger.Error("error getting doctor", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, doctor)
+}
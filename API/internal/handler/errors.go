@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// respondError writes err as a JSON error response, keeping the status of
+// domain API errors and falling back to 500 for anything else.
+func respondError(c echo.Context, err error) error {
+	var apiErr *domain.APIError
+	if errors.As(err, &apiErr) {
+		return c.JSON(apiErr.Status, apiErr)
+	}
+	return c.JSON(http.StatusInternalServerError, domain.NewInternalError(err.Error()))
+}
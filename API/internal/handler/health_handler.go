@@ -5,33 +5,75 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/vida-plus/api/internal/domain"
-	"go.mongodb.org/mongo-driver/mongo"
+	"github.com/vida-plus/api/internal/healthcheck"
+	"github.com/vida-plus/api/pkg/shutdown"
 )
 
 type HealthHandler struct {
-	mongoClient *mongo.Client
+	registry *healthcheck.Registry
+	shutdown *shutdown.Manager
 }
 
-func NewHealthHandler(mongoClient *mongo.Client) *HealthHandler {
+// NewHealthHandler creates a HealthHandler that reports not ready once shutdownManager starts
+// draining, so load balancers stop sending requests before the server stops accepting them.
+func NewHealthHandler(registry *healthcheck.Registry, shutdownManager *shutdown.Manager) *HealthHandler {
 	return &HealthHandler{
-		mongoClient: mongoClient,
+		registry: registry,
+		shutdown: shutdownManager,
 	}
 }
 
 // Check godoc
 // @Summary Health check
-// @Description Check the health status of the API and database connection
+// @Description Check the health status of the API and its critical components. Fails while the API is shutting down. Prefer /health/ready, which details each component.
 // @Tags health
 // @Produce json
 // @Success 200 {object} map[string]string "Service is healthy"
 // @Failure 503 {object} domain.APIError "Service unavailable"
 // @Router /health [get]
 func (h *HealthHandler) Check(c echo.Context) error {
-	if err := h.mongoClient.Ping(c.Request().Context(), nil); err != nil {
+	if h.shutdown.Draining() {
 		return c.JSON(http.StatusServiceUnavailable, domain.NewAPIError(
 			http.StatusServiceUnavailable,
-			"database health check failed",
+			"service is shutting down",
+		))
+	}
+	if report := h.registry.Ready(c.Request().Context()); report.Status == healthcheck.NotWorkingStatus {
+		return c.JSON(http.StatusServiceUnavailable, domain.NewAPIError(
+			http.StatusServiceUnavailable,
+			"health check failed",
 		))
 	}
 	return c.JSON(http.StatusOK, map[string]string{"status": "healthy"})
 }
+
+// Live godoc
+// @Summary Liveness probe
+// @Description Reports whether the process is up. It doesn't check the dependencies, so an unavailable database doesn't get the instance restarted.
+// @Tags health
+// @Produce json
+// @Success 200 {object} healthcheck.Report "Process is alive"
+// @Router /health/live [get]
+func (h *HealthHandler) Live(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.registry.Live())
+}
+
+// Ready godoc
+// @Summary Readiness probe
+// @Description Reports the status, latency and error of each component, such as MongoDB, the mailer and the signing keys. Results are cached for a few seconds. The instance is not ready while a critical component fails or while it shuts down, and degraded (still 200) while a non-critical one fails.
+// @Tags health
+// @Produce json
+// @Success 200 {object} healthcheck.Report "Ready to serve requests"
+// @Failure 503 {object} healthcheck.Report "Not ready"
+// @Router /health/ready [get]
+func (h *HealthHandler) Ready(c echo.Context) error {
+	if h.shutdown.Draining() {
+		return c.JSON(http.StatusServiceUnavailable, healthcheck.Report{Status: healthcheck.NotWorkingStatus})
+	}
+
+	report := h.registry.Ready(c.Request().Context())
+	if report.Status == healthcheck.NotWorkingStatus {
+		return c.JSON(http.StatusServiceUnavailable, report)
+	}
+	return c.JSON(http.StatusOK, report)
+}
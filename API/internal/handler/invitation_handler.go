@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// InvitationHandler handles staff onboarding through invitations
+type InvitationHandler struct {
+	invitationService domain.InvitationService
+}
+
+// NewInvitationHandler creates a new instance of InvitationHandler
+func NewInvitationHandler(invitationService domain.InvitationService) *InvitationHandler {
+	return &InvitationHandler{invitationService: invitationService}
+}
+
+// Invite godoc
+// @Summary Invite user (Admin only)
+// @Description Email an invitation link that creates an account of the given type. Inviting the same email again invalidates the previous link.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.InviteUserRequest true "Email and user type"
+// @Success 201 {object} domain.Invitation "Invitation sent"
+// @Failure 400 {object} domain.APIError "Bad request or unknown user type"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 409 {object} domain.APIError "User already exists"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/invitations [post]
+func (h *InvitationHandler) Invite(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "InvitationHandler"),
+		slog.String("func", "Invite"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.InviteUserRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	invitation, err := h.invitationService.Invite(c.Request().Context(), req, claims.UserID)
+	if err != nil {
+		logger.Error("error sending invitation", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, invitation)
+}
+
+// Accept godoc
+// @Summary Accept invitation
+// @Description Create an account from an invitation link. The email and user type come from the invitation, and the account starts active.
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Param request body domain.AcceptInvitationRequest true "Token from the invitation link, password and profile"
+// @Success 201 {object} domain.RegisterResponse "Account created"
+// @Failure 400 {object} domain.APIError "Bad request or invalid invitation"
+// @Failure 409 {object} domain.APIError "User, CPF or CRM already exists"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/invitations/accept [post]
+func (h *InvitationHandler) Accept(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "InvitationHandler"),
+		slog.String("func", "Accept"),
+	)
+
+	var req domain.AcceptInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	user, err := h.invitationService.Accept(c.Request().Context(), req)
+	if err != nil {
+		logger.Error("error accepting invitation", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, domain.RegisterResponse{
+		ID:      user.ID,
+		Email:   user.Email,
+		Type:    user.Type,
+		Status:  user.Status,
+		Profile: user.Profile,
+	})
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/pkg/jwks"
+)
+
+// jwksMaxAge is how long clients may cache the key set, in seconds. It must stay below
+// jwks.PublishAhead so scheduled keys reach verifiers before they sign tokens.
+const jwksMaxAge = "300"
+
+type JWKSHandler struct {
+	keys *jwks.KeySet
+}
+
+func NewJWKSHandler(keys *jwks.KeySet) *JWKSHandler {
+	return &JWKSHandler{
+		keys: keys,
+	}
+}
+
+// GetKeys godoc
+// @Summary JSON Web Key Set
+// @Description Public keys that verify tokens issued by Vida Plus, identified by the kid header of each token
+// @Tags authentication
+// @Produce json
+// @Success 200 {object} jwks.Set "Public signing keys"
+// @Router /.well-known/jwks.json [get]
+func (h *JWKSHandler) GetKeys(c echo.Context) error {
+	c.Response().Header().Set("Cache-Control", "public, max-age="+jwksMaxAge)
+	return c.JSON(http.StatusOK, h.keys.JWKS())
+}
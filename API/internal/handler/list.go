@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// bindList binds the query parameters of a list endpoint into filter, which is validated, and
+// into the returned paging and sorting parameters.
+func bindList(c echo.Context, filter any) (domain.ListQuery, error) {
+	var query domain.ListQuery
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, filter); err != nil {
+		return query, domain.NewAPIError(http.StatusBadRequest, err.Error())
+	}
+	if err := binder.BindQueryParams(c, &query); err != nil {
+		return query, domain.NewAPIError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := GetValidator().Struct(filter); err != nil {
+		return query, domain.NewAPIError(http.StatusBadRequest, err.Error())
+	}
+
+	return query, nil
+}
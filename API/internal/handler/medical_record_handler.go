@@ -0,0 +1,279 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// MedicalRecordHandler handles the medical records of patients
+type MedicalRecordHandler struct {
+	recordService domain.MedicalRecordService
+}
+
+// NewMedicalRecordHandler creates a new instance of MedicalRecordHandler
+func NewMedicalRecordHandler(recordService domain.MedicalRecordService) *MedicalRecordHandler {
+	return &MedicalRecordHandler{recordService: recordService}
+}
+
+// GetRecord godoc
+// @Summary Get medical record
+// @Description Get the final allergies, medications and diagnoses of a patient, newest first. Nurses get the basic view, without diagnosis notes. Patients only get their own record.
+// @Tags medical-records
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Success 200 {object} domain.MedicalRecord "Medical record"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record [get]
+func (h *MedicalRecordHandler) GetRecord(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MedicalRecordHandler"),
+		slog.String("func", "GetRecord"),
+		slog.String("patientID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	record, err := h.recordService.GetRecord(c.Request().Context(), c.Param("id"), claims)
+	if err != nil {
+		logger.Error("error getting medical record", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, record)
+}
+
+// ListEntries godoc
+// @Summary List medical record entries
+// @Description List the entries of the record of a patient page by page, newest first by default. Doctors get every entry; nurses get the basic view of final encounters, diagnoses, allergies and medications; patients get the final entries of their own record. Follow next_cursor to get the next page with the same filters and sort; it is omitted on the last page.
+// @Tags medical-records
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param kind query string false "Filter by kind" Enums(encounter, diagnosis, note, allergy, medication)
+// @Param status query string false "Filter by status, drafts are only seen by doctors and admins" Enums(draft, final)
+// @Param encounter_id query string false "Filter by the encounter the entries were recorded in"
+// @Param sort query string false "Sort field, prefixed with - for descending order" Enums(created_at, -created_at)
+// @Param limit query int false "Page size, up to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Success 200 {object} domain.RecordEntryPage "Page of entries"
+// @Failure 400 {object} domain.APIError "Invalid filter, sort or cursor"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/entries [get]
+func (h *MedicalRecordHandler) ListEntries(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MedicalRecordHandler"),
+		slog.String("func", "ListEntries"),
+		slog.String("patientID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var filter domain.RecordEntryFilter
+	query, err := bindList(c, &filter)
+	if err != nil {
+		logger.Error("invalid list parameters", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	entries, err := h.recordService.ListEntries(c.Request().Context(), c.Param("id"), filter, query, claims)
+	if err != nil {
+		logger.Error("failed to list record entries", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, entries)
+}
+
+// GetEntry godoc
+// @Summary Get medical record entry
+// @Description Get an entry of the record of a patient. Entries the user can't see are reported as not found.
+// @Tags medical-records
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param entryId path string true "Entry ID"
+// @Success 200 {object} domain.RecordEntry "Entry"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient or entry not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/entries/{entryId} [get]
+func (h *MedicalRecordHandler) GetEntry(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MedicalRecordHandler"),
+		slog.String("func", "GetEntry"),
+		slog.String("patientID", c.Param("id")),
+		slog.String("entryID", c.Param("entryId")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	entry, err := h.recordService.GetEntry(c.Request().Context(), c.Param("id"), c.Param("entryId"), claims)
+	if err != nil {
+		logger.Error("error getting record entry", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, entry)
+}
+
+// CreateEntry godoc
+// @Summary Add medical record entry
+// @Description Add an entry to the record of a patient, as a draft unless finalize is set. Only doctors write records, and the entry must only have the details of its kind.
+// @Tags medical-records
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param request body domain.CreateRecordEntryRequest true "Kind and details of the entry"
+// @Success 201 {object} domain.RecordEntry "Entry added"
+// @Failure 400 {object} domain.APIError "Bad request, or unknown encounter or appointment"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/entries [post]
+func (h *MedicalRecordHandler) CreateEntry(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MedicalRecordHandler"),
+		slog.String("func", "CreateEntry"),
+		slog.String("patientID", c.Param("id")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.CreateRecordEntryRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	entry, err := h.recordService.CreateEntry(c.Request().Context(), c.Param("id"), req, claims)
+	if err != nil {
+		logger.Error("error creating record entry", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, entry)
+}
+
+// UpdateEntry godoc
+// @Summary Update medical record draft
+// @Description Replace the details of a draft. Only the doctor who wrote the draft can change it, and final entries never change.
+// @Tags medical-records
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param entryId path string true "Entry ID"
+// @Param request body domain.UpdateRecordEntryRequest true "New details of the entry"
+// @Success 200 {object} domain.RecordEntry "Entry updated"
+// @Failure 400 {object} domain.APIError "Bad request, or unknown encounter or appointment"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient or entry not found"
+// @Failure 409 {object} domain.APIError "Entry is final"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/entries/{entryId} [patch]
+func (h *MedicalRecordHandler) UpdateEntry(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MedicalRecordHandler"),
+		slog.String("func", "UpdateEntry"),
+		slog.String("patientID", c.Param("id")),
+		slog.String("entryID", c.Param("entryId")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.UpdateRecordEntryRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	entry, err := h.recordService.UpdateEntry(c.Request().Context(), c.Param("id"), c.Param("entryId"), req, claims)
+	if err != nil {
+		logger.Error("error updating record entry", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, entry)
+}
+
+// FinalizeEntry godoc
+// @Summary Finalize medical record draft
+// @Description Make a draft final, after which it never changes and is seen by nurses and the patient. Only the doctor who wrote the draft can finalize it.
+// @Tags medical-records
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Patient ID"
+// @Param entryId path string true "Entry ID"
+// @Success 200 {object} domain.RecordEntry "Entry finalized"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 404 {object} domain.APIError "Patient or entry not found"
+// @Failure 409 {object} domain.APIError "Entry is already final"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /patients/{id}/medical-record/entries/{entryId}/finalize [post]
+func (h *MedicalRecordHandler) FinalizeEntry(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MedicalRecordHandler"),
+		slog.String("func", "FinalizeEntry"),
+		slog.String("patientID", c.Param("id")),
+		slog.String("entryID", c.Param("entryId")),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	entry, err := h.recordService.FinalizeEntry(c.Request().Context(), c.Param("id"), c.Param("entryId"), claims)
+	if err != nil {
+		logger.Error("error finalizing record entry", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, entry)
+}
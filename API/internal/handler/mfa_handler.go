@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// MFAHandler handles MFA enrollment and policy endpoints
+type MFAHandler struct {
+	mfaService domain.MFAService
+}
+
+// NewMFAHandler creates a new instance of MFAHandler
+func NewMFAHandler(mfaService domain.MFAService) *MFAHandler {
+	return &MFAHandler{mfaService: mfaService}
+}
+
+// Enroll godoc
+// @Summary Start MFA enrollment
+// @Description Generate a TOTP secret, otpauth URI and recovery codes for the authenticated user. MFA is enabled once confirmed with a code.
+// @Tags mfa
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.MFASetup "Secret, otpauth URI and recovery codes"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 409 {object} domain.APIError "MFA already enabled"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/mfa/enroll [post]
+func (h *MFAHandler) Enroll(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MFAHandler"),
+		slog.String("func", "Enroll"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	setup, err := h.mfaService.Enroll(c.Request().Context(), claims.UserID)
+	if err != nil {
+		logger.Error("error starting mfa enrollment", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, setup)
+}
+
+// Confirm godoc
+// @Summary Confirm MFA enrollment
+// @Description Enable MFA with a code from the authenticator app
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.MFACodeRequest true "TOTP code"
+// @Success 204 "MFA enabled"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized or invalid code"
+// @Failure 409 {object} domain.APIError "MFA already enabled"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/mfa/confirm [post]
+func (h *MFAHandler) Confirm(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MFAHandler"),
+		slog.String("func", "Confirm"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.MFACodeRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := h.mfaService.Confirm(c.Request().Context(), claims.UserID, req.Code); err != nil {
+		logger.Error("error confirming mfa enrollment", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Disable godoc
+// @Summary Disable MFA
+// @Description Remove the MFA enrollment of the authenticated user. Not allowed when MFA is required for the user type.
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.MFACodeRequest true "TOTP or recovery code"
+// @Success 204 "MFA disabled"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized or invalid code"
+// @Failure 403 {object} domain.APIError "MFA is required for the user type"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /auth/mfa/disable [post]
+func (h *MFAHandler) Disable(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MFAHandler"),
+		slog.String("func", "Disable"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.MFACodeRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := h.mfaService.Disable(c.Request().Context(), claims.UserID, req.Code); err != nil {
+		logger.Error("error disabling mfa", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetPolicies godoc
+// @Summary Get MFA policies (Admin only)
+// @Description Get whether MFA is required for each user type
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} domain.MFAPolicy "MFA policy of every user type"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/mfa/policies [get]
+func (h *MFAHandler) GetPolicies(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MFAHandler"),
+		slog.String("func", "GetPolicies"),
+	)
+
+	policies, err := h.mfaService.GetPolicies(c.Request().Context())
+	if err != nil {
+		logger.Error("error fetching mfa policies", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, policies)
+}
+
+// SetPolicy godoc
+// @Summary Set MFA policy (Admin only)
+// @Description Require or stop requiring MFA for a user type. Users of the type without MFA must enroll on their next login.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param type path string true "User type" Enums(patient, doctor, nurse, admin, receptionist)
+// @Param request body domain.MFAPolicyRequest true "Policy"
+// @Success 200 {object} domain.MFAPolicy "Updated policy"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Forbidden"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /admin/mfa/policies/{type} [put]
+func (h *MFAHandler) SetPolicy(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "MFAHandler"),
+		slog.String("func", "SetPolicy"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.MFAPolicyRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	policy, err := h.mfaService.SetPolicy(c.Request().Context(), req.Type, *req.Required, claims.UserID)
+	if err != nil {
+		logger.Error("error updating mfa policy", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	logger.Info("admin changed mfa policy",
+		slog.String("adminID", claims.UserID),
+		slog.String("userType", string(req.Type)),
+		slog.Bool("required", *req.Required),
+	)
+
+	return c.JSON(http.StatusOK, policy)
+}
@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// ProfileHandler handles self-service endpoints of the authenticated user
+type ProfileHandler struct {
+	profileService domain.ProfileService
+}
+
+// NewProfileHandler creates a new instance of ProfileHandler
+func NewProfileHandler(profileService domain.ProfileService) *ProfileHandler {
+	return &ProfileHandler{profileService: profileService}
+}
+
+// GetProfile godoc
+// @Summary Get own profile
+// @Description Get the account and profile of the authenticated user
+// @Tags profile
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.User "Authenticated user"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 404 {object} domain.APIError "User not found"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /profile [get]
+func (h *ProfileHandler) GetProfile(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ProfileHandler"),
+		slog.String("func", "GetProfile"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	user, err := h.profileService.GetProfile(c.Request().Context(), claims.UserID)
+	if err != nil {
+		logger.Error("error fetching profile", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// UpdateProfile godoc
+// @Summary Update own profile
+// @Description Change profile fields of the authenticated user. Omitted fields are kept. Each user type can only change its own fields, so patients can't set CRM or COREN.
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.UpdateProfileRequest true "Profile fields to change"
+// @Success 200 {object} domain.User "Updated user"
+// @Failure 400 {object} domain.APIError "Bad request"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 403 {object} domain.APIError "Field cannot be changed by this user type"
+// @Failure 409 {object} domain.APIError "CPF or CRM already registered"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /profile [patch]
+func (h *ProfileHandler) UpdateProfile(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ProfileHandler"),
+		slog.String("func", "UpdateProfile"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.UpdateProfileRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	user, err := h.profileService.UpdateProfile(c.Request().Context(), claims.UserID, req)
+	if err != nil {
+		logger.Error("error updating profile", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// ChangePassword godoc
+// @Summary Change own password
+// @Description Change the password of the authenticated user after checking the current one. Every session, including the current one, is ended.
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.ChangePasswordRequest true "Current and new password"
+// @Success 204 "Password changed"
+// @Failure 400 {object} domain.APIError "Bad request or incorrect current password"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /profile/password [post]
+func (h *ProfileHandler) ChangePassword(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ProfileHandler"),
+		slog.String("func", "ChangePassword"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.ChangePasswordRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := h.profileService.ChangePassword(c.Request().Context(), claims.UserID, req.CurrentPassword, req.NewPassword); err != nil {
+		logger.Error("error changing password", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ChangeEmail godoc
+// @Summary Change own email
+// @Description Email a confirmation link to the new address. The current email keeps working until the link is used.
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.ChangeEmailRequest true "Password and new email"
+// @Success 202 "Confirmation email sent"
+// @Failure 400 {object} domain.APIError "Bad request or incorrect password"
+// @Failure 401 {object} domain.APIError "Unauthorized"
+// @Failure 409 {object} domain.APIError "Email already in use"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /profile/email [post]
+func (h *ProfileHandler) ChangeEmail(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ProfileHandler"),
+		slog.String("func", "ChangeEmail"),
+	)
+
+	claims, err := domain.GetAuthClaims(c.Get("claims"))
+	if err != nil {
+		logger.Error("missing or invalid claims", slog.Any("error", err))
+		return c.JSON(http.StatusUnauthorized, domain.NewAPIError(http.StatusUnauthorized, err.Error()))
+	}
+
+	var req domain.ChangeEmailRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := h.profileService.RequestEmailChange(c.Request().Context(), claims.UserID, req.Password, req.NewEmail); err != nil {
+		logger.Error("error requesting email change", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+// ConfirmEmailChange godoc
+// @Summary Confirm email change
+// @Description Replace the account email with the new address the confirmation link was sent to
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Param request body domain.VerifyEmailRequest true "Token from the confirmation link"
+// @Success 200 {object} domain.User "Updated user"
+// @Failure 400 {object} domain.APIError "Invalid or expired token"
+// @Failure 409 {object} domain.APIError "Email already in use"
+// @Failure 500 {object} domain.APIError "Internal server error"
+// @Router /profile/email/confirm [post]
+func (h *ProfileHandler) ConfirmEmailChange(c echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "ProfileHandler"),
+		slog.String("func", "ConfirmEmailChange"),
+	)
+
+	var req domain.VerifyEmailRequest
+	if err := c.Bind(&req); err != nil {
+		logger.Error("error during binding request", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	if err := GetValidator().Struct(req); err != nil {
+		logger.Error("error during validate struct values", slog.Any("error", err))
+		return c.JSON(http.StatusBadRequest, domain.NewAPIError(http.StatusBadRequest, err.Error()))
+	}
+
+	user, err := h.profileService.ConfirmEmailChange(c.Request().Context(), req.Token)
+	if err != nil {
+		logger.Error("error confirming email change", slog.Any("error", err))
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
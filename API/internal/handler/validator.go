@@ -4,6 +4,8 @@ import (
 	"sync"
 
 	"github.com/go-playground/validator/v10"
+
+	"github.com/vida-plus/api/internal/domain"
 )
 
 var (
@@ -11,11 +13,46 @@ var (
 	once     sync.Once
 )
 
+// documentValidators check the Brazilian documents of profiles. Empty values are valid, so
+// clearing an optional field passes; the fields each type requires are checked by validateProfileType.
+var documentValidators = map[string]func(string) bool{
+	"cpf":   func(s string) bool { _, ok := domain.NormalizeCPF(s); return ok },
+	"crm":   func(s string) bool { _, ok := domain.NormalizeCRM(s); return ok },
+	"coren": func(s string) bool { _, ok := domain.NormalizeCOREN(s); return ok },
+	"phone": func(s string) bool { _, ok := domain.NormalizePhone(s); return ok },
+	// date_of_birth accepts past ISO 8601 dates, as in 1990-05-15
+	"date_of_birth": domain.ValidDateOfBirth,
+}
+
 func GetValidator() *validator.Validate {
 	if validate == nil {
 		once.Do(func() {
 			validate = validator.New()
+			// user_type accepts only the values of domain.UserTypes
+			validate.RegisterValidation("user_type", func(fl validator.FieldLevel) bool {
+				return domain.UserType(fl.Field().String()).IsValid()
+			})
+			// clock_end accepts the end of hours, a time of day or domain.EndOfDay
+			validate.RegisterValidation("clock_end", func(fl validator.FieldLevel) bool {
+				return domain.IsValidEnd(fl.Field().String())
+			})
+			for tag, valid := range documentValidators {
+				validate.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+					value := fl.Field().String()
+					return value == "" || valid(value)
+				})
+			}
+			validate.RegisterStructValidation(validateProfileType, domain.CreateUserRequest{})
 		})
 	}
 	return validate
 }
+
+// validateProfileType reports the profile fields the type of a new account requires, such as
+// the CRM of doctors, as required. Self-registration only creates patients, which require none.
+func validateProfileType(sl validator.StructLevel) {
+	req := sl.Current().Interface().(domain.CreateUserRequest)
+	for _, name := range req.Profile.MissingFields(req.Type) {
+		sl.ReportError(nil, "profile."+name, "Profile."+name, "required", string(req.Type))
+	}
+}
@@ -1,55 +1,189 @@
+// Package healthcheck aggregates the health of the components the API depends on, such as the
+// database, the mailer and the signing keys, for the liveness and readiness endpoints.
 package healthcheck
 
 import (
 	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-// HealthCheckService interface defines health check operations
-type HealthCheckService interface {
-	Status(ctx context.Context) *HealthCheckResultItem
-}
-
-// HealthCheckResultItem represents the result of a health check
-type HealthCheckResultItem struct {
-	Status string `json:"status"`
-	Error  string `json:"error,omitempty"`
-}
-
 const (
 	WorkingStatus    = "working"
 	NotWorkingStatus = "not_working"
+	// DegradedStatus means a non-critical component is failing, so the instance still serves requests
+	DegradedStatus = "degraded"
 )
 
-// HealthCheckServiceImpl implements HealthCheckService
-type HealthCheckServiceImpl struct {
-	mongoClient *mongo.Client
+// Check reports whether a component works. It must return once ctx is done.
+type Check func(ctx context.Context) error
+
+// Component is a dependency of the API whose health is checked.
+type Component struct {
+	Name  string
+	Check Check
+	// Timeout bounds each run of Check.
+	Timeout time.Duration
+	// Critical components make the instance not ready when they fail. Failures of the others
+	// only degrade it.
+	Critical bool
+}
+
+// ComponentResult is the outcome of the last check of a component.
+type ComponentResult struct {
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	Critical  bool      `json:"critical"`
+	LatencyMS float64   `json:"latency_ms"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Report is the health of the instance and of each of its components.
+type Report struct {
+	Status     string            `json:"status"`
+	Components []ComponentResult `json:"components,omitempty"`
+}
+
+// component is a registered Component and its cached result. mu is held while the check runs,
+// so concurrent requests wait for one check instead of each running their own.
+type component struct {
+	Component
+	mu     sync.Mutex
+	result ComponentResult
+}
+
+// Registry runs the checks of the registered components and caches their results.
+type Registry struct {
+	cacheTTL time.Duration
+	now      func() time.Time
+
+	mu         sync.RWMutex
+	components []*component
+}
+
+// NewRegistry creates a Registry that reuses each result for cacheTTL, so frequent probes don't
+// hammer the components.
+func NewRegistry(cacheTTL time.Duration) *Registry {
+	return &Registry{cacheTTL: cacheTTL, now: time.Now}
+}
+
+// Register adds a component, which is reported in the order it was registered.
+func (r *Registry) Register(c Component) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.components = append(r.components, &component{Component: c})
+}
+
+// Live reports whether the process can serve requests. It doesn't check the components, so
+// an unavailable dependency doesn't get the instance restarted.
+func (r *Registry) Live() Report {
+	return Report{Status: WorkingStatus}
 }
 
-// NewHealthCheckService creates a new HealthCheckService
-func NewHealthCheckService(mongoClient *mongo.Client) HealthCheckService {
-	return &HealthCheckServiceImpl{mongoClient: mongoClient}
+// Ready checks every component in parallel, reusing results younger than the cache TTL. It is
+// not working when a critical component fails and degraded when any other one does.
+func (r *Registry) Ready(ctx context.Context) Report {
+	r.mu.RLock()
+	components := append([]*component(nil), r.components...)
+	r.mu.RUnlock()
+
+	results := make([]ComponentResult, len(components))
+	var wg sync.WaitGroup
+	for i, c := range components {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = r.result(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: WorkingStatus, Components: results}
+	for _, result := range results {
+		if result.Status == WorkingStatus {
+			continue
+		}
+		if result.Critical {
+			report.Status = NotWorkingStatus
+			break
+		}
+		report.Status = DegradedStatus
+	}
+	return report
+}
+
+// result returns the cached result of c or checks it again once it is stale
+func (r *Registry) result(ctx context.Context, c *component) ComponentResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.result.CheckedAt.IsZero() && r.now().Sub(c.result.CheckedAt) < r.cacheTTL {
+		return c.result
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	start := r.now()
+	err := run(checkCtx, c.Check)
+	result := ComponentResult{
+		Name:      c.Name,
+		Status:    WorkingStatus,
+		Critical:  c.Critical,
+		LatencyMS: float64(r.now().Sub(start).Microseconds()) / 1000,
+		CheckedAt: start,
+	}
+	if err != nil {
+		result.Status = NotWorkingStatus
+		result.Error = err.Error()
+	}
+
+	// A check cut short by the caller going away says nothing about the component
+	if ctx.Err() == nil {
+		c.result = result
+	}
+	return result
 }
 
-// Status checks the health of the database connection
-func (h *HealthCheckServiceImpl) Status(ctx context.Context) *HealthCheckResultItem {
-	if h.mongoClient == nil {
-		return &HealthCheckResultItem{
-			Status: NotWorkingStatus,
-			Error:  "MongoDB client not initialized",
+// run calls check, giving up when ctx is done even if check doesn't
+func run(ctx context.Context, check Check) error {
+	done := make(chan error, 1)
+	go func() { done <- check(ctx) }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return errors.New("check timed out")
 		}
+		return ctx.Err()
 	}
+}
 
-	if err := h.mongoClient.Ping(ctx, nil); err != nil {
-		return &HealthCheckResultItem{
-			Status: NotWorkingStatus,
-			Error:  "Failed to ping MongoDB: " + err.Error(),
+// MongoCheck pings the MongoDB deployment of client
+func MongoCheck(client *mongo.Client) Check {
+	return func(ctx context.Context) error {
+		if client == nil {
+			return errors.New("MongoDB client not initialized")
 		}
+		return client.Ping(ctx, nil)
 	}
+}
 
-	return &HealthCheckResultItem{
-		Status: WorkingStatus,
-		Error:  "",
+// TCPCheck opens and closes a connection to address, such as the SMTP server
+func TCPCheck(address string) Check {
+	return func(ctx context.Context) error {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
 	}
 }
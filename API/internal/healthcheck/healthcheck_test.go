@@ -0,0 +1,129 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func staticCheck(err error) Check {
+	return func(ctx context.Context) error { return err }
+}
+
+func Test_Registry_Ready(t *testing.T) {
+	tests := []struct {
+		name       string
+		components []Component
+		status     string
+	}{
+		{"NO_COMPONENTS", nil, WorkingStatus},
+		{"ALL_WORKING", []Component{
+			{Name: "mongodb", Check: staticCheck(nil), Timeout: time.Second, Critical: true},
+			{Name: "mailer", Check: staticCheck(nil), Timeout: time.Second},
+		}, WorkingStatus},
+		{"NON_CRITICAL_FAILING", []Component{
+			{Name: "mongodb", Check: staticCheck(nil), Timeout: time.Second, Critical: true},
+			{Name: "mailer", Check: staticCheck(errors.New("connection refused")), Timeout: time.Second},
+		}, DegradedStatus},
+		{"CRITICAL_FAILING", []Component{
+			{Name: "mongodb", Check: staticCheck(errors.New("connection refused")), Timeout: time.Second, Critical: true},
+			{Name: "mailer", Check: staticCheck(errors.New("connection refused")), Timeout: time.Second},
+		}, NotWorkingStatus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry(time.Minute)
+			for _, c := range tt.components {
+				registry.Register(c)
+			}
+
+			report := registry.Ready(context.Background())
+			assert.Equal(t, tt.status, report.Status)
+			require.Len(t, report.Components, len(tt.components))
+			for i, c := range tt.components {
+				assert.Equal(t, c.Name, report.Components[i].Name)
+				assert.Equal(t, c.Critical, report.Components[i].Critical)
+			}
+		})
+	}
+}
+
+func Test_Registry_Ready_Timeout(t *testing.T) {
+	unblock := make(chan struct{})
+	defer close(unblock)
+
+	registry := NewRegistry(time.Minute)
+	registry.Register(Component{
+		Name: "stuck",
+		// Ignores its context
+		Check: func(ctx context.Context) error {
+			<-unblock
+			return nil
+		},
+		Timeout:  10 * time.Millisecond,
+		Critical: true,
+	})
+
+	report := registry.Ready(context.Background())
+	assert.Equal(t, NotWorkingStatus, report.Status)
+	assert.Equal(t, "check timed out", report.Components[0].Error)
+}
+
+func Test_Registry_Ready_Cache(t *testing.T) {
+	var calls atomic.Int32
+	registry := NewRegistry(time.Minute)
+	now := time.Now()
+	registry.now = func() time.Time { return now }
+	registry.Register(Component{
+		Name: "mongodb",
+		Check: func(ctx context.Context) error {
+			calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		},
+		Timeout: time.Second,
+	})
+
+	// Concurrent probes share one check
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			registry.Ready(context.Background())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+
+	// The result is checked again once stale
+	now = now.Add(time.Minute)
+	registry.Ready(context.Background())
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func Test_Registry_Live(t *testing.T) {
+	registry := NewRegistry(time.Minute)
+	registry.Register(Component{Name: "mongodb", Check: staticCheck(errors.New("down")), Timeout: time.Second, Critical: true})
+
+	// Liveness doesn't depend on the components
+	assert.Equal(t, Report{Status: WorkingStatus}, registry.Live())
+}
+
+func Test_TCPCheck(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+
+	assert.NoError(t, TCPCheck(address)(context.Background()))
+
+	require.NoError(t, listener.Close())
+	assert.Error(t, TCPCheck(address)(context.Background()))
+}
@@ -7,8 +7,8 @@ import (
 	"github.com/vida-plus/api/internal/domain"
 )
 
-// RequirePermission creates a middleware that checks if user has specific permission
-func RequirePermission(permission string) echo.MiddlewareFunc {
+// RequirePermission creates a middleware that checks if user has any of the given permissions
+func RequirePermission(permissions ...string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			claims, err := domain.GetAuthClaims(c.Get("claims"))
@@ -23,14 +23,16 @@ func RequirePermission(permission string) echo.MiddlewareFunc {
 			// Por simplicidade, vamos usar apenas o tipo do usuário do token
 			user := &domain.User{Type: claims.UserType}
 
-			if !user.HasPermission(permission) {
-				return c.JSON(http.StatusForbidden, domain.NewAPIError(
-					http.StatusForbidden,
-					"insufficient permissions",
-				))
+			for _, permission := range permissions {
+				if user.HasPermission(permission) {
+					return next(c)
+				}
 			}
 
-			return next(c)
+			return c.JSON(http.StatusForbidden, domain.NewAPIError(
+				http.StatusForbidden,
+				"insufficient permissions",
+			))
 		}
 	}
 }
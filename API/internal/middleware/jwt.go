@@ -17,8 +17,9 @@ func AuthMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-// JWTMiddleware validates JWT from Authorization header and sets user info in context.
-func JWTMiddleware(jwtManager domain.JWTManager) echo.MiddlewareFunc {
+// JWTMiddleware validates JWT from Authorization header, rejects revoked tokens and tokens of
+// users that are no longer active, and sets user info in context.
+func JWTMiddleware(jwtManager domain.JWTManager, revocations domain.TokenRevocationStore, statuses domain.UserStatusCache) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			header := c.Request().Header.Get("Authorization")
@@ -30,6 +31,23 @@ func JWTMiddleware(jwtManager domain.JWTManager) echo.MiddlewareFunc {
 			if err != nil {
 				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid token"})
 			}
+			revoked, err := revocations.IsRevoked(c.Request().Context(), claims)
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "unable to validate token"})
+			}
+			if revoked {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "token has been revoked"})
+			}
+			status, err := statuses.GetStatus(c.Request().Context(), claims.UserID)
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "unable to validate token"})
+			}
+			if status == "" {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid token"})
+			}
+			if status != domain.UserStatusActive {
+				return c.JSON(http.StatusForbidden, domain.NewAccountStatusError(status))
+			}
 			c.Set("claims", claims)
 			return next(c)
 		}
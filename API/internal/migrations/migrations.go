@@ -0,0 +1,11 @@
+// Package migrations holds the changes applied to the vida_plus database, in the order of their
+// versions. New migrations are added to All and never edited once deployed.
+package migrations
+
+import "github.com/vida-plus/api/pkg/migrate"
+
+// All is every migration of the API
+var All = []migrate.Migration{
+	normalizeProfileDocuments,
+	moveNoteEntries,
+}
@@ -0,0 +1,80 @@
+package migrations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg/migrate"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func Test_All(t *testing.T) {
+	_, err := migrate.New((&mongo.Client{}).Database("test"), All)
+	assert.NoError(t, err)
+}
+
+func Test_NormalizedProfileFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile domain.UserProfile
+		want    bson.M
+	}{
+		{"CANONICAL", domain.UserProfile{Phone: "+5511988888888", CPF: "123.456.789-09", CRM: "CRM/SP 12345"}, bson.M{}},
+		{"UNFORMATTED", domain.UserProfile{Phone: "(11) 98888-8888", CPF: "12345678909", CRM: "crm-sp 12345", COREN: "corensp123456"},
+			bson.M{"profile.phone": "+5511988888888", "profile.cpf": "123.456.789-09", "profile.crm": "CRM/SP 12345", "profile.coren": "COREN-SP 123456"}},
+		{"INVALID", domain.UserProfile{Phone: "123", CPF: "111.111.111-11", CRM: "CRM/XX 1"}, bson.M{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizedProfileFields(tt.profile))
+		})
+	}
+}
+
+func Test_ClinicalNoteFromEntry(t *testing.T) {
+	created := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	finalized := created.Add(time.Hour)
+	content := &domain.NoteContent{Title: "Evolução", Body: "Estável"}
+
+	tests := []struct {
+		name       string
+		status     domain.RecordEntryStatus
+		finalized  *time.Time
+		wantStatus domain.NoteStatus
+		wantSigned *time.Time
+	}{
+		{"DRAFT", domain.RecordEntryDraft, nil, domain.NoteDraft, nil},
+		{"FINAL", domain.RecordEntryFinal, &finalized, domain.NoteSigned, &finalized},
+		{"FINAL WITHOUT TIME", domain.RecordEntryFinal, nil, domain.NoteSigned, &created},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			note := clinicalNoteFromEntry(&domain.RecordEntry{
+				ID:            "entry-1",
+				PatientID:     "patient-1",
+				Kind:          domain.RecordEntryNote,
+				Status:        tt.status,
+				EncounterID:   "encounter-1",
+				RecordContent: domain.RecordContent{Note: content},
+				AuthorID:      "doctor-1",
+				CreatedAt:     created,
+				UpdatedAt:     created,
+				FinalizedAt:   tt.finalized,
+			})
+			assert.Equal(t, &domain.ClinicalNote{
+				ID:          "entry-1",
+				PatientID:   "patient-1",
+				Status:      tt.wantStatus,
+				EncounterID: "encounter-1",
+				NoteContent: *content,
+				AuthorID:    "doctor-1",
+				CreatedAt:   created,
+				UpdatedAt:   created,
+				SignedAt:    tt.wantSigned,
+			}, note)
+		})
+	}
+}
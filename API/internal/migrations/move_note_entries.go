@@ -0,0 +1,70 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg/migrate"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// moveNoteEntries moves the note entries of medical records, written before clinical notes had
+// their own collection, to clinical notes with the same IDs. Final entries become notes signed
+// when they were finalized, and drafts stay drafts of their author. Moved notes can't be told
+// from the others, so it can't be rolled back.
+var moveNoteEntries = migrate.Migration{
+	Version: 20261017090000,
+	Name:    "move_note_entries_to_clinical_notes",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		entries := db.Collection("medical_record_entries")
+		notes := db.Collection("clinical_notes")
+
+		cursor, err := entries.Find(ctx, bson.M{"kind": domain.RecordEntryNote})
+		if err != nil {
+			return fmt.Errorf("finding note entries: %w", err)
+		}
+		defer cursor.Close(ctx)
+
+		for cursor.Next(ctx) {
+			var entry domain.RecordEntry
+			if err := cursor.Decode(&entry); err != nil {
+				return fmt.Errorf("decoding note entry: %w", err)
+			}
+
+			// The note already exists when a previous run stopped before removing the entry
+			if _, err := notes.InsertOne(ctx, clinicalNoteFromEntry(&entry)); err != nil && !mongo.IsDuplicateKeyError(err) {
+				return fmt.Errorf("moving note entry %s: %w", entry.ID, err)
+			}
+			if _, err := entries.DeleteOne(ctx, bson.M{"_id": entry.ID}); err != nil {
+				return fmt.Errorf("removing note entry %s: %w", entry.ID, err)
+			}
+		}
+		return cursor.Err()
+	},
+}
+
+// clinicalNoteFromEntry returns the clinical note a note entry becomes
+func clinicalNoteFromEntry(entry *domain.RecordEntry) *domain.ClinicalNote {
+	note := &domain.ClinicalNote{
+		ID:          entry.ID,
+		PatientID:   entry.PatientID,
+		Status:      domain.NoteDraft,
+		EncounterID: entry.EncounterID,
+		AuthorID:    entry.AuthorID,
+		CreatedAt:   entry.CreatedAt,
+		UpdatedAt:   entry.UpdatedAt,
+	}
+	if entry.Note != nil {
+		note.NoteContent = *entry.Note
+	}
+	if entry.Status == domain.RecordEntryFinal {
+		note.Status = domain.NoteSigned
+		note.SignedAt = entry.FinalizedAt
+		if note.SignedAt == nil {
+			note.SignedAt = &note.UpdatedAt
+		}
+	}
+	return note
+}
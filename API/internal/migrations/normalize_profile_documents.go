@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg/migrate"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// normalizeProfileDocuments puts the phones and documents of users created before they were
+// validated in the canonical format, so they match the unique indexes and lookups. Invalid
+// values are left for the users to fix, and the original formatting isn't kept, so it can't be
+// rolled back.
+var normalizeProfileDocuments = migrate.Migration{
+	Version: 20261016120000,
+	Name:    "normalize_profile_documents",
+	Up: func(ctx context.Context, db *mongo.Database) error {
+		users := db.Collection("users")
+
+		cursor, err := users.Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{"profile": 1}))
+		if err != nil {
+			return fmt.Errorf("finding users: %w", err)
+		}
+		defer cursor.Close(ctx)
+
+		for cursor.Next(ctx) {
+			var user domain.User
+			if err := cursor.Decode(&user); err != nil {
+				return fmt.Errorf("decoding user: %w", err)
+			}
+
+			changes := normalizedProfileFields(user.Profile)
+			if len(changes) == 0 {
+				continue
+			}
+
+			if _, err := users.UpdateOne(ctx, bson.M{"_id": user.ID}, bson.M{"$set": changes}); err != nil {
+				// Another user already has the normalized document, which an admin must resolve
+				if mongo.IsDuplicateKeyError(err) {
+					slog.Warn("skipping user with a duplicate document", slog.String("user_id", user.ID))
+					continue
+				}
+				return fmt.Errorf("updating user %s: %w", user.ID, err)
+			}
+		}
+		return cursor.Err()
+	},
+}
+
+// normalizedProfileFields returns the profile fields whose valid value isn't in the canonical format
+func normalizedProfileFields(profile domain.UserProfile) bson.M {
+	documents := []struct {
+		field     string
+		value     string
+		normalize func(string) (string, bool)
+	}{
+		{"profile.phone", profile.Phone, domain.NormalizePhone},
+		{"profile.cpf", profile.CPF, domain.NormalizeCPF},
+		{"profile.crm", profile.CRM, domain.NormalizeCRM},
+		{"profile.coren", profile.COREN, domain.NormalizeCOREN},
+	}
+
+	changes := bson.M{}
+	for _, document := range documents {
+		if document.value == "" {
+			continue
+		}
+		if normalized, ok := document.normalize(document.value); ok && normalized != document.value {
+			changes[document.field] = normalized
+		}
+	}
+	return changes
+}
@@ -0,0 +1,289 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// appointmentSortFields maps domain.AppointmentSortFields to their document paths and types
+var appointmentSortFields = map[string]sortField{
+	"starts_at":  {Path: "starts_at", Type: bson.TypeDateTime},
+	"created_at": {Path: "created_at", Type: bson.TypeDateTime},
+}
+
+// errAppointmentConflict is returned when an appointment would overlap another one of the doctor
+var errAppointmentConflict = domain.NewConflictError("the doctor already has an appointment at this time")
+
+type AppointmentRepository struct {
+	collection *mongo.Collection
+	locks      *calendarLocks
+}
+
+func NewAppointmentRepository(db *mongo.Database) domain.AppointmentRepository {
+	return &AppointmentRepository{
+		collection: db.Collection("appointments"),
+		locks:      newCalendarLocks(db),
+	}
+}
+
+// Create inserts the appointment unless check fails or it overlaps another one of the doctor. The
+// calendar of the doctor is locked from the checks until the insert, so concurrent bookings of the
+// same time keep exactly one of them.
+func (r *AppointmentRepository) Create(ctx context.Context, appointment *domain.Appointment, check func(ctx context.Context) error) error {
+	logger := slog.With(
+		slog.String("repository", "AppointmentRepository"),
+		slog.String("method", "Create"),
+		slog.String("appointmentID", appointment.ID),
+		slog.String("doctorID", appointment.DoctorID),
+	)
+
+	return r.locks.withLock(ctx, appointment.DoctorID, func(ctx context.Context) error {
+		if err := check(ctx); err != nil {
+			logger.Info("appointment doesn't fit the calendar", slog.Any("error", err))
+			return err
+		}
+		conflict, err := r.hasConflict(ctx, appointment)
+		if err != nil {
+			logger.Error("failed to check conflicts", slog.Any("error", err))
+			return domain.NewInternalError("failed to create appointment")
+		}
+		if conflict {
+			logger.Info("appointment conflicts with another one")
+			return errAppointmentConflict
+		}
+
+		if _, err := r.collection.InsertOne(ctx, appointment); err != nil {
+			logger.Error("failed to create appointment", slog.Any("error", err))
+			return domain.NewInternalError("failed to create appointment")
+		}
+
+		logger.Info("appointment created successfully")
+		return nil
+	})
+}
+
+func (r *AppointmentRepository) GetByID(ctx context.Context, id string) (*domain.Appointment, error) {
+	logger := slog.With(
+		slog.String("repository", "AppointmentRepository"),
+		slog.String("method", "GetByID"),
+		slog.String("appointmentID", id),
+	)
+
+	var appointment domain.Appointment
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&appointment); err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("appointment not found")
+			return nil, nil
+		}
+		logger.Error("failed to get appointment", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to get appointment")
+	}
+
+	return &appointment, nil
+}
+
+func (r *AppointmentRepository) List(ctx context.Context, filter domain.AppointmentFilter, page domain.PageRequest) (*domain.AppointmentPage, error) {
+	logger := slog.With(
+		slog.String("repository", "AppointmentRepository"),
+		slog.String("method", "List"),
+	)
+
+	query := bson.M{}
+	if filter.PatientID != "" {
+		query["patient_id"] = filter.PatientID
+	}
+	if filter.DoctorID != "" {
+		query["doctor_id"] = filter.DoctorID
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.From != nil || filter.To != nil {
+		startsAt := bson.M{}
+		if filter.From != nil {
+			startsAt["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			startsAt["$lt"] = *filter.To
+		}
+		query["starts_at"] = startsAt
+	}
+
+	appointments, err := findPage[*domain.Appointment](ctx, r.collection, query, page, appointmentSortFields)
+	if err != nil {
+		logger.Error("failed to list appointments", slog.Any("error", err))
+		return nil, err
+	}
+
+	return appointments, nil
+}
+
+// Reschedule moves the appointment unless check fails or the new time overlaps another one of
+// the doctor, holding the lock of the calendar as Create does.
+func (r *AppointmentRepository) Reschedule(ctx context.Context, current *domain.Appointment, startsAt, endsAt time.Time,
+	change domain.AppointmentChange, check func(ctx context.Context) error) (*domain.Appointment, error) {
+	logger := slog.With(
+		slog.String("repository", "AppointmentRepository"),
+		slog.String("method", "Reschedule"),
+		slog.String("appointmentID", current.ID),
+	)
+
+	moved := *current
+	moved.StartsAt = startsAt
+	moved.EndsAt = endsAt
+
+	var appointment *domain.Appointment
+	err := r.locks.withLock(ctx, current.DoctorID, func(ctx context.Context) error {
+		if err := check(ctx); err != nil {
+			logger.Info("appointment doesn't fit the calendar", slog.Any("error", err))
+			return err
+		}
+		conflict, err := r.hasConflict(ctx, &moved)
+		if err != nil {
+			logger.Error("failed to check conflicts", slog.Any("error", err))
+			return domain.NewInternalError("failed to reschedule appointment")
+		}
+		if conflict {
+			logger.Info("appointment conflicts with another one")
+			return errAppointmentConflict
+		}
+
+		filter := bson.M{
+			"_id":        current.ID,
+			"updated_at": current.UpdatedAt,
+			"status":     bson.M{"$in": domain.AppointmentStatusesFrom(domain.AppointmentStatusRescheduled)},
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"starts_at":  startsAt,
+				"ends_at":    endsAt,
+				"status":     domain.AppointmentStatusRescheduled,
+				"updated_at": change.ChangedAt,
+			},
+			"$push": bson.M{"history": change},
+		}
+
+		var updated domain.Appointment
+		err = r.collection.FindOneAndUpdate(ctx, filter, update,
+			options.FindOneAndUpdate().SetReturnDocument(options.After),
+		).Decode(&updated)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				logger.Info("appointment not found or changed")
+				return nil
+			}
+			logger.Error("failed to reschedule appointment", slog.Any("error", err))
+			return domain.NewInternalError("failed to reschedule appointment")
+		}
+
+		appointment = &updated
+		logger.Info("appointment rescheduled successfully")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+func (r *AppointmentRepository) ListBlocking(ctx context.Context, doctorID string, from, to time.Time) ([]*domain.Appointment, error) {
+	logger := slog.With(
+		slog.String("repository", "AppointmentRepository"),
+		slog.String("method", "ListBlocking"),
+		slog.String("doctorID", doctorID),
+	)
+
+	return r.findBlocking(ctx, logger, doctorID, from, to)
+}
+
+func (r *AppointmentRepository) ListBlockingForDoctors(ctx context.Context, doctorIDs []string, from, to time.Time) ([]*domain.Appointment, error) {
+	logger := slog.With(
+		slog.String("repository", "AppointmentRepository"),
+		slog.String("method", "ListBlockingForDoctors"),
+		slog.Int("doctors", len(doctorIDs)),
+	)
+
+	return r.findBlocking(ctx, logger, bson.M{"$in": doctorIDs}, from, to)
+}
+
+// findBlocking returns the appointments taking up time whose doctor_id matches doctor and that
+// overlap from until to, soonest first
+func (r *AppointmentRepository) findBlocking(ctx context.Context, logger *slog.Logger, doctor any,
+	from, to time.Time) ([]*domain.Appointment, error) {
+	filter := bson.M{
+		"doctor_id": doctor,
+		"status":    bson.M{"$in": domain.BlockingAppointmentStatuses},
+		"starts_at": bson.M{"$lt": to},
+		"ends_at":   bson.M{"$gt": from},
+	}
+	results, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "starts_at", Value: 1}}))
+	if err != nil {
+		logger.Error("failed to find appointments", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to list appointments")
+	}
+	defer results.Close(ctx)
+
+	appointments := []*domain.Appointment{}
+	if err := results.All(ctx, &appointments); err != nil {
+		logger.Error("failed to decode appointments", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to list appointments")
+	}
+
+	return appointments, nil
+}
+
+func (r *AppointmentRepository) UpdateStatus(ctx context.Context, id string, change domain.AppointmentChange) (*domain.Appointment, error) {
+	logger := slog.With(
+		slog.String("repository", "AppointmentRepository"),
+		slog.String("method", "UpdateStatus"),
+		slog.String("appointmentID", id),
+		slog.String("status", string(change.Status)),
+	)
+
+	filter := bson.M{
+		"_id":    id,
+		"status": bson.M{"$in": domain.AppointmentStatusesFrom(change.Status)},
+	}
+	update := bson.M{
+		"$set":  bson.M{"status": change.Status, "updated_at": change.ChangedAt},
+		"$push": bson.M{"history": change},
+	}
+
+	var appointment domain.Appointment
+	err := r.collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&appointment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("appointment not found or in a final status")
+			return nil, nil
+		}
+		logger.Error("failed to update appointment status", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to update appointment status")
+	}
+
+	logger.Info("appointment status updated successfully")
+	return &appointment, nil
+}
+
+// hasConflict reports whether another appointment taking up the time of the doctor overlaps appointment
+func (r *AppointmentRepository) hasConflict(ctx context.Context, appointment *domain.Appointment) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"_id":       bson.M{"$ne": appointment.ID},
+		"doctor_id": appointment.DoctorID,
+		"status":    bson.M{"$in": domain.BlockingAppointmentStatuses},
+		"starts_at": bson.M{"$lt": appointment.EndsAt},
+		"ends_at":   bson.M{"$gt": appointment.StartsAt},
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
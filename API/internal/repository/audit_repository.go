@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AuditRepository struct {
+	collection *mongo.Collection
+}
+
+func NewAuditRepository(db *mongo.Database) domain.AuditRepository {
+	return &AuditRepository{
+		collection: db.Collection("audit_logs"),
+	}
+}
+
+func (r *AuditRepository) Record(ctx context.Context, event *domain.AuditEvent) error {
+	logger := slog.With(
+		slog.String("repository", "AuditRepository"),
+		slog.String("method", "Record"),
+		slog.String("action", string(event.Action)),
+	)
+
+	if _, err := r.collection.InsertOne(ctx, event); err != nil {
+		logger.Error("failed to record audit event", slog.Any("error", err))
+		return domain.NewInternalError("failed to record audit event")
+	}
+
+	return nil
+}
+
+// List returns the most recent events matching filter.
+func (r *AuditRepository) List(ctx context.Context, filter domain.AuditFilter, limit int) ([]*domain.AuditEvent, error) {
+	logger := slog.With(
+		slog.String("repository", "AuditRepository"),
+		slog.String("method", "List"),
+	)
+
+	query := bson.M{}
+	if filter.TargetID != "" {
+		query["target_id"] = filter.TargetID
+	}
+	if filter.ActorID != "" {
+		query["actor_id"] = filter.ActorID
+	}
+	if filter.Action != "" {
+		query["action"] = filter.Action
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		logger.Error("failed to find audit events", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to find audit events")
+	}
+	defer cursor.Close(ctx)
+
+	events := []*domain.AuditEvent{}
+	if err := cursor.All(ctx, &events); err != nil {
+		logger.Error("failed to decode audit events", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to decode audit events")
+	}
+
+	return events, nil
+}
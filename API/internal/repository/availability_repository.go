@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AvailabilityRepository struct {
+	availabilities *mongo.Collection
+	exceptions     *mongo.Collection
+}
+
+func NewAvailabilityRepository(db *mongo.Database) domain.AvailabilityRepository {
+	return &AvailabilityRepository{
+		availabilities: db.Collection("availabilities"),
+		exceptions:     db.Collection("availability_exceptions"),
+	}
+}
+
+func (r *AvailabilityRepository) GetAvailability(ctx context.Context, doctorID string) (*domain.Availability, error) {
+	logger := slog.With(
+		slog.String("repository", "AvailabilityRepository"),
+		slog.String("method", "GetAvailability"),
+		slog.String("doctorID", doctorID),
+	)
+
+	var availability domain.Availability
+	err := r.availabilities.FindOne(ctx, bson.M{"_id": doctorID}).Decode(&availability)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.Error("failed to get availability", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to get availability")
+	}
+
+	return &availability, nil
+}
+
+func (r *AvailabilityRepository) ListAvailabilities(ctx context.Context, doctorIDs []string) ([]*domain.Availability, error) {
+	logger := slog.With(
+		slog.String("repository", "AvailabilityRepository"),
+		slog.String("method", "ListAvailabilities"),
+		slog.Int("doctors", len(doctorIDs)),
+	)
+
+	cursor, err := r.availabilities.Find(ctx, bson.M{"_id": bson.M{"$in": doctorIDs}})
+	if err != nil {
+		logger.Error("failed to list availabilities", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to list availabilities")
+	}
+	defer cursor.Close(ctx)
+
+	availabilities := []*domain.Availability{}
+	if err := cursor.All(ctx, &availabilities); err != nil {
+		logger.Error("failed to decode availabilities", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to decode availabilities")
+	}
+
+	return availabilities, nil
+}
+
+func (r *AvailabilityRepository) SaveAvailability(ctx context.Context, availability *domain.Availability) error {
+	logger := slog.With(
+		slog.String("repository", "AvailabilityRepository"),
+		slog.String("method", "SaveAvailability"),
+		slog.String("doctorID", availability.DoctorID),
+	)
+
+	_, err := r.availabilities.ReplaceOne(ctx, bson.M{"_id": availability.DoctorID}, availability, options.Replace().SetUpsert(true))
+	if err != nil {
+		logger.Error("failed to save availability", slog.Any("error", err))
+		return domain.NewInternalError("failed to save availability")
+	}
+
+	logger.Info("availability saved", slog.Int("periods", len(availability.Weekly)))
+	return nil
+}
+
+func (r *AvailabilityRepository) CreateException(ctx context.Context, exception *domain.AvailabilityException) error {
+	logger := slog.With(
+		slog.String("repository", "AvailabilityRepository"),
+		slog.String("method", "CreateException"),
+		slog.String("doctorID", exception.DoctorID),
+	)
+
+	if _, err := r.exceptions.InsertOne(ctx, exception); err != nil {
+		logger.Error("failed to create availability exception", slog.Any("error", err))
+		return domain.NewInternalError("failed to create availability exception")
+	}
+
+	logger.Info("availability exception created", slog.String("exceptionID", exception.ID))
+	return nil
+}
+
+func (r *AvailabilityRepository) ListExceptions(ctx context.Context, doctorID, startDate, endDate string) ([]*domain.AvailabilityException, error) {
+	logger := slog.With(
+		slog.String("repository", "AvailabilityRepository"),
+		slog.String("method", "ListExceptions"),
+		slog.String("doctorID", doctorID),
+	)
+
+	return r.findExceptions(ctx, logger, doctorID, startDate, endDate)
+}
+
+func (r *AvailabilityRepository) ListExceptionsForDoctors(ctx context.Context, doctorIDs []string, startDate, endDate string) ([]*domain.AvailabilityException, error) {
+	logger := slog.With(
+		slog.String("repository", "AvailabilityRepository"),
+		slog.String("method", "ListExceptionsForDoctors"),
+		slog.Int("doctors", len(doctorIDs)),
+	)
+
+	return r.findExceptions(ctx, logger, bson.M{"$in": doctorIDs}, startDate, endDate)
+}
+
+// findExceptions returns the exceptions whose doctor_id matches doctor and that cover any day
+// from startDate to endDate, oldest first
+func (r *AvailabilityRepository) findExceptions(ctx context.Context, logger *slog.Logger, doctor any,
+	startDate, endDate string) ([]*domain.AvailabilityException, error) {
+	// Dates written as YYYY-MM-DD sort like the days they name
+	filter := bson.M{
+		"doctor_id":  doctor,
+		"start_date": bson.M{"$lte": endDate},
+		"end_date":   bson.M{"$gte": startDate},
+	}
+	cursor, err := r.exceptions.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		logger.Error("failed to list availability exceptions", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to list availability exceptions")
+	}
+	defer cursor.Close(ctx)
+
+	exceptions := []*domain.AvailabilityException{}
+	if err := cursor.All(ctx, &exceptions); err != nil {
+		logger.Error("failed to decode availability exceptions", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to decode availability exceptions")
+	}
+
+	return exceptions, nil
+}
+
+func (r *AvailabilityRepository) DeleteException(ctx context.Context, doctorID, id string) (bool, error) {
+	logger := slog.With(
+		slog.String("repository", "AvailabilityRepository"),
+		slog.String("method", "DeleteException"),
+		slog.String("doctorID", doctorID),
+		slog.String("exceptionID", id),
+	)
+
+	result, err := r.exceptions.DeleteOne(ctx, bson.M{"_id": id, "doctor_id": doctorID})
+	if err != nil {
+		logger.Error("failed to delete availability exception", slog.Any("error", err))
+		return false, domain.NewInternalError("failed to delete availability exception")
+	}
+
+	return result.DeletedCount > 0, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// errCalendarBusy is returned when the calendar of a doctor stays locked for longer than calendarLockWait
+var errCalendarBusy = domain.NewConflictError("the calendar of the doctor is being changed, try again")
+
+const (
+	// calendarLockTTL is how long a lock is held before it expires, in case its holder died
+	calendarLockTTL = 10 * time.Second
+	// calendarLockTimeout bounds the work done while holding a lock, so that it ends before
+	// the lock can expire and be taken by someone else
+	calendarLockTimeout = calendarLockTTL / 2
+	// calendarLockWait and calendarLockPoll control how long and how often a held lock is retried
+	calendarLockWait = 5 * time.Second
+	calendarLockPoll = 20 * time.Millisecond
+)
+
+// calendarLocks serializes the changes to the calendar of each doctor, so that looking for
+// overlapping appointments and saving one can't interleave with another change. MongoDB may
+// run without replica set, and so without transactions, so like the migration lock each lock
+// is a document that expires.
+type calendarLocks struct {
+	collection *mongo.Collection
+}
+
+func newCalendarLocks(db *mongo.Database) *calendarLocks {
+	return &calendarLocks{collection: db.Collection("calendar_locks")}
+}
+
+// withLock runs fn while holding the lock of the calendar of doctorID. Errors returned by fn
+// are passed through unchanged.
+func (l *calendarLocks) withLock(ctx context.Context, doctorID string, fn func(ctx context.Context) error) error {
+	logger := slog.With(
+		slog.String("repository", "calendarLocks"),
+		slog.String("method", "withLock"),
+		slog.String("doctorID", doctorID),
+	)
+
+	owner := pkg.GenerateID()
+	if err := l.acquire(ctx, doctorID, owner); err != nil {
+		if err != errCalendarBusy {
+			logger.Error("failed to lock calendar", slog.Any("error", err))
+			return domain.NewInternalError("failed to lock the calendar of the doctor")
+		}
+		logger.Info("calendar stayed locked")
+		return err
+	}
+	defer func() {
+		// The lock is released even when ctx was cancelled, or others would wait for it to expire
+		releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), calendarLockTimeout)
+		defer cancel()
+		if _, err := l.collection.DeleteOne(releaseCtx, bson.M{"_id": doctorID, "owner": owner}); err != nil {
+			logger.Error("failed to unlock calendar", slog.Any("error", err))
+		}
+	}()
+
+	lockedCtx, cancel := context.WithTimeout(ctx, calendarLockTimeout)
+	defer cancel()
+	return fn(lockedCtx)
+}
+
+// acquire takes the lock when nobody holds it or its holder let it expire. Otherwise the upsert
+// collides with the existing lock document and is retried until calendarLockWait passes.
+func (l *calendarLocks) acquire(ctx context.Context, doctorID, owner string) error {
+	deadline := time.Now().Add(calendarLockWait)
+	for {
+		now := time.Now()
+		filter := bson.M{"_id": doctorID, "expires_at": bson.M{"$lte": now}}
+		update := bson.M{"$set": bson.M{"owner": owner, "expires_at": now.Add(calendarLockTTL)}}
+
+		_, err := l.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+		if err == nil {
+			return nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+
+		if time.Now().After(deadline) {
+			return errCalendarBusy
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(calendarLockPoll):
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// clinicalNoteSortFields maps domain.ClinicalNoteSortFields to their document paths and types
+var clinicalNoteSortFields = map[string]sortField{
+	"created_at": {Path: "created_at", Type: bson.TypeDateTime},
+}
+
+// errNoteAmended is returned when another amendment of the same version was added first
+var errNoteAmended = domain.NewConflictError("clinical note was amended by someone else, review the latest version and try again")
+
+type ClinicalNoteRepository struct {
+	collection *mongo.Collection
+	amendments *mongo.Collection
+}
+
+func NewClinicalNoteRepository(db *mongo.Database) domain.ClinicalNoteRepository {
+	return &ClinicalNoteRepository{
+		collection: db.Collection("clinical_notes"),
+		amendments: db.Collection("clinical_note_amendments"),
+	}
+}
+
+func (r *ClinicalNoteRepository) Create(ctx context.Context, note *domain.ClinicalNote) error {
+	logger := slog.With(
+		slog.String("repository", "ClinicalNoteRepository"),
+		slog.String("method", "Create"),
+		slog.String("patientID", note.PatientID),
+	)
+
+	if _, err := r.collection.InsertOne(ctx, note); err != nil {
+		logger.Error("failed to create clinical note", slog.Any("error", err))
+		return domain.NewInternalError("failed to create clinical note")
+	}
+
+	logger.Info("clinical note created", slog.String("noteID", note.ID))
+	return nil
+}
+
+func (r *ClinicalNoteRepository) GetByID(ctx context.Context, patientID, id string) (*domain.ClinicalNote, error) {
+	logger := slog.With(
+		slog.String("repository", "ClinicalNoteRepository"),
+		slog.String("method", "GetByID"),
+		slog.String("patientID", patientID),
+		slog.String("noteID", id),
+	)
+
+	var note domain.ClinicalNote
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id, "patient_id": patientID}).Decode(&note); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.Error("failed to get clinical note", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to get clinical note")
+	}
+
+	return &note, nil
+}
+
+func (r *ClinicalNoteRepository) List(ctx context.Context, query domain.ClinicalNoteQuery, page domain.PageRequest) (*domain.ClinicalNotePage, error) {
+	logger := slog.With(
+		slog.String("repository", "ClinicalNoteRepository"),
+		slog.String("method", "List"),
+		slog.String("patientID", query.PatientID),
+	)
+
+	filter := bson.M{"patient_id": query.PatientID}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+	if query.EncounterID != "" {
+		filter["encounter_id"] = query.EncounterID
+	}
+
+	notes, err := findPage[*domain.ClinicalNote](ctx, r.collection, filter, page, clinicalNoteSortFields)
+	if err != nil {
+		logger.Error("failed to list clinical notes", slog.Any("error", err))
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+func (r *ClinicalNoteRepository) UpdateDraft(ctx context.Context, note *domain.ClinicalNote) (*domain.ClinicalNote, error) {
+	logger := slog.With(
+		slog.String("repository", "ClinicalNoteRepository"),
+		slog.String("method", "UpdateDraft"),
+		slog.String("patientID", note.PatientID),
+		slog.String("noteID", note.ID),
+	)
+
+	// Matching the status keeps a note signed meanwhile from being replaced
+	filter := bson.M{"_id": note.ID, "patient_id": note.PatientID, "status": domain.NoteDraft}
+	set := bson.M{"title": note.Title, "body": note.Body, "updated_at": note.UpdatedAt}
+	update := bson.M{"$set": set}
+	if note.EncounterID != "" {
+		set["encounter_id"] = note.EncounterID
+	} else {
+		update["$unset"] = bson.M{"encounter_id": ""}
+	}
+
+	var updated domain.ClinicalNote
+	err := r.collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("clinical note not found or signed")
+			return nil, nil
+		}
+		logger.Error("failed to update clinical note", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to update clinical note")
+	}
+
+	logger.Info("clinical note updated")
+	return &updated, nil
+}
+
+// Sign only signs the draft if it was last updated at updatedAt, the time the author saw, so that
+// a change saved after the author read the draft is never signed without being seen.
+func (r *ClinicalNoteRepository) Sign(ctx context.Context, patientID, id string, updatedAt, at time.Time) (*domain.ClinicalNote, error) {
+	logger := slog.With(
+		slog.String("repository", "ClinicalNoteRepository"),
+		slog.String("method", "Sign"),
+		slog.String("patientID", patientID),
+		slog.String("noteID", id),
+	)
+
+	filter := bson.M{"_id": id, "patient_id": patientID, "status": domain.NoteDraft, "updated_at": updatedAt}
+	update := bson.M{"$set": bson.M{"status": domain.NoteSigned, "signed_at": at, "updated_at": at}}
+
+	var note domain.ClinicalNote
+	err := r.collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&note)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("clinical note not found or changed")
+			return nil, nil
+		}
+		logger.Error("failed to sign clinical note", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to sign clinical note")
+	}
+
+	logger.Info("clinical note signed")
+	return &note, nil
+}
+
+// CreateAmendment relies on the unique index on the note and version of amendments, which makes
+// concurrent amendments of the same version fail instead of both being added.
+func (r *ClinicalNoteRepository) CreateAmendment(ctx context.Context, amendment *domain.NoteAmendment) error {
+	logger := slog.With(
+		slog.String("repository", "ClinicalNoteRepository"),
+		slog.String("method", "CreateAmendment"),
+		slog.String("noteID", amendment.NoteID),
+		slog.Int("version", amendment.Version),
+	)
+
+	if _, err := r.amendments.InsertOne(ctx, amendment); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			logger.Info("clinical note amended meanwhile")
+			return errNoteAmended
+		}
+		logger.Error("failed to create clinical note amendment", slog.Any("error", err))
+		return domain.NewInternalError("failed to create clinical note amendment")
+	}
+
+	logger.Info("clinical note amendment created", slog.String("amendmentID", amendment.ID))
+	return nil
+}
+
+func (r *ClinicalNoteRepository) ListAmendments(ctx context.Context, noteIDs []string) ([]*domain.NoteAmendment, error) {
+	logger := slog.With(
+		slog.String("repository", "ClinicalNoteRepository"),
+		slog.String("method", "ListAmendments"),
+		slog.Int("notes", len(noteIDs)),
+	)
+
+	cursor, err := r.amendments.Find(ctx, bson.M{"note_id": bson.M{"$in": noteIDs}},
+		options.Find().SetSort(bson.D{{Key: "note_id", Value: 1}, {Key: "version", Value: 1}}))
+	if err != nil {
+		logger.Error("failed to list clinical note amendments", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to list clinical note amendments")
+	}
+	defer cursor.Close(ctx)
+
+	amendments := []*domain.NoteAmendment{}
+	if err := cursor.All(ctx, &amendments); err != nil {
+		logger.Error("failed to decode clinical note amendments", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to decode clinical note amendments")
+	}
+
+	return amendments, nil
+}
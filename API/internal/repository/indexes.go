@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/vida-plus/api/pkg/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Names of the unique indexes of users, which tell which field a duplicate key error is about
+const (
+	usersEmailIndex = "users_email_unique"
+	usersCPFIndex   = "users_cpf_unique"
+	usersCRMIndex   = "users_crm_unique"
+)
+
+// expiringIndex removes documents once their expires_at has passed
+var expiringIndex = mongo.IndexModel{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)}
+
+// uniqueIfPresent is a unique index on an optional field, which documents without it don't take part in
+func uniqueIfPresent(name, field string) mongo.IndexModel {
+	return mongo.IndexModel{
+		Keys: bson.D{{Key: field, Value: 1}},
+		Options: options.Index().SetName(name).SetUnique(true).
+			SetPartialFilterExpression(bson.M{field: bson.M{"$exists": true}}),
+	}
+}
+
+// collectionIndexes declares the indexes required by the repositories
+var collectionIndexes = []database.CollectionIndexes{
+	{Collection: "users", Indexes: []mongo.IndexModel{
+		// Soft-deleted users keep their email reserved, so they are part of every unique index
+		{Keys: bson.D{{Key: "email", Value: 1}}, Options: options.Index().SetName(usersEmailIndex).SetUnique(true)},
+		uniqueIfPresent(usersCPFIndex, "profile.cpf"),
+		uniqueIfPresent(usersCRMIndex, "profile.crm"),
+		// Supports the default sort of the user list
+		{Keys: bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		// Supports the default sort of the doctor directory
+		{Keys: bson.D{{Key: "type", Value: 1}, {Key: "profile.first_name", Value: 1}, {Key: "_id", Value: 1}}},
+	}},
+	{Collection: "refresh_tokens", Indexes: []mongo.IndexModel{
+		expiringIndex,
+		{Keys: bson.D{{Key: "family_id", Value: 1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+	}},
+	{Collection: "revoked_tokens", Indexes: []mongo.IndexModel{expiringIndex}},
+	{Collection: "session_revocations", Indexes: []mongo.IndexModel{expiringIndex}},
+	{Collection: "password_reset_tokens", Indexes: []mongo.IndexModel{
+		expiringIndex,
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+	}},
+	{Collection: "login_attempts", Indexes: []mongo.IndexModel{expiringIndex}},
+	{Collection: "invitations", Indexes: []mongo.IndexModel{
+		expiringIndex,
+		{Keys: bson.D{{Key: "email", Value: 1}}},
+	}},
+	{Collection: "appointments", Indexes: []mongo.IndexModel{
+		// Supports the conflict checks on the calendar of a doctor
+		{Keys: bson.D{{Key: "doctor_id", Value: 1}, {Key: "starts_at", Value: 1}}},
+		{Keys: bson.D{{Key: "patient_id", Value: 1}, {Key: "starts_at", Value: 1}}},
+		{Keys: bson.D{{Key: "starts_at", Value: 1}, {Key: "_id", Value: 1}}},
+	}},
+	{Collection: "availability_exceptions", Indexes: []mongo.IndexModel{
+		{Keys: bson.D{{Key: "doctor_id", Value: 1}, {Key: "start_date", Value: 1}}},
+	}},
+	{Collection: "medical_record_entries", Indexes: []mongo.IndexModel{
+		// Supports the default sort of the entries of a record
+		{Keys: bson.D{{Key: "patient_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+	}},
+	{Collection: "clinical_notes", Indexes: []mongo.IndexModel{
+		// Supports the default sort of the notes of a record
+		{Keys: bson.D{{Key: "patient_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+	}},
+	{Collection: "clinical_note_amendments", Indexes: []mongo.IndexModel{
+		// Keeps two amendments from correcting the same version of a note
+		{Keys: bson.D{{Key: "note_id", Value: 1}, {Key: "version", Value: 1}}, Options: options.Index().SetUnique(true)},
+	}},
+	{Collection: "audit_logs", Indexes: []mongo.IndexModel{
+		{Keys: bson.D{{Key: "target_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "created_at", Value: -1}}},
+	}},
+}
+
+// EnsureIndexes creates the indexes required by the repositories
+func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
+	return database.EnsureIndexes(ctx, db, collectionIndexes)
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type InvitationRepository struct {
+	collection *mongo.Collection
+}
+
+func NewInvitationRepository(db *mongo.Database) domain.InvitationRepository {
+	return &InvitationRepository{
+		collection: db.Collection("invitations"),
+	}
+}
+
+func (r *InvitationRepository) Create(ctx context.Context, invitation *domain.Invitation) error {
+	logger := slog.With(
+		slog.String("repository", "InvitationRepository"),
+		slog.String("method", "Create"),
+		slog.String("email", invitation.Email),
+	)
+
+	if _, err := r.collection.InsertOne(ctx, invitation); err != nil {
+		logger.Error("failed to create invitation", slog.Any("error", err))
+		return domain.NewInternalError("failed to create invitation")
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) GetPending(ctx context.Context, id string, now time.Time) (*domain.Invitation, error) {
+	logger := slog.With(
+		slog.String("repository", "InvitationRepository"),
+		slog.String("method", "GetPending"),
+	)
+
+	filter := bson.M{
+		"_id":         id,
+		"accepted_at": bson.M{"$exists": false},
+		"expires_at":  bson.M{"$gt": now},
+	}
+
+	var invitation domain.Invitation
+	if err := r.collection.FindOne(ctx, filter).Decode(&invitation); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.Error("failed to find invitation", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to find invitation")
+	}
+
+	return &invitation, nil
+}
+
+func (r *InvitationRepository) Consume(ctx context.Context, id string, acceptedAt time.Time) (*domain.Invitation, error) {
+	logger := slog.With(
+		slog.String("repository", "InvitationRepository"),
+		slog.String("method", "Consume"),
+	)
+
+	filter := bson.M{
+		"_id":         id,
+		"accepted_at": bson.M{"$exists": false},
+		"expires_at":  bson.M{"$gt": acceptedAt},
+	}
+
+	var invitation domain.Invitation
+	err := r.collection.FindOneAndUpdate(ctx, filter, bson.M{"$set": bson.M{"accepted_at": acceptedAt}}).Decode(&invitation)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("invitation not found or already accepted")
+			return nil, nil
+		}
+		logger.Error("failed to consume invitation", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to consume invitation")
+	}
+
+	return &invitation, nil
+}
+
+func (r *InvitationRepository) DeleteByEmail(ctx context.Context, email string) error {
+	logger := slog.With(
+		slog.String("repository", "InvitationRepository"),
+		slog.String("method", "DeleteByEmail"),
+		slog.String("email", email),
+	)
+
+	if _, err := r.collection.DeleteMany(ctx, bson.M{"email": email}); err != nil {
+		logger.Error("failed to delete invitations", slog.Any("error", err))
+		return domain.NewInternalError("failed to delete invitations")
+	}
+
+	return nil
+}
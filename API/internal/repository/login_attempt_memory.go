@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// MemoryLoginAttemptStore keeps failed login counters in memory. Counters are not shared
+// between replicas, so it is meant for tests and single instance deployments.
+type MemoryLoginAttemptStore struct {
+	mu       sync.Mutex
+	attempts map[string]domain.LoginAttempts
+}
+
+func NewMemoryLoginAttemptStore() domain.LoginAttemptStore {
+	return &MemoryLoginAttemptStore{attempts: make(map[string]domain.LoginAttempts)}
+}
+
+func (s *MemoryLoginAttemptStore) RegisterFailure(_ context.Context, key string, at, expiresAt time.Time) (*domain.LoginAttempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempts, ok := s.attempts[key]
+	if !ok || !attempts.ExpiresAt.After(at) {
+		attempts = domain.LoginAttempts{Key: key}
+	}
+	attempts.Failures++
+	attempts.LastFailureAt = at
+	attempts.ExpiresAt = expiresAt
+	s.attempts[key] = attempts
+
+	return &attempts, nil
+}
+
+func (s *MemoryLoginAttemptStore) Get(_ context.Context, key string) (*domain.LoginAttempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempts, ok := s.attempts[key]
+	if !ok {
+		return nil, nil
+	}
+	if !attempts.ExpiresAt.After(time.Now()) {
+		delete(s.attempts, key)
+		return nil, nil
+	}
+
+	return &attempts, nil
+}
+
+func (s *MemoryLoginAttemptStore) Reset(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+	return nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type LoginAttemptRepository struct {
+	collection *mongo.Collection
+}
+
+func NewLoginAttemptRepository(db *mongo.Database) domain.LoginAttemptStore {
+	return &LoginAttemptRepository{
+		collection: db.Collection("login_attempts"),
+	}
+}
+
+func (r *LoginAttemptRepository) RegisterFailure(ctx context.Context, key string, at, expiresAt time.Time) (*domain.LoginAttempts, error) {
+	logger := slog.With(
+		slog.String("repository", "LoginAttemptRepository"),
+		slog.String("method", "RegisterFailure"),
+		slog.String("key", key),
+	)
+
+	// The TTL monitor only runs every minute, so expired counters restart explicitly
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"failures": bson.M{"$cond": bson.A{
+			bson.M{"$gt": bson.A{"$expires_at", at}},
+			bson.M{"$add": bson.A{"$failures", 1}},
+			1,
+		}},
+		"last_failure_at": at,
+		"expires_at":      expiresAt,
+	}}}}
+
+	var attempts domain.LoginAttempts
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": key}, update,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&attempts)
+	if err != nil {
+		logger.Error("failed to register login failure", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to register login failure")
+	}
+
+	return &attempts, nil
+}
+
+func (r *LoginAttemptRepository) Get(ctx context.Context, key string) (*domain.LoginAttempts, error) {
+	logger := slog.With(
+		slog.String("repository", "LoginAttemptRepository"),
+		slog.String("method", "Get"),
+		slog.String("key", key),
+	)
+
+	var attempts domain.LoginAttempts
+	err := r.collection.FindOne(ctx, bson.M{"_id": key, "expires_at": bson.M{"$gt": time.Now()}}).Decode(&attempts)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.Error("failed to get login attempts", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to get login attempts")
+	}
+
+	return &attempts, nil
+}
+
+func (r *LoginAttemptRepository) Reset(ctx context.Context, key string) error {
+	logger := slog.With(
+		slog.String("repository", "LoginAttemptRepository"),
+		slog.String("method", "Reset"),
+		slog.String("key", key),
+	)
+
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": key}); err != nil {
+		logger.Error("failed to reset login attempts", slog.Any("error", err))
+		return domain.NewInternalError("failed to reset login attempts")
+	}
+
+	return nil
+}
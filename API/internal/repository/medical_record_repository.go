@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// recordEntrySortFields maps domain.RecordEntrySortFields to their document paths and types
+var recordEntrySortFields = map[string]sortField{
+	"created_at": {Path: "created_at", Type: bson.TypeDateTime},
+}
+
+type MedicalRecordRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMedicalRecordRepository(db *mongo.Database) domain.MedicalRecordRepository {
+	return &MedicalRecordRepository{
+		collection: db.Collection("medical_record_entries"),
+	}
+}
+
+func (r *MedicalRecordRepository) Create(ctx context.Context, entry *domain.RecordEntry) error {
+	logger := slog.With(
+		slog.String("repository", "MedicalRecordRepository"),
+		slog.String("method", "Create"),
+		slog.String("patientID", entry.PatientID),
+	)
+
+	if _, err := r.collection.InsertOne(ctx, entry); err != nil {
+		logger.Error("failed to create record entry", slog.Any("error", err))
+		return domain.NewInternalError("failed to create record entry")
+	}
+
+	logger.Info("record entry created", slog.String("entryID", entry.ID), slog.String("kind", string(entry.Kind)))
+	return nil
+}
+
+func (r *MedicalRecordRepository) GetByID(ctx context.Context, patientID, id string) (*domain.RecordEntry, error) {
+	logger := slog.With(
+		slog.String("repository", "MedicalRecordRepository"),
+		slog.String("method", "GetByID"),
+		slog.String("patientID", patientID),
+		slog.String("entryID", id),
+	)
+
+	var entry domain.RecordEntry
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id, "patient_id": patientID}).Decode(&entry); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.Error("failed to get record entry", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to get record entry")
+	}
+
+	return &entry, nil
+}
+
+func (r *MedicalRecordRepository) List(ctx context.Context, query domain.RecordEntryQuery, page domain.PageRequest) (*domain.RecordEntryPage, error) {
+	logger := slog.With(
+		slog.String("repository", "MedicalRecordRepository"),
+		slog.String("method", "List"),
+		slog.String("patientID", query.PatientID),
+	)
+
+	entries, err := findPage[*domain.RecordEntry](ctx, r.collection, recordEntryFilter(query), page, recordEntrySortFields)
+	if err != nil {
+		logger.Error("failed to list record entries", slog.Any("error", err))
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r *MedicalRecordRepository) ListAll(ctx context.Context, query domain.RecordEntryQuery) ([]*domain.RecordEntry, error) {
+	logger := slog.With(
+		slog.String("repository", "MedicalRecordRepository"),
+		slog.String("method", "ListAll"),
+		slog.String("patientID", query.PatientID),
+	)
+
+	results, err := r.collection.Find(ctx, recordEntryFilter(query),
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}))
+	if err != nil {
+		logger.Error("failed to find record entries", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to list record entries")
+	}
+	defer results.Close(ctx)
+
+	entries := []*domain.RecordEntry{}
+	if err := results.All(ctx, &entries); err != nil {
+		logger.Error("failed to decode record entries", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to list record entries")
+	}
+
+	return entries, nil
+}
+
+func (r *MedicalRecordRepository) UpdateDraft(ctx context.Context, entry *domain.RecordEntry) (*domain.RecordEntry, error) {
+	logger := slog.With(
+		slog.String("repository", "MedicalRecordRepository"),
+		slog.String("method", "UpdateDraft"),
+		slog.String("patientID", entry.PatientID),
+		slog.String("entryID", entry.ID),
+	)
+
+	// Matching the status keeps a draft finalized meanwhile from being replaced
+	filter := bson.M{"_id": entry.ID, "patient_id": entry.PatientID, "status": domain.RecordEntryDraft}
+	result, err := r.collection.ReplaceOne(ctx, filter, entry)
+	if err != nil {
+		logger.Error("failed to update record entry", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to update record entry")
+	}
+	if result.MatchedCount == 0 {
+		logger.Info("record entry not found or final")
+		return nil, nil
+	}
+
+	logger.Info("record entry updated")
+	return entry, nil
+}
+
+func (r *MedicalRecordRepository) Finalize(ctx context.Context, patientID, id string, at time.Time) (*domain.RecordEntry, error) {
+	logger := slog.With(
+		slog.String("repository", "MedicalRecordRepository"),
+		slog.String("method", "Finalize"),
+		slog.String("patientID", patientID),
+		slog.String("entryID", id),
+	)
+
+	filter := bson.M{"_id": id, "patient_id": patientID, "status": domain.RecordEntryDraft}
+	update := bson.M{"$set": bson.M{"status": domain.RecordEntryFinal, "finalized_at": at, "updated_at": at}}
+
+	var entry domain.RecordEntry
+	err := r.collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&entry)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("record entry not found or final")
+			return nil, nil
+		}
+		logger.Error("failed to finalize record entry", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to finalize record entry")
+	}
+
+	logger.Info("record entry finalized")
+	return &entry, nil
+}
+
+// recordEntryFilter returns the filter of the entries matching query
+func recordEntryFilter(query domain.RecordEntryQuery) bson.M {
+	filter := bson.M{"patient_id": query.PatientID}
+	if len(query.Kinds) > 0 {
+		filter["kind"] = bson.M{"$in": query.Kinds}
+	}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+	if query.EncounterID != "" {
+		filter["encounter_id"] = query.EncounterID
+	}
+	return filter
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MFAPolicyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMFAPolicyRepository(db *mongo.Database) domain.MFAPolicyRepository {
+	return &MFAPolicyRepository{
+		collection: db.Collection("mfa_policies"),
+	}
+}
+
+func (r *MFAPolicyRepository) GetPolicy(ctx context.Context, userType domain.UserType) (*domain.MFAPolicy, error) {
+	logger := slog.With(
+		slog.String("repository", "MFAPolicyRepository"),
+		slog.String("method", "GetPolicy"),
+		slog.String("userType", string(userType)),
+	)
+
+	var policy domain.MFAPolicy
+	err := r.collection.FindOne(ctx, bson.M{"_id": userType}).Decode(&policy)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.Error("failed to get mfa policy", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to get mfa policy")
+	}
+
+	return &policy, nil
+}
+
+func (r *MFAPolicyRepository) GetPolicies(ctx context.Context) ([]*domain.MFAPolicy, error) {
+	logger := slog.With(
+		slog.String("repository", "MFAPolicyRepository"),
+		slog.String("method", "GetPolicies"),
+	)
+
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		logger.Error("failed to list mfa policies", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to list mfa policies")
+	}
+	defer cursor.Close(ctx)
+
+	var policies []*domain.MFAPolicy
+	if err := cursor.All(ctx, &policies); err != nil {
+		logger.Error("failed to decode mfa policies", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to decode mfa policies")
+	}
+
+	return policies, nil
+}
+
+func (r *MFAPolicyRepository) SetPolicy(ctx context.Context, policy *domain.MFAPolicy) error {
+	logger := slog.With(
+		slog.String("repository", "MFAPolicyRepository"),
+		slog.String("method", "SetPolicy"),
+		slog.String("userType", string(policy.UserType)),
+	)
+
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": policy.UserType}, policy, options.Replace().SetUpsert(true))
+	if err != nil {
+		logger.Error("failed to save mfa policy", slog.Any("error", err))
+		return domain.NewInternalError("failed to save mfa policy")
+	}
+
+	logger.Info("mfa policy saved", slog.Bool("required", policy.Required))
+	return nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MFARepository struct {
+	collection *mongo.Collection
+}
+
+func NewMFARepository(db *mongo.Database) domain.MFARepository {
+	return &MFARepository{
+		collection: db.Collection("mfa_enrollments"),
+	}
+}
+
+func (r *MFARepository) SaveEnrollment(ctx context.Context, enrollment *domain.MFAEnrollment) error {
+	logger := slog.With(
+		slog.String("repository", "MFARepository"),
+		slog.String("method", "SaveEnrollment"),
+		slog.String("userID", enrollment.UserID),
+	)
+
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": enrollment.UserID}, enrollment, options.Replace().SetUpsert(true))
+	if err != nil {
+		logger.Error("failed to save mfa enrollment", slog.Any("error", err))
+		return domain.NewInternalError("failed to save mfa enrollment")
+	}
+
+	return nil
+}
+
+func (r *MFARepository) GetEnrollment(ctx context.Context, userID string) (*domain.MFAEnrollment, error) {
+	logger := slog.With(
+		slog.String("repository", "MFARepository"),
+		slog.String("method", "GetEnrollment"),
+		slog.String("userID", userID),
+	)
+
+	var enrollment domain.MFAEnrollment
+	err := r.collection.FindOne(ctx, bson.M{"_id": userID}).Decode(&enrollment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.Error("failed to get mfa enrollment", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to get mfa enrollment")
+	}
+
+	return &enrollment, nil
+}
+
+func (r *MFARepository) ConfirmEnrollment(ctx context.Context, userID string, confirmedAt time.Time) error {
+	logger := slog.With(
+		slog.String("repository", "MFARepository"),
+		slog.String("method", "ConfirmEnrollment"),
+		slog.String("userID", userID),
+	)
+
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": userID, "confirmed_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"confirmed_at": confirmedAt}},
+	)
+	if err != nil {
+		logger.Error("failed to confirm mfa enrollment", slog.Any("error", err))
+		return domain.NewInternalError("failed to confirm mfa enrollment")
+	}
+
+	return nil
+}
+
+func (r *MFARepository) UseStep(ctx context.Context, userID string, step int64) (bool, error) {
+	logger := slog.With(
+		slog.String("repository", "MFARepository"),
+		slog.String("method", "UseStep"),
+		slog.String("userID", userID),
+	)
+
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": userID, "last_used_step": bson.M{"$lt": step}},
+		bson.M{"$set": bson.M{"last_used_step": step}},
+	)
+	if err != nil {
+		logger.Error("failed to record used time step", slog.Any("error", err))
+		return false, domain.NewInternalError("failed to verify mfa code")
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+func (r *MFARepository) UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	logger := slog.With(
+		slog.String("repository", "MFARepository"),
+		slog.String("method", "UseRecoveryCode"),
+		slog.String("userID", userID),
+	)
+
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": userID, "confirmed_at": bson.M{"$exists": true}, "recovery_codes": codeHash},
+		bson.M{"$pull": bson.M{"recovery_codes": codeHash}},
+	)
+	if err != nil {
+		logger.Error("failed to use recovery code", slog.Any("error", err))
+		return false, domain.NewInternalError("failed to verify recovery code")
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+func (r *MFARepository) DeleteEnrollment(ctx context.Context, userID string) error {
+	logger := slog.With(
+		slog.String("repository", "MFARepository"),
+		slog.String("method", "DeleteEnrollment"),
+		slog.String("userID", userID),
+	)
+
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": userID}); err != nil {
+		logger.Error("failed to delete mfa enrollment", slog.Any("error", err))
+		return domain.NewInternalError("failed to delete mfa enrollment")
+	}
+
+	return nil
+}
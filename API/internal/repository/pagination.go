@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"log/slog"
+	"strings"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// sortField is a field a list can be sorted by: its document path and the BSON type of its values
+type sortField struct {
+	Path string
+	Type bsontype.Type
+}
+
+// pageCursor points past the last item of a page. Pages are sorted by a field and then by ID,
+// so the ID breaks ties between items with the same value.
+type pageCursor struct {
+	Field string        `bson:"f"`
+	Value bson.RawValue `bson:"v"`
+	ID    bson.RawValue `bson:"id"`
+}
+
+func encodeCursor(cursor pageCursor) (string, error) {
+	data, err := bson.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor parses a cursor of a list sorted by field. Cursors of other sorts are rejected,
+// since their values can't be compared. Cursors come from clients and their values go into the
+// query, so a value that isn't of the type of the field, or an ID that isn't a string, is rejected
+// too rather than compared as a document or an array.
+func decodeCursor(encoded, field string, valueType bsontype.Type) (*pageCursor, error) {
+	invalid := domain.NewBadRequestError("invalid cursor")
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var cursor pageCursor
+	if err := bson.Unmarshal(data, &cursor); err != nil {
+		return nil, invalid
+	}
+	if cursor.Field != field || cursor.Value.Type != valueType || cursor.ID.Type != bson.TypeString {
+		return nil, invalid
+	}
+
+	return &cursor, nil
+}
+
+// findPage returns a page of the documents of collection matching filter. fields maps the
+// fields the list can be sorted by to their document paths and types.
+func findPage[T any](ctx context.Context, collection *mongo.Collection, filter bson.M, page domain.PageRequest,
+	fields map[string]sortField) (*domain.Page[T], error) {
+	logger := slog.With(
+		slog.String("repository", "pagination"),
+		slog.String("method", "findPage"),
+		slog.String("collection", collection.Name()),
+		slog.String("sort", page.Sort.Field),
+	)
+
+	sort, ok := fields[page.Sort.Field]
+	if !ok {
+		return nil, domain.NewBadRequestError("cannot sort by " + page.Sort.Field)
+	}
+	path := sort.Path
+
+	total, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		logger.Error("failed to count documents", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to count documents")
+	}
+
+	direction, after := 1, "$gt"
+	if page.Sort.Desc {
+		direction, after = -1, "$lt"
+	}
+
+	query := filter
+	if page.Cursor != "" {
+		cursor, err := decodeCursor(page.Cursor, page.Sort.Field, sort.Type)
+		if err != nil {
+			return nil, err
+		}
+		query = bson.M{"$and": bson.A{filter, bson.M{"$or": bson.A{
+			bson.M{path: bson.M{after: cursor.Value}},
+			bson.M{path: cursor.Value, "_id": bson.M{after: cursor.ID}},
+		}}}}
+	}
+
+	// One extra document tells whether there is a next page
+	opts := options.Find().
+		SetSort(bson.D{{Key: path, Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(int64(page.Limit + 1))
+	results, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		logger.Error("failed to find documents", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to find documents")
+	}
+	defer results.Close(ctx)
+
+	var docs []bson.Raw
+	if err := results.All(ctx, &docs); err != nil {
+		logger.Error("failed to read documents", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to read documents")
+	}
+
+	result := &domain.Page[T]{Items: make([]T, 0, min(len(docs), page.Limit)), Total: total}
+	if len(docs) > page.Limit {
+		docs = docs[:page.Limit]
+		last := docs[len(docs)-1]
+
+		value, err := last.LookupErr(strings.Split(path, ".")...)
+		if err != nil {
+			logger.Error("failed to read sort value", slog.Any("error", err))
+			return nil, domain.NewInternalError("failed to read documents")
+		}
+		next, err := encodeCursor(pageCursor{Field: page.Sort.Field, Value: value, ID: last.Lookup("_id")})
+		if err != nil {
+			logger.Error("failed to encode cursor", slog.Any("error", err))
+			return nil, domain.NewInternalError("failed to read documents")
+		}
+		result.NextCursor = next
+	}
+
+	for _, doc := range docs {
+		var item T
+		if err := bson.Unmarshal(doc, &item); err != nil {
+			logger.Error("failed to decode document", slog.Any("error", err))
+			return nil, domain.NewInternalError("failed to decode documents")
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func Test_Cursor_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+	doc, err := bson.Marshal(bson.M{"_id": "user-1", "created_at": createdAt})
+	require.NoError(t, err)
+	raw := bson.Raw(doc)
+
+	encoded, err := encodeCursor(pageCursor{Field: "created_at", Value: raw.Lookup("created_at"), ID: raw.Lookup("_id")})
+	require.NoError(t, err)
+
+	cursor, err := decodeCursor(encoded, "created_at", bson.TypeDateTime)
+	require.NoError(t, err)
+	assert.Equal(t, createdAt, cursor.Value.Time().UTC())
+	assert.Equal(t, "user-1", cursor.ID.StringValue())
+}
+
+func Test_Cursor_Invalid(t *testing.T) {
+	doc, err := bson.Marshal(bson.M{"_id": "user-1", "email": "a@test.com", "operator": bson.M{"$ne": nil}})
+	require.NoError(t, err)
+	raw := bson.Raw(doc)
+	encoded, err := encodeCursor(pageCursor{Field: "email", Value: raw.Lookup("email"), ID: raw.Lookup("_id")})
+	require.NoError(t, err)
+
+	// Cursors edited by the client to put an operator into the query
+	tamperedValue, err := encodeCursor(pageCursor{Field: "email", Value: raw.Lookup("operator"), ID: raw.Lookup("_id")})
+	require.NoError(t, err)
+	tamperedID, err := encodeCursor(pageCursor{Field: "email", Value: raw.Lookup("email"), ID: raw.Lookup("operator")})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		encoded string
+		field   string
+	}{
+		{"NOT BASE64", "not base64!", "email"},
+		{"NOT BSON", "aGVsbG8", "email"},
+		{"OTHER SORT", encoded, "created_at"},
+		{"TAMPERED VALUE", tamperedValue, "email"},
+		{"TAMPERED ID", tamperedID, "email"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeCursor(tt.encoded, tt.field, bson.TypeString)
+			assert.EqualError(t, err, "invalid cursor")
+		})
+	}
+}
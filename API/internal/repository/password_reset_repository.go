@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type PasswordResetRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPasswordResetRepository(db *mongo.Database) domain.PasswordResetRepository {
+	return &PasswordResetRepository{
+		collection: db.Collection("password_reset_tokens"),
+	}
+}
+
+func (r *PasswordResetRepository) Create(ctx context.Context, token *domain.PasswordResetToken) error {
+	logger := slog.With(
+		slog.String("repository", "PasswordResetRepository"),
+		slog.String("method", "Create"),
+		slog.String("userID", token.UserID),
+	)
+
+	if _, err := r.collection.InsertOne(ctx, token); err != nil {
+		logger.Error("failed to create password reset token", slog.Any("error", err))
+		return domain.NewInternalError("failed to create password reset token")
+	}
+
+	return nil
+}
+
+func (r *PasswordResetRepository) Consume(ctx context.Context, id string, usedAt time.Time) (*domain.PasswordResetToken, error) {
+	logger := slog.With(
+		slog.String("repository", "PasswordResetRepository"),
+		slog.String("method", "Consume"),
+	)
+
+	filter := bson.M{
+		"_id":        id,
+		"used_at":    bson.M{"$exists": false},
+		"expires_at": bson.M{"$gt": usedAt},
+	}
+
+	var token domain.PasswordResetToken
+	err := r.collection.FindOneAndUpdate(ctx, filter, bson.M{"$set": bson.M{"used_at": usedAt}}).Decode(&token)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("password reset token not found or already used")
+			return nil, nil
+		}
+		logger.Error("failed to consume password reset token", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to consume password reset token")
+	}
+
+	return &token, nil
+}
+
+func (r *PasswordResetRepository) Release(ctx context.Context, id string) error {
+	logger := slog.With(
+		slog.String("repository", "PasswordResetRepository"),
+		slog.String("method", "Release"),
+	)
+
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$unset": bson.M{"used_at": ""}}); err != nil {
+		logger.Error("failed to release password reset token", slog.Any("error", err))
+		return domain.NewInternalError("failed to release password reset token")
+	}
+
+	return nil
+}
+
+func (r *PasswordResetRepository) DeleteByUser(ctx context.Context, userID string) error {
+	logger := slog.With(
+		slog.String("repository", "PasswordResetRepository"),
+		slog.String("method", "DeleteByUser"),
+		slog.String("userID", userID),
+	)
+
+	if _, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID}); err != nil {
+		logger.Error("failed to delete password reset tokens", slog.Any("error", err))
+		return domain.NewInternalError("failed to delete password reset tokens")
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type RefreshTokenRepository struct {
+	collection *mongo.Collection
+}
+
+func NewRefreshTokenRepository(db *mongo.Database) domain.RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		collection: db.Collection("refresh_tokens"),
+	}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	logger := slog.With(
+		slog.String("repository", "RefreshTokenRepository"),
+		slog.String("method", "Create"),
+		slog.String("userID", token.UserID),
+		slog.String("familyID", token.FamilyID),
+	)
+
+	if _, err := r.collection.InsertOne(ctx, token); err != nil {
+		logger.Error("failed to create refresh token", slog.Any("error", err))
+		return domain.NewInternalError("failed to create refresh token")
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetByID(ctx context.Context, id string) (*domain.RefreshToken, error) {
+	logger := slog.With(
+		slog.String("repository", "RefreshTokenRepository"),
+		slog.String("method", "GetByID"),
+		slog.String("tokenID", id),
+	)
+
+	var token domain.RefreshToken
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&token)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("refresh token not found")
+			return nil, nil
+		}
+		logger.Error("failed to get refresh token", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to get refresh token")
+	}
+
+	return &token, nil
+}
+
+func (r *RefreshTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) (bool, error) {
+	logger := slog.With(
+		slog.String("repository", "RefreshTokenRepository"),
+		slog.String("method", "MarkUsed"),
+		slog.String("tokenID", id),
+	)
+
+	filter := bson.M{
+		"_id":        id,
+		"used_at":    bson.M{"$exists": false},
+		"revoked_at": bson.M{"$exists": false},
+	}
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"used_at": usedAt}})
+	if err != nil {
+		logger.Error("failed to mark refresh token as used", slog.Any("error", err))
+		return false, domain.NewInternalError("failed to update refresh token")
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	logger := slog.With(
+		slog.String("repository", "RefreshTokenRepository"),
+		slog.String("method", "RevokeFamily"),
+		slog.String("familyID", familyID),
+	)
+
+	return r.revoke(ctx, logger, bson.M{"family_id": familyID})
+}
+
+func (r *RefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID string) error {
+	logger := slog.With(
+		slog.String("repository", "RefreshTokenRepository"),
+		slog.String("method", "RevokeAllForUser"),
+		slog.String("userID", userID),
+	)
+
+	return r.revoke(ctx, logger, bson.M{"user_id": userID})
+}
+
+func (r *RefreshTokenRepository) revoke(ctx context.Context, logger *slog.Logger, filter bson.M) error {
+	filter["revoked_at"] = bson.M{"$exists": false}
+
+	result, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"revoked_at": time.Now()}})
+	if err != nil {
+		logger.Error("failed to revoke refresh tokens", slog.Any("error", err))
+		return domain.NewInternalError("failed to revoke refresh tokens")
+	}
+
+	logger.Info("refresh tokens revoked", slog.Int64("count", result.ModifiedCount))
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TokenRevocationRepository struct {
+	revokedTokens      *mongo.Collection
+	sessionRevocations *mongo.Collection
+}
+
+func NewTokenRevocationRepository(db *mongo.Database) domain.TokenRevocationRepository {
+	return &TokenRevocationRepository{
+		revokedTokens:      db.Collection("revoked_tokens"),
+		sessionRevocations: db.Collection("session_revocations"),
+	}
+}
+
+// RevokeToken records the revocation of a token. It only inserts, so it reports whether this
+// call was the first to revoke the jti.
+func (r *TokenRevocationRepository) RevokeToken(ctx context.Context, token *domain.RevokedToken) (bool, error) {
+	logger := slog.With(
+		slog.String("repository", "TokenRevocationRepository"),
+		slog.String("method", "RevokeToken"),
+		slog.String("userID", token.UserID),
+	)
+
+	result, err := r.revokedTokens.UpdateOne(ctx,
+		bson.M{"_id": token.ID},
+		bson.M{"$setOnInsert": token},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		logger.Error("failed to revoke token", slog.Any("error", err))
+		return false, domain.NewInternalError("failed to revoke token")
+	}
+
+	return result.UpsertedCount == 1, nil
+}
+
+func (r *TokenRevocationRepository) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	logger := slog.With(
+		slog.String("repository", "TokenRevocationRepository"),
+		slog.String("method", "IsTokenRevoked"),
+	)
+
+	count, err := r.revokedTokens.CountDocuments(ctx, bson.M{"_id": tokenID}, options.Count().SetLimit(1))
+	if err != nil {
+		logger.Error("failed to check token revocation", slog.Any("error", err))
+		return false, domain.NewInternalError("failed to check token revocation")
+	}
+
+	return count > 0, nil
+}
+
+func (r *TokenRevocationRepository) RevokeSessions(ctx context.Context, revocation *domain.SessionRevocation) error {
+	logger := slog.With(
+		slog.String("repository", "TokenRevocationRepository"),
+		slog.String("method", "RevokeSessions"),
+		slog.String("userID", revocation.UserID),
+	)
+
+	_, err := r.sessionRevocations.ReplaceOne(ctx,
+		bson.M{"_id": revocation.UserID},
+		revocation,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		logger.Error("failed to revoke sessions", slog.Any("error", err))
+		return domain.NewInternalError("failed to revoke sessions")
+	}
+
+	logger.Info("user sessions revoked")
+	return nil
+}
+
+func (r *TokenRevocationRepository) GetSessionRevocation(ctx context.Context, userID string) (*domain.SessionRevocation, error) {
+	logger := slog.With(
+		slog.String("repository", "TokenRevocationRepository"),
+		slog.String("method", "GetSessionRevocation"),
+		slog.String("userID", userID),
+	)
+
+	var revocation domain.SessionRevocation
+	err := r.sessionRevocations.FindOne(ctx, bson.M{"_id": userID}).Decode(&revocation)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		logger.Error("failed to get session revocation", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to get session revocation")
+	}
+
+	return &revocation, nil
+}
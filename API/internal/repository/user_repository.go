@@ -3,10 +3,15 @@ package repository
 import (
 	"context"
 	"log/slog"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/vida-plus/api/internal/domain"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type UserRepository struct {
@@ -32,6 +37,11 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *domain.User) erro
 
 	_, err := r.collection.InsertOne(ctx, user)
 	if err != nil {
+		// Concurrent registrations of the same email pass the existence check of the services
+		if conflict, ok := duplicateUserError(err); ok {
+			logger.Info("duplicate user", slog.Any("error", err))
+			return conflict
+		}
 		logger.Error("failed to create user", slog.Any("error", err))
 		return domain.NewInternalError("failed to create user")
 	}
@@ -84,25 +94,420 @@ func (r *UserRepository) GetByID(ctx context.Context, id string) (*domain.User,
 	return &user, nil
 }
 
-func (r *UserRepository) GetAllUsers(ctx context.Context) ([]*domain.User, error) {
+// userSortFields maps domain.UserSortFields to their document paths and types
+var userSortFields = map[string]sortField{
+	"created_at": {Path: "created_at", Type: bson.TypeDateTime},
+	"email":      {Path: "email", Type: bson.TypeString},
+	"first_name": {Path: "profile.first_name", Type: bson.TypeString},
+	"last_name":  {Path: "profile.last_name", Type: bson.TypeString},
+}
+
+func (r *UserRepository) ListUsers(ctx context.Context, filter domain.UserFilter, page domain.PageRequest) (*domain.UserPage, error) {
 	logger := slog.With(
 		slog.String("repository", "UserRepository"),
-		slog.String("method", "GetAllUsers"),
+		slog.String("method", "ListUsers"),
 	)
 
-	cursor, err := r.collection.Find(ctx, bson.M{})
-	if err != nil {
-		logger.Error("failed to find users", slog.Any("error", err))
-		return nil, domain.NewInternalError("failed to find users")
+	query := bson.M{"deleted_at": bson.M{"$exists": filter.Deleted}}
+	if filter.Type != "" {
+		query["type"] = filter.Type
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.CreatedFrom != nil || filter.CreatedTo != nil {
+		createdAt := bson.M{}
+		if filter.CreatedFrom != nil {
+			createdAt["$gte"] = *filter.CreatedFrom
+		}
+		if filter.CreatedTo != nil {
+			createdAt["$lt"] = *filter.CreatedTo
+		}
+		query["created_at"] = createdAt
+	}
+	if filter.Search != "" {
+		prefix := primitive.Regex{Pattern: "^" + regexp.QuoteMeta(filter.Search), Options: "i"}
+		query["$or"] = bson.A{
+			bson.M{"email": prefix},
+			bson.M{"profile.first_name": prefix},
+			bson.M{"profile.last_name": prefix},
+		}
 	}
-	defer cursor.Close(ctx)
 
-	var users []*domain.User
-	if err = cursor.All(ctx, &users); err != nil {
-		logger.Error("failed to decode users", slog.Any("error", err))
-		return nil, domain.NewInternalError("failed to decode users")
+	users, err := findPage[*domain.User](ctx, r.collection, query, page, userSortFields)
+	if err != nil {
+		logger.Error("failed to list users", slog.Any("error", err))
+		return nil, err
 	}
 
-	logger.Info("users retrieved successfully", slog.Int("count", len(users)))
+	logger.Info("users listed successfully", slog.Int("count", len(users.Items)), slog.Int64("total", users.Total))
 	return users, nil
 }
+
+// doctorSortFields maps domain.DoctorSortFields to their document paths and types
+var doctorSortFields = map[string]sortField{
+	"first_name": {Path: "profile.first_name", Type: bson.TypeString},
+	"last_name":  {Path: "profile.last_name", Type: bson.TypeString},
+}
+
+func (r *UserRepository) ListDoctors(ctx context.Context, filter domain.DoctorFilter, page domain.PageRequest) (*domain.UserPage, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "ListDoctors"),
+	)
+
+	doctors, err := findPage[*domain.User](ctx, r.collection, doctorQuery(filter), page, doctorSortFields)
+	if err != nil {
+		logger.Error("failed to list doctors", slog.Any("error", err))
+		return nil, err
+	}
+
+	logger.Info("doctors listed successfully", slog.Int("count", len(doctors.Items)), slog.Int64("total", doctors.Total))
+	return doctors, nil
+}
+
+// doctorQuery selects the active doctors matching filter
+func doctorQuery(filter domain.DoctorFilter) bson.M {
+	query := bson.M{
+		"type":       domain.UserTypeDoctor,
+		"status":     domain.UserStatusActive,
+		"deleted_at": bson.M{"$exists": false},
+	}
+	if filter.Speciality != "" {
+		query["profile.speciality"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(strings.TrimSpace(filter.Speciality)) + "$", Options: "i"}
+	}
+	if filter.Department != "" {
+		query["profile.department"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(strings.TrimSpace(filter.Department)) + "$", Options: "i"}
+	}
+
+	var terms bson.A
+	for _, word := range strings.Fields(filter.Search) {
+		// Matches the word at the start of the field or after a space
+		prefix := primitive.Regex{Pattern: `(^|\s)` + regexp.QuoteMeta(word), Options: "i"}
+		terms = append(terms, bson.M{"$or": bson.A{
+			bson.M{"profile.first_name": prefix},
+			bson.M{"profile.last_name": prefix},
+			bson.M{"profile.speciality": prefix},
+			bson.M{"profile.department": prefix},
+		}})
+	}
+	if len(terms) > 0 {
+		query["$and"] = terms
+	}
+
+	return query
+}
+
+func (r *UserRepository) UpdateStatus(ctx context.Context, id string, status domain.UserStatus, reason, changedBy string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "UpdateStatus"),
+		slog.String("userID", id),
+		slog.String("status", string(status)),
+	)
+
+	now := time.Now()
+	update := bson.M{"$set": bson.M{
+		"status":            status,
+		"status_reason":     reason,
+		"status_changed_by": changedBy,
+		"status_changed_at": now,
+		"updated_at":        now,
+	}}
+
+	var user domain.User
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("user not found")
+			return nil, nil
+		}
+		logger.Error("failed to update user status", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to update user status")
+	}
+
+	logger.Info("user status updated successfully")
+	return &user, nil
+}
+
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, id, email, reason, changedBy string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "MarkEmailVerified"),
+		slog.String("userID", id),
+	)
+
+	filter := bson.M{
+		"_id":               id,
+		"status":            domain.UserStatusPending,
+		"email_verified_at": bson.M{"$exists": false},
+	}
+	if email != "" {
+		filter["email"] = email
+	}
+
+	now := time.Now()
+	update := bson.M{"$set": bson.M{
+		"status":            domain.UserStatusActive,
+		"email_verified_at": now,
+		"status_reason":     reason,
+		"status_changed_by": changedBy,
+		"status_changed_at": now,
+		"updated_at":        now,
+	}}
+
+	var user domain.User
+	err := r.collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("no pending unverified user found")
+			return nil, nil
+		}
+		logger.Error("failed to mark email as verified", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to verify email")
+	}
+
+	logger.Info("email verified successfully")
+	return &user, nil
+}
+
+func (r *UserRepository) MarkVerificationSent(ctx context.Context, id string, sentAt, notAfter time.Time) (bool, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "MarkVerificationSent"),
+		slog.String("userID", id),
+	)
+
+	filter := bson.M{
+		"_id":               id,
+		"status":            domain.UserStatusPending,
+		"email_verified_at": bson.M{"$exists": false},
+		"$or": bson.A{
+			bson.M{"verification_sent_at": bson.M{"$exists": false}},
+			bson.M{"verification_sent_at": bson.M{"$lte": notAfter}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"verification_sent_at": sentAt}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		logger.Error("failed to record verification email", slog.Any("error", err))
+		return false, domain.NewInternalError("failed to record verification email")
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+func (r *UserRepository) MarkResetSent(ctx context.Context, id string, sentAt, notAfter time.Time) (bool, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "MarkResetSent"),
+		slog.String("userID", id),
+	)
+
+	filter := bson.M{
+		"_id": id,
+		"$or": bson.A{
+			bson.M{"reset_sent_at": bson.M{"$exists": false}},
+			bson.M{"reset_sent_at": bson.M{"$lte": notAfter}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"reset_sent_at": sentAt}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		logger.Error("failed to record password reset email", slog.Any("error", err))
+		return false, domain.NewInternalError("failed to record password reset email")
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+func (r *UserRepository) UpdatePassword(ctx context.Context, id, hashedPassword string) error {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "UpdatePassword"),
+		slog.String("userID", id),
+	)
+
+	update := bson.M{"$set": bson.M{"password": hashedPassword, "updated_at": time.Now()}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		logger.Error("failed to update password", slog.Any("error", err))
+		return domain.NewInternalError("failed to update password")
+	}
+	if result.MatchedCount == 0 {
+		logger.Info("user not found")
+		return domain.NewNotFoundError("user not found")
+	}
+
+	logger.Info("password updated successfully")
+	return nil
+}
+
+// RecordActivity doesn't touch updated_at, since activity isn't a change to the account.
+func (r *UserRepository) RecordActivity(ctx context.Context, id string, at time.Time) error {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "RecordActivity"),
+		slog.String("userID", id),
+	)
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"last_active_at": at}})
+	if err != nil {
+		logger.Error("failed to record activity", slog.Any("error", err))
+		return domain.NewInternalError("failed to record activity")
+	}
+
+	return nil
+}
+
+func (r *UserRepository) UpdateUser(ctx context.Context, id string, update domain.UserUpdate) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "UpdateUser"),
+		slog.String("userID", id),
+	)
+
+	set := bson.M{"updated_at": time.Now()}
+	if update.Type != nil {
+		set["type"] = *update.Type
+	}
+	if update.Profile != nil {
+		set["profile"] = update.Profile
+	}
+	if update.PendingEmail != nil {
+		set["pending_email"] = *update.PendingEmail
+	}
+
+	var user domain.User
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{"$set": set},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("user not found")
+			return nil, nil
+		}
+		if conflict, ok := duplicateUserError(err); ok {
+			logger.Info("duplicate user", slog.Any("error", err))
+			return nil, conflict
+		}
+		logger.Error("failed to update user", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to update user")
+	}
+
+	logger.Info("user updated successfully")
+	return &user, nil
+}
+
+func (r *UserRepository) ConfirmEmailChange(ctx context.Context, id, email string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "ConfirmEmailChange"),
+		slog.String("userID", id),
+	)
+
+	now := time.Now()
+	update := bson.M{
+		"$set":   bson.M{"email": email, "email_verified_at": now, "updated_at": now},
+		"$unset": bson.M{"pending_email": ""},
+	}
+
+	var user domain.User
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id, "pending_email": email}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("no pending email change found")
+			return nil, nil
+		}
+		if conflict, ok := duplicateUserError(err); ok {
+			logger.Info("email taken since the change was requested", slog.Any("error", err))
+			return nil, conflict
+		}
+		logger.Error("failed to confirm email change", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to confirm email change")
+	}
+
+	logger.Info("email changed successfully")
+	return &user, nil
+}
+
+func (r *UserRepository) SoftDelete(ctx context.Context, id, deletedBy string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "SoftDelete"),
+		slog.String("userID", id),
+	)
+
+	now := time.Now()
+	update := bson.M{"$set": bson.M{"deleted_at": now, "deleted_by": deletedBy, "updated_at": now}}
+
+	var user domain.User
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id, "deleted_at": bson.M{"$exists": false}}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("no user to delete found")
+			return nil, nil
+		}
+		logger.Error("failed to delete user", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to delete user")
+	}
+
+	logger.Info("user deleted successfully")
+	return &user, nil
+}
+
+func (r *UserRepository) Restore(ctx context.Context, id string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "Restore"),
+		slog.String("userID", id),
+	)
+
+	update := bson.M{
+		"$set":   bson.M{"updated_at": time.Now()},
+		"$unset": bson.M{"deleted_at": "", "deleted_by": ""},
+	}
+
+	var user domain.User
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id, "deleted_at": bson.M{"$exists": true}}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			logger.Info("no deleted user found")
+			return nil, nil
+		}
+		logger.Error("failed to restore user", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to restore user")
+	}
+
+	logger.Info("user restored successfully")
+	return &user, nil
+}
+
+// duplicateUserError maps a duplicate key error of the unique indexes of users to a conflict
+// naming the field that is already taken.
+func duplicateUserError(err error) (*domain.APIError, bool) {
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, false
+	}
+
+	switch message := err.Error(); {
+	case strings.Contains(message, usersCPFIndex):
+		return domain.NewConflictError("cpf is already registered"), true
+	case strings.Contains(message, usersCRMIndex):
+		return domain.NewConflictError("crm is already registered"), true
+	default:
+		return domain.NewConflictError("user already exists"), true
+	}
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func Test_DuplicateUserError(t *testing.T) {
+	duplicate := func(index string) error {
+		return mongo.WriteException{WriteErrors: []mongo.WriteError{{
+			Code:    11000,
+			Message: "E11000 duplicate key error collection: vida_plus.users index: " + index + " dup key",
+		}}}
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		message string
+	}{
+		{"EMAIL", duplicate(usersEmailIndex), "user already exists"},
+		{"CPF", duplicate(usersCPFIndex), "cpf is already registered"},
+		{"CRM", duplicate(usersCRMIndex), "crm is already registered"},
+		{"OTHER", errors.New("connection refused"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflict, ok := duplicateUserError(tt.err)
+			if tt.message == "" {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, 409, conflict.Status)
+			assert.Equal(t, tt.message, conflict.Details)
+		})
+	}
+}
+
+func Test_DoctorQuery(t *testing.T) {
+	query := doctorQuery(domain.DoctorFilter{Search: "ana  cardio", Speciality: " Cardiologia "})
+
+	assert.Equal(t, domain.UserTypeDoctor, query["type"])
+	assert.Equal(t, domain.UserStatusActive, query["status"])
+	assert.Equal(t, primitive.Regex{Pattern: "^Cardiologia$", Options: "i"}, query["profile.speciality"])
+	assert.NotContains(t, query, "profile.department")
+
+	// Every word must match one of the fields
+	terms, ok := query["$and"].(bson.A)
+	require.True(t, ok)
+	require.Len(t, terms, 2)
+	assert.Equal(t, primitive.Regex{Pattern: `(^|\s)cardio`, Options: "i"},
+		terms[1].(bson.M)["$or"].(bson.A)[2].(bson.M)["profile.speciality"])
+
+	assert.NotContains(t, doctorQuery(domain.DoctorFilter{}), "$and")
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/vida-plus/api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// countBucket is a group of a $group stage counting documents
+type countBucket struct {
+	Key   string `bson:"_id"`
+	Count int64  `bson:"count"`
+}
+
+// userStatsResult is the output of the $facet stage of GetStats
+type userStatsResult struct {
+	Total         []countBucket       `bson:"total"`
+	ByType        []countBucket       `bson:"by_type"`
+	ByStatus      []countBucket       `bson:"by_status"`
+	ByDepartment  []countBucket       `bson:"by_department"`
+	Active        []map[string]int64  `bson:"active"`
+	Registrations []domain.TimeBucket `bson:"registrations"`
+}
+
+// GetStats computes every count in a single pass over the users, with one $facet sub-pipeline each.
+func (r *UserRepository) GetStats(ctx context.Context, period domain.StatsPeriod) (*domain.SystemStats, error) {
+	logger := slog.With(
+		slog.String("repository", "UserRepository"),
+		slog.String("method", "GetStats"),
+		slog.Time("from", period.From),
+		slog.Time("to", period.To),
+		slog.String("interval", string(period.Interval)),
+	)
+
+	countBy := func(field string) bson.A {
+		return bson.A{bson.M{"$group": bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}}}
+	}
+
+	// A user is active in a window when its last activity falls in it. Users that never logged
+	// in have no last_active_at, which compares lower than any date.
+	active := bson.M{"_id": nil}
+	for name, window := range domain.ActivityWindows {
+		since := period.Now.Add(-window)
+		active[name] = bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$gte": bson.A{"$last_active_at", since}}, 1, 0}}}
+	}
+
+	truncate := bson.M{"date": "$created_at", "unit": period.Interval, "timezone": "UTC"}
+	if period.Interval == domain.StatsIntervalWeek {
+		truncate["startOfWeek"] = "monday"
+	}
+
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"deleted_at": bson.M{"$exists": false}}},
+		bson.M{"$facet": bson.M{
+			"total":     bson.A{bson.M{"$count": "count"}},
+			"by_type":   countBy("type"),
+			"by_status": countBy("status"),
+			"by_department": append(bson.A{
+				bson.M{"$match": bson.M{"profile.department": bson.M{"$nin": bson.A{nil, ""}}}},
+			}, countBy("profile.department")...),
+			"active": bson.A{
+				bson.M{"$group": active},
+				bson.M{"$project": bson.M{"_id": 0}},
+			},
+			"registrations": bson.A{
+				bson.M{"$match": bson.M{"created_at": bson.M{"$gte": period.From, "$lt": period.To}}},
+				bson.M{"$group": bson.M{"_id": bson.M{"$dateTrunc": truncate}, "count": bson.M{"$sum": 1}}},
+				bson.M{"$sort": bson.M{"_id": 1}},
+			},
+		}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.Error("failed to aggregate user stats", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to aggregate user stats")
+	}
+	defer cursor.Close(ctx)
+
+	var results []userStatsResult
+	if err := cursor.All(ctx, &results); err != nil || len(results) != 1 {
+		logger.Error("failed to decode user stats", slog.Any("error", err))
+		return nil, domain.NewInternalError("failed to decode user stats")
+	}
+	result := results[0]
+
+	stats := &domain.SystemStats{
+		ByType:        map[domain.UserType]int64{},
+		ByStatus:      map[domain.UserStatus]int64{},
+		ByDepartment:  map[string]int64{},
+		ActiveUsers:   map[string]int64{},
+		Registrations: result.Registrations,
+	}
+	if len(result.Total) > 0 {
+		stats.TotalUsers = result.Total[0].Count
+	}
+	for _, bucket := range result.ByType {
+		stats.ByType[domain.UserType(bucket.Key)] = bucket.Count
+	}
+	for _, bucket := range result.ByStatus {
+		stats.ByStatus[domain.UserStatus(bucket.Key)] = bucket.Count
+	}
+	for _, bucket := range result.ByDepartment {
+		stats.ByDepartment[bucket.Key] = bucket.Count
+	}
+	var activeUsers map[string]int64
+	if len(result.Active) > 0 {
+		activeUsers = result.Active[0]
+	}
+	for name := range domain.ActivityWindows {
+		stats.ActiveUsers[name] = activeUsers[name]
+	}
+
+	return stats, nil
+}
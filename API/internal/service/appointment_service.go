@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+)
+
+const (
+	// maxAppointmentDuration bounds how long a single appointment may take up the calendar of a doctor
+	maxAppointmentDuration = 4 * time.Hour
+	// checkInWindow is how long before its start a patient can be checked in to an appointment,
+	// which is possible until the appointment ends
+	checkInWindow = 30 * time.Minute
+)
+
+// AppointmentServiceImpl implements AppointmentService interface.
+type AppointmentServiceImpl struct {
+	appointments domain.AppointmentRepository
+	users        domain.UserRepository
+	availability domain.AvailabilityService
+	now          func() time.Time
+}
+
+// NewAppointmentService creates an AppointmentService that books the slots of availability
+func NewAppointmentService(appointments domain.AppointmentRepository, users domain.UserRepository,
+	availability domain.AvailabilityService) domain.AppointmentService {
+	return &AppointmentServiceImpl{
+		appointments: appointments,
+		users:        users,
+		availability: availability,
+		now:          time.Now,
+	}
+}
+
+// managesAny reports whether actor manages the appointments of any patient rather than only their own
+func managesAny(actor *domain.AuthClaims) bool {
+	user := &domain.User{Type: actor.UserType}
+	return user.HasPermission(domain.PermissionManageAppointments)
+}
+
+// canAccess reports whether actor may see and change appointment
+func canAccess(actor *domain.AuthClaims, appointment *domain.Appointment) bool {
+	if managesAny(actor) {
+		return true
+	}
+	user := &domain.User{Type: actor.UserType}
+	return user.HasPermission(domain.PermissionManageOwnAppointments) && appointment.PatientID == actor.UserID
+}
+
+func (s *AppointmentServiceImpl) Book(ctx context.Context, req domain.BookAppointmentRequest, actor *domain.AuthClaims) (*domain.Appointment, error) {
+	logger := slog.With(
+		slog.String("service", "AppointmentService"),
+		slog.String("method", "Book"),
+		slog.String("doctorID", req.DoctorID),
+		slog.String("actorID", actor.UserID),
+	)
+
+	patientID := req.PatientID
+	if !managesAny(actor) {
+		// Patients book for themselves
+		if patientID != "" && patientID != actor.UserID {
+			logger.Info("patient booking for someone else")
+			return nil, domain.NewForbiddenError("patients can only book their own appointments")
+		}
+		patientID = actor.UserID
+	}
+	if patientID == "" {
+		return nil, domain.NewBadRequestError("patient_id is required")
+	}
+
+	now := s.now()
+	if err := s.validateTime(req.StartsAt, req.EndsAt, now); err != nil {
+		return nil, err
+	}
+	if err := s.checkUser(ctx, req.DoctorID, domain.UserTypeDoctor); err != nil {
+		logger.Info("invalid doctor", slog.Any("error", err))
+		return nil, err
+	}
+	if err := s.checkUser(ctx, patientID, domain.UserTypePatient); err != nil {
+		logger.Info("invalid patient", slog.Any("error", err))
+		return nil, err
+	}
+
+	appointment := &domain.Appointment{
+		ID:        pkg.GenerateID(),
+		PatientID: patientID,
+		DoctorID:  req.DoctorID,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		Status:    domain.AppointmentStatusBooked,
+		Reason:    req.Reason,
+		BookedBy:  actor.UserID,
+		CreatedAt: now,
+		UpdatedAt: now,
+		History: []domain.AppointmentChange{
+			{Status: domain.AppointmentStatusBooked, ChangedBy: actor.UserID, ChangedAt: now},
+		},
+	}
+	if err := s.appointments.Create(ctx, appointment, s.slotCheck(req.DoctorID, req.StartsAt, req.EndsAt)); err != nil {
+		logger.Info("error creating appointment", slog.Any("error", err))
+		return nil, err
+	}
+
+	logger.Info("appointment booked", slog.String("appointmentID", appointment.ID))
+	return appointment, nil
+}
+
+func (s *AppointmentServiceImpl) Get(ctx context.Context, id string, actor *domain.AuthClaims) (*domain.Appointment, error) {
+	return s.getAccessible(ctx, id, actor)
+}
+
+func (s *AppointmentServiceImpl) List(ctx context.Context, filter domain.AppointmentFilter, query domain.ListQuery,
+	actor *domain.AuthClaims) (*domain.AppointmentPage, error) {
+	logger := slog.With(
+		slog.String("service", "AppointmentService"),
+		slog.String("method", "List"),
+		slog.String("actorID", actor.UserID),
+	)
+
+	page, err := query.PageRequest(domain.AppointmentSortFields, domain.SortOrder{Field: "starts_at"})
+	if err != nil {
+		logger.Info("invalid list query", slog.Any("error", err))
+		return nil, err
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, domain.NewBadRequestError("from must be before to")
+	}
+
+	// Patients only list their own appointments, whatever they ask for
+	if !managesAny(actor) {
+		filter.PatientID = actor.UserID
+	}
+
+	appointments, err := s.appointments.List(ctx, filter, page)
+	if err != nil {
+		logger.Error("error listing appointments", slog.Any("error", err))
+		return nil, err
+	}
+
+	return appointments, nil
+}
+
+func (s *AppointmentServiceImpl) Reschedule(ctx context.Context, id string, req domain.RescheduleAppointmentRequest,
+	actor *domain.AuthClaims) (*domain.Appointment, error) {
+	logger := slog.With(
+		slog.String("service", "AppointmentService"),
+		slog.String("method", "Reschedule"),
+		slog.String("appointmentID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	current, err := s.getAccessible(ctx, id, actor)
+	if err != nil {
+		return nil, err
+	}
+	if !current.Status.CanChangeTo(domain.AppointmentStatusRescheduled) {
+		logger.Info("appointment can't be rescheduled", slog.String("status", string(current.Status)))
+		return nil, domain.NewConflictError("appointment is " + string(current.Status) + " and can't be rescheduled")
+	}
+
+	now := s.now()
+	if err := s.validateTime(req.StartsAt, req.EndsAt, now); err != nil {
+		return nil, err
+	}
+
+	prevStartsAt, prevEndsAt := current.StartsAt, current.EndsAt
+	appointment, err := s.appointments.Reschedule(ctx, current, req.StartsAt, req.EndsAt, domain.AppointmentChange{
+		Status:       domain.AppointmentStatusRescheduled,
+		ChangedBy:    actor.UserID,
+		ChangedAt:    now,
+		Reason:       req.Reason,
+		PrevStartsAt: &prevStartsAt,
+		PrevEndsAt:   &prevEndsAt,
+	}, s.slotCheck(current.DoctorID, req.StartsAt, req.EndsAt))
+	if err != nil {
+		logger.Info("error rescheduling appointment", slog.Any("error", err))
+		return nil, err
+	}
+	if appointment == nil {
+		logger.Info("appointment changed while rescheduling")
+		return nil, domain.NewConflictError("appointment was changed by someone else, try again")
+	}
+
+	logger.Info("appointment rescheduled")
+	return appointment, nil
+}
+
+func (s *AppointmentServiceImpl) Cancel(ctx context.Context, id string, req domain.CancelAppointmentRequest,
+	actor *domain.AuthClaims) (*domain.Appointment, error) {
+	return s.changeStatus(ctx, id, domain.AppointmentStatusCancelled, req.Reason, actor)
+}
+
+func (s *AppointmentServiceImpl) CheckIn(ctx context.Context, id string, actor *domain.AuthClaims) (*domain.Appointment, error) {
+	if !managesAny(actor) {
+		return nil, domain.NewForbiddenError("only staff can check patients in")
+	}
+	return s.changeStatus(ctx, id, domain.AppointmentStatusCheckedIn, "", actor)
+}
+
+func (s *AppointmentServiceImpl) NoShow(ctx context.Context, id string, actor *domain.AuthClaims) (*domain.Appointment, error) {
+	if !managesAny(actor) {
+		return nil, domain.NewForbiddenError("only staff can mark no-shows")
+	}
+	return s.changeStatus(ctx, id, domain.AppointmentStatusNoShow, "", actor)
+}
+
+// slotCheck returns the check the appointment repository runs under the lock of the calendar,
+// so that startsAt to endsAt is a slot of the doctor by the calendar as it is when booking
+func (s *AppointmentServiceImpl) slotCheck(doctorID string, startsAt, endsAt time.Time) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		return s.availability.CheckSlot(ctx, doctorID, startsAt, endsAt)
+	}
+}
+
+// changeStatus moves an appointment actor can access to status, if its current status allows it
+func (s *AppointmentServiceImpl) changeStatus(ctx context.Context, id string, status domain.AppointmentStatus, reason string,
+	actor *domain.AuthClaims) (*domain.Appointment, error) {
+	logger := slog.With(
+		slog.String("service", "AppointmentService"),
+		slog.String("method", "changeStatus"),
+		slog.String("appointmentID", id),
+		slog.String("status", string(status)),
+		slog.String("actorID", actor.UserID),
+	)
+
+	current, err := s.getAccessible(ctx, id, actor)
+	if err != nil {
+		return nil, err
+	}
+	if !current.Status.CanChangeTo(status) {
+		logger.Info("invalid status change", slog.String("from", string(current.Status)))
+		return nil, domain.NewConflictError("appointment is " + string(current.Status) + " and can't become " + string(status))
+	}
+
+	now := s.now()
+	if status == domain.AppointmentStatusNoShow && now.Before(current.StartsAt) {
+		return nil, domain.NewConflictError("appointment hasn't started yet")
+	}
+	if status == domain.AppointmentStatusCheckedIn &&
+		(now.Before(current.StartsAt.Add(-checkInWindow)) || !now.Before(current.EndsAt)) {
+		logger.Info("check-in outside of the appointment window")
+		return nil, domain.NewBadRequestError(fmt.Sprintf("patients can be checked in from %s before the appointment starts until it ends",
+			checkInWindow))
+	}
+
+	appointment, err := s.appointments.UpdateStatus(ctx, id, domain.AppointmentChange{
+		Status:    status,
+		ChangedBy: actor.UserID,
+		ChangedAt: now,
+		Reason:    reason,
+	})
+	if err != nil {
+		logger.Error("error updating appointment status", slog.Any("error", err))
+		return nil, err
+	}
+	if appointment == nil {
+		// The status changed since it was read
+		logger.Info("appointment changed while updating status")
+		return nil, domain.NewConflictError("appointment was changed by someone else, try again")
+	}
+
+	logger.Info("appointment status changed")
+	return appointment, nil
+}
+
+// getAccessible returns an appointment actor can access. Appointments of other patients are
+// reported as not found, so their IDs can't be probed.
+func (s *AppointmentServiceImpl) getAccessible(ctx context.Context, id string, actor *domain.AuthClaims) (*domain.Appointment, error) {
+	logger := slog.With(
+		slog.String("service", "AppointmentService"),
+		slog.String("method", "getAccessible"),
+		slog.String("appointmentID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	appointment, err := s.appointments.GetByID(ctx, id)
+	if err != nil {
+		logger.Error("error fetching appointment", slog.Any("error", err))
+		return nil, err
+	}
+	if appointment == nil || !canAccess(actor, appointment) {
+		logger.Info("appointment not found or not accessible")
+		return nil, domain.NewNotFoundError("appointment not found")
+	}
+
+	return appointment, nil
+}
+
+// validateTime checks the time of a new or rescheduled appointment
+func (s *AppointmentServiceImpl) validateTime(startsAt, endsAt, now time.Time) error {
+	if !startsAt.After(now) {
+		return domain.NewBadRequestError("starts_at must be in the future")
+	}
+	if !endsAt.After(startsAt) {
+		return domain.NewBadRequestError("ends_at must be after starts_at")
+	}
+	if endsAt.Sub(startsAt) > maxAppointmentDuration {
+		return domain.NewBadRequestError(fmt.Sprintf("appointments can't be longer than %d hours", int(maxAppointmentDuration.Hours())))
+	}
+	return nil
+}
+
+// checkUser checks that id is an active user of type userType
+func (s *AppointmentServiceImpl) checkUser(ctx context.Context, id string, userType domain.UserType) error {
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.IsDeleted() || user.Type != userType {
+		return domain.NewBadRequestError(string(userType) + " not found")
+	}
+	if !user.IsActive() {
+		return domain.NewBadRequestError(string(userType) + " account is not active")
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	mocks "github.com/vida-plus/api/mocks"
+)
+
+var (
+	testPatient      = &domain.AuthClaims{UserID: "patient-1", UserType: domain.UserTypePatient}
+	testReceptionist = &domain.AuthClaims{UserID: "reception-1", UserType: domain.UserTypeReceptionist}
+)
+
+func newTestAppointmentService(t *testing.T) (*AppointmentServiceImpl, *mocks.AppointmentRepositoryMock, *mocks.UserRepositoryMock,
+	*mocks.AvailabilityServiceMock, time.Time) {
+	appointments := mocks.NewAppointmentRepositoryMock(t)
+	users := mocks.NewUserRepositoryMock(t)
+	availability := mocks.NewAvailabilityServiceMock(t)
+
+	now := time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC)
+	s := NewAppointmentService(appointments, users, availability).(*AppointmentServiceImpl)
+	s.now = func() time.Time { return now }
+	return s, appointments, users, availability, now
+}
+
+// runCheck makes the appointment repository mock run the check it is given, as it does under the calendar lock
+func runCheck(ctx context.Context, _ *domain.Appointment, check func(ctx context.Context) error) error {
+	return check(ctx)
+}
+
+func requireStatus(t *testing.T, err error, status int) {
+	t.Helper()
+
+	var apiErr *domain.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, status, apiErr.Status)
+}
+
+func Test_AppointmentService_Book(t *testing.T) {
+	ctx := context.Background()
+	doctor := &domain.User{ID: "doctor-1", Type: domain.UserTypeDoctor, Status: domain.UserStatusActive}
+	patient := &domain.User{ID: "patient-1", Type: domain.UserTypePatient, Status: domain.UserStatusActive}
+
+	t.Run("PATIENT BOOKS FOR THEMSELVES", func(t *testing.T) {
+		s, appointments, users, availability, now := newTestAppointmentService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+		users.EXPECT().GetByID(ctx, patient.ID).Return(patient, nil)
+		availability.EXPECT().CheckSlot(ctx, doctor.ID, now.Add(time.Hour), now.Add(90*time.Minute)).Return(nil)
+		appointments.EXPECT().Create(ctx, mock.MatchedBy(func(a *domain.Appointment) bool {
+			return a.PatientID == patient.ID && a.Status == domain.AppointmentStatusBooked && len(a.History) == 1
+		}), mock.Anything).RunAndReturn(runCheck)
+
+		appointment, err := s.Book(ctx, domain.BookAppointmentRequest{
+			DoctorID: doctor.ID,
+			StartsAt: now.Add(time.Hour),
+			EndsAt:   now.Add(90 * time.Minute),
+		}, testPatient)
+		require.NoError(t, err)
+		assert.Equal(t, testPatient.UserID, appointment.BookedBy)
+	})
+
+	t.Run("NOT A SLOT", func(t *testing.T) {
+		s, appointments, users, availability, now := newTestAppointmentService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+		users.EXPECT().GetByID(ctx, patient.ID).Return(patient, nil)
+		availability.EXPECT().CheckSlot(ctx, doctor.ID, now.Add(time.Hour), now.Add(90*time.Minute)).
+			Return(domain.NewBadRequestError("the doctor has no slot at this time"))
+		appointments.EXPECT().Create(ctx, mock.Anything, mock.Anything).RunAndReturn(runCheck)
+
+		_, err := s.Book(ctx, domain.BookAppointmentRequest{
+			DoctorID: doctor.ID,
+			StartsAt: now.Add(time.Hour),
+			EndsAt:   now.Add(90 * time.Minute),
+		}, testPatient)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("PATIENT BOOKING FOR SOMEONE ELSE", func(t *testing.T) {
+		s, _, _, _, now := newTestAppointmentService(t)
+
+		_, err := s.Book(ctx, domain.BookAppointmentRequest{
+			PatientID: "patient-2",
+			DoctorID:  doctor.ID,
+			StartsAt:  now.Add(time.Hour),
+			EndsAt:    now.Add(90 * time.Minute),
+		}, testPatient)
+		requireStatus(t, err, http.StatusForbidden)
+	})
+
+	t.Run("STAFF MUST SET PATIENT", func(t *testing.T) {
+		s, _, _, _, now := newTestAppointmentService(t)
+
+		_, err := s.Book(ctx, domain.BookAppointmentRequest{
+			DoctorID: doctor.ID,
+			StartsAt: now.Add(time.Hour),
+			EndsAt:   now.Add(90 * time.Minute),
+		}, testReceptionist)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("IN THE PAST", func(t *testing.T) {
+		s, _, _, _, now := newTestAppointmentService(t)
+
+		_, err := s.Book(ctx, domain.BookAppointmentRequest{
+			DoctorID: doctor.ID,
+			StartsAt: now.Add(-time.Hour),
+			EndsAt:   now.Add(-30 * time.Minute),
+		}, testPatient)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("TOO LONG", func(t *testing.T) {
+		s, _, _, _, now := newTestAppointmentService(t)
+
+		_, err := s.Book(ctx, domain.BookAppointmentRequest{
+			DoctorID: doctor.ID,
+			StartsAt: now.Add(time.Hour),
+			EndsAt:   now.Add(time.Hour + maxAppointmentDuration + time.Minute),
+		}, testPatient)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("NOT A DOCTOR", func(t *testing.T) {
+		s, _, users, _, now := newTestAppointmentService(t)
+		users.EXPECT().GetByID(ctx, "nurse-1").Return(&domain.User{ID: "nurse-1", Type: domain.UserTypeNurse,
+			Status: domain.UserStatusActive}, nil)
+
+		_, err := s.Book(ctx, domain.BookAppointmentRequest{
+			DoctorID: "nurse-1",
+			StartsAt: now.Add(time.Hour),
+			EndsAt:   now.Add(90 * time.Minute),
+		}, testPatient)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+}
+
+func Test_AppointmentService_access(t *testing.T) {
+	ctx := context.Background()
+	s, appointments, _, _, now := newTestAppointmentService(t)
+	other := &domain.Appointment{ID: "appointment-1", PatientID: "patient-2", DoctorID: "doctor-1",
+		Status: domain.AppointmentStatusBooked, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}
+	appointments.EXPECT().GetByID(ctx, other.ID).Return(other, nil)
+
+	// Appointments of other patients look like they don't exist
+	_, err := s.Get(ctx, other.ID, testPatient)
+	requireStatus(t, err, http.StatusNotFound)
+	_, err = s.Cancel(ctx, other.ID, domain.CancelAppointmentRequest{Reason: "travel"}, testPatient)
+	requireStatus(t, err, http.StatusNotFound)
+
+	got, err := s.Get(ctx, other.ID, testReceptionist)
+	require.NoError(t, err)
+	assert.Equal(t, other, got)
+
+	// Patients can't check themselves in
+	_, err = s.CheckIn(ctx, other.ID, &domain.AuthClaims{UserID: other.PatientID, UserType: domain.UserTypePatient})
+	requireStatus(t, err, http.StatusForbidden)
+}
+
+func Test_AppointmentService_changeStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("FINAL STATUS", func(t *testing.T) {
+		s, appointments, _, _, now := newTestAppointmentService(t)
+		appointments.EXPECT().GetByID(ctx, "appointment-1").Return(&domain.Appointment{ID: "appointment-1",
+			Status: domain.AppointmentStatusCancelled, StartsAt: now.Add(time.Hour)}, nil)
+
+		_, err := s.CheckIn(ctx, "appointment-1", testReceptionist)
+		requireStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("NO SHOW BEFORE START", func(t *testing.T) {
+		s, appointments, _, _, now := newTestAppointmentService(t)
+		appointments.EXPECT().GetByID(ctx, "appointment-1").Return(&domain.Appointment{ID: "appointment-1",
+			Status: domain.AppointmentStatusBooked, StartsAt: now.Add(time.Hour)}, nil)
+
+		_, err := s.NoShow(ctx, "appointment-1", testReceptionist)
+		requireStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("CHECK-IN WINDOW", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			startsIn time.Duration
+			allowed  bool
+		}{
+			{"AT WINDOW OPENING", checkInWindow, true},
+			{"BEFORE WINDOW", checkInWindow + time.Second, false},
+			{"JUST BEFORE END", -29 * time.Minute, true},
+			{"AT END", -30 * time.Minute, false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				s, appointments, _, _, now := newTestAppointmentService(t)
+				startsAt := now.Add(tt.startsIn)
+				appointments.EXPECT().GetByID(ctx, "appointment-1").Return(&domain.Appointment{ID: "appointment-1",
+					Status: domain.AppointmentStatusBooked, StartsAt: startsAt, EndsAt: startsAt.Add(30 * time.Minute)}, nil)
+				if tt.allowed {
+					checkedIn := &domain.Appointment{ID: "appointment-1", Status: domain.AppointmentStatusCheckedIn}
+					appointments.EXPECT().UpdateStatus(ctx, "appointment-1", domain.AppointmentChange{
+						Status:    domain.AppointmentStatusCheckedIn,
+						ChangedBy: testReceptionist.UserID,
+						ChangedAt: now,
+					}).Return(checkedIn, nil)
+				}
+
+				appointment, err := s.CheckIn(ctx, "appointment-1", testReceptionist)
+				if tt.allowed {
+					require.NoError(t, err)
+					assert.Equal(t, domain.AppointmentStatusCheckedIn, appointment.Status)
+				} else {
+					requireStatus(t, err, http.StatusBadRequest)
+				}
+			})
+		}
+	})
+
+	t.Run("CHANGED CONCURRENTLY", func(t *testing.T) {
+		s, appointments, _, _, now := newTestAppointmentService(t)
+		appointments.EXPECT().GetByID(ctx, "appointment-1").Return(&domain.Appointment{ID: "appointment-1",
+			Status: domain.AppointmentStatusBooked, StartsAt: now.Add(-time.Minute)}, nil)
+		appointments.EXPECT().UpdateStatus(ctx, "appointment-1", domain.AppointmentChange{
+			Status:    domain.AppointmentStatusNoShow,
+			ChangedBy: testReceptionist.UserID,
+			ChangedAt: now,
+		}).Return(nil, nil)
+
+		_, err := s.NoShow(ctx, "appointment-1", testReceptionist)
+		requireStatus(t, err, http.StatusConflict)
+	})
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// recordAudit writes an audit event. Failures are logged only, so the audit trail never blocks
+// the action being recorded.
+func recordAudit(ctx context.Context, audit domain.AuditRepository, logger *slog.Logger, event *domain.AuditEvent) {
+	if err := audit.Record(ctx, event); err != nil {
+		logger.Error("error recording audit event", slog.Any("error", err), slog.String("action", string(event.Action)))
+	}
+}
@@ -12,14 +12,38 @@ import (
 	"github.com/vida-plus/api/pkg"
 )
 
+// mfaChallengeTTL is how long the user has to enter an MFA code after the password step.
+const mfaChallengeTTL = 5 * time.Minute
+
+// mfaChallengeAttempts is how many wrong codes an MFA challenge takes before it is revoked.
+const mfaChallengeAttempts = 5
+
 // AuthServiceImpl implements AuthService interface.
 type AuthServiceImpl struct {
-	userStore domain.UserStore
-	jwt       domain.JWTManager
+	userStore     domain.UserStore
+	jwt           domain.JWTManager
+	refreshTokens domain.RefreshTokenRepository
+	revocations   domain.TokenRevocationStore
+	verifications domain.EmailVerificationService
+	mfa           domain.MFAService
+	throttle      domain.LoginThrottle
+	// refreshTokenTTL is how long a refresh token can be exchanged for a new pair.
+	refreshTokenTTL time.Duration
 }
 
-func NewAuthService(userStore domain.UserStore, jwt domain.JWTManager) domain.AuthService {
-	return &AuthServiceImpl{userStore: userStore, jwt: jwt}
+func NewAuthService(userStore domain.UserStore, jwt domain.JWTManager, refreshTokens domain.RefreshTokenRepository,
+	revocations domain.TokenRevocationStore, verifications domain.EmailVerificationService, mfa domain.MFAService,
+	throttle domain.LoginThrottle, refreshTokenTTL time.Duration) domain.AuthService {
+	return &AuthServiceImpl{
+		userStore:       userStore,
+		jwt:             jwt,
+		refreshTokens:   refreshTokens,
+		revocations:     revocations,
+		verifications:   verifications,
+		mfa:             mfa,
+		throttle:        throttle,
+		refreshTokenTTL: refreshTokenTTL,
+	}
 }
 
 func (a *AuthServiceImpl) Register(ctx context.Context, email, password string) (*domain.User, error) {
@@ -52,16 +76,18 @@ func (a *AuthServiceImpl) Register(ctx context.Context, email, password string)
 		Email:     email,
 		Password:  string(hashedPassword),
 		Type:      domain.UserTypePatient, // Default to patient
-		Status:    domain.UserStatusActive,
+		Status:    initialStatus(domain.UserTypePatient),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
 	if err := a.userStore.Create(ctx, user); err != nil {
 		logger.Error("error creating user", slog.Any("error", err))
-		return nil, domain.NewInternalError("error creating user")
+		return nil, err
 	}
 
+	a.sendVerification(ctx, logger, user)
+
 	logger.Info("user registered successfully", slog.String("userID", user.ID))
 	return user, nil
 }
@@ -74,6 +100,18 @@ func (a *AuthServiceImpl) RegisterWithProfile(ctx context.Context, req domain.Re
 		slog.String("type", string(req.Type)),
 	)
 
+	// Staff accounts are only created by admins, directly or through an invitation
+	if req.Type != "" && req.Type != domain.UserTypePatient {
+		logger.Info("attempt to self-register a staff account")
+		return nil, domain.NewForbiddenError("only patients can self-register, staff accounts require an invitation")
+	}
+
+	profile := req.Profile
+	if err := profile.Normalize(domain.UserTypePatient); err != nil {
+		logger.Info("invalid profile", slog.Any("error", err))
+		return nil, err
+	}
+
 	// Check if user already exists
 	existingUser, err := a.userStore.GetByEmail(ctx, req.Email)
 	if err != nil {
@@ -96,52 +134,280 @@ func (a *AuthServiceImpl) RegisterWithProfile(ctx context.Context, req domain.Re
 		ID:        pkg.GenerateID(),
 		Email:     req.Email,
 		Password:  string(hashedPassword),
-		Type:      req.Type,
-		Status:    domain.UserStatusActive,
-		Profile:   req.Profile,
+		Type:      domain.UserTypePatient,
+		Status:    initialStatus(domain.UserTypePatient),
+		Profile:   profile,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
 	if err := a.userStore.Create(ctx, user); err != nil {
 		logger.Error("error creating user", slog.Any("error", err))
-		return nil, domain.NewInternalError("error creating user")
+		return nil, err
 	}
 
+	a.sendVerification(ctx, logger, user)
+
 	logger.Info("user registered successfully", slog.String("userID", user.ID), slog.String("type", string(user.Type)))
 	return user, nil
 }
 
-func (a *AuthServiceImpl) Login(ctx context.Context, email, password string) (string, error) {
+// Login checks the user's password. Users with MFA enabled, or whose type requires it, get
+// an MFA challenge token instead of tokens. Repeated failures from the same account or ip
+// are throttled.
+func (a *AuthServiceImpl) Login(ctx context.Context, email, password, ip string) (*domain.LoginResult, error) {
 	logger := slog.With(
 		slog.String("service", "AuthService"),
 		slog.String("method", "Login"),
 		slog.String("email", email),
+		slog.String("ip", ip),
 	)
 
+	if err := a.throttle.Check(ctx, email, ip); err != nil {
+		return nil, err
+	}
+
 	user, err := a.userStore.GetByEmail(ctx, email)
 	if err != nil {
 		logger.Error("error fetching user", slog.Any("error", err))
-		return "", domain.NewInternalError("error processing login")
+		return nil, domain.NewInternalError("error processing login")
 	}
-	if user == nil {
+	if user == nil || user.IsDeleted() {
 		logger.Info("login attempt with non-existent user")
-		return "", domain.NewUnauthorizedError("invalid credentials")
+		a.recordFailure(ctx, logger, email, ip, nil)
+		return nil, domain.NewUnauthorizedError("invalid credentials")
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
 		logger.Info("login attempt with invalid password")
-		return "", domain.NewUnauthorizedError("invalid credentials")
+		a.recordFailure(ctx, logger, email, ip, user)
+		return nil, domain.NewUnauthorizedError("invalid credentials")
 	}
 
-	token, err := a.jwt.Generate(user)
+	if !user.IsActive() {
+		logger.Info("login attempt on non-active account", slog.String("status", string(user.Status)))
+		return nil, domain.NewAccountStatusError(user.Status)
+	}
+
+	enabled, err := a.mfa.IsEnabled(ctx, user.ID)
 	if err != nil {
-		logger.Error("error generating token", slog.Any("error", err))
-		return "", domain.NewInternalError("error generating authentication token")
+		logger.Error("error checking mfa enrollment", slog.Any("error", err))
+		return nil, domain.NewInternalError("error processing login")
+	}
+	required := enabled
+	if !enabled {
+		if required, err = a.mfa.IsRequired(ctx, user.Type); err != nil {
+			logger.Error("error checking mfa policy", slog.Any("error", err))
+			return nil, domain.NewInternalError("error processing login")
+		}
+	}
+	// Failures are only cleared once every factor passed, or logging in again would clear the
+	// wrong codes entered for the previous challenge
+	if required {
+		mfaToken, err := a.jwt.GenerateScopedToken(user.ID, user.Email, domain.TokenPurposeMFAChallenge, mfaChallengeTTL)
+		if err != nil {
+			logger.Error("error generating mfa challenge", slog.Any("error", err))
+			return nil, domain.NewInternalError("error generating authentication token")
+		}
+
+		logger.Info("mfa challenge issued", slog.String("userID", user.ID), slog.Bool("setupRequired", !enabled))
+		return &domain.LoginResult{MFAToken: mfaToken, MFASetupRequired: !enabled}, nil
+	}
+
+	if err := a.throttle.RecordSuccess(ctx, email); err != nil {
+		logger.Error("error resetting login attempts", slog.Any("error", err))
+	}
+
+	pair, err := a.issueTokenPair(ctx, user, pkg.GenerateID())
+	if err != nil {
+		logger.Error("error generating tokens", slog.Any("error", err))
+		return nil, domain.NewInternalError("error generating authentication token")
 	}
 
 	logger.Info("user logged in successfully", slog.String("userID", user.ID))
-	return token, nil
+	return &domain.LoginResult{Tokens: pair}, nil
+}
+
+// SetupMFA starts an MFA enrollment for a user who must enroll before logging in. It is
+// throttled like the login it continues.
+func (a *AuthServiceImpl) SetupMFA(ctx context.Context, mfaToken, ip string) (*domain.MFASetup, error) {
+	logger := slog.With(
+		slog.String("service", "AuthService"),
+		slog.String("method", "SetupMFA"),
+		slog.String("ip", ip),
+	)
+
+	_, user, err := a.lookupMFAChallenge(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.With(slog.String("userID", user.ID))
+
+	if err := a.throttle.Check(ctx, user.Email, ip); err != nil {
+		return nil, err
+	}
+
+	setup, err := a.mfa.Enroll(ctx, user.ID)
+	if err != nil {
+		logger.Error("error starting mfa enrollment", slog.Any("error", err))
+		return nil, err
+	}
+
+	logger.Info("mfa enrollment started during login")
+	return setup, nil
+}
+
+// VerifyMFA completes a login by exchanging an MFA challenge token and a valid TOTP or
+// recovery code for a token pair. Wrong codes are throttled like wrong passwords, and a
+// challenge is revoked once used or after mfaChallengeAttempts wrong codes. Concurrent
+// verifications of one challenge get a single session.
+func (a *AuthServiceImpl) VerifyMFA(ctx context.Context, mfaToken, code, ip string) (*domain.TokenPair, error) {
+	logger := slog.With(
+		slog.String("service", "AuthService"),
+		slog.String("method", "VerifyMFA"),
+		slog.String("ip", ip),
+	)
+
+	challenge, user, err := a.lookupMFAChallenge(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.With(slog.String("userID", user.ID))
+
+	if err := a.throttle.Check(ctx, user.Email, ip); err != nil {
+		return nil, err
+	}
+
+	if err := a.mfa.Verify(ctx, user.ID, code); err != nil {
+		logger.Info("mfa verification failed", slog.Any("error", err))
+		a.recordFailure(ctx, logger, user.Email, ip, user)
+		a.recordChallengeFailure(ctx, logger, challenge)
+		return nil, err
+	}
+
+	// Only the request that revokes the challenge first gets a session
+	first, err := a.revocations.Revoke(ctx, challenge)
+	if err != nil {
+		logger.Error("error revoking mfa challenge", slog.Any("error", err))
+		return nil, domain.NewInternalError("error processing login")
+	}
+	if !first {
+		logger.Info("mfa challenge already used")
+		return nil, domain.NewUnauthorizedError("invalid or expired mfa token")
+	}
+	if err := a.throttle.RecordSuccess(ctx, user.Email); err != nil {
+		logger.Error("error resetting login attempts", slog.Any("error", err))
+	}
+
+	pair, err := a.issueTokenPair(ctx, user, pkg.GenerateID())
+	if err != nil {
+		logger.Error("error generating tokens", slog.Any("error", err))
+		return nil, domain.NewInternalError("error generating authentication token")
+	}
+
+	logger.Info("user logged in successfully with mfa")
+	return pair, nil
+}
+
+// Refresh exchanges a refresh token for a new token pair. Each refresh token can be
+// used once; presenting an already used token revokes every token in its family.
+func (a *AuthServiceImpl) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	logger := slog.With(
+		slog.String("service", "AuthService"),
+		slog.String("method", "Refresh"),
+	)
+
+	stored, err := a.lookupRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.With(slog.String("userID", stored.UserID), slog.String("familyID", stored.FamilyID))
+
+	if stored.IsRevoked() {
+		logger.Info("attempt to use revoked refresh token")
+		return nil, domain.NewUnauthorizedError("invalid refresh token")
+	}
+
+	marked, err := a.refreshTokens.MarkUsed(ctx, stored.ID, time.Now())
+	if err != nil {
+		logger.Error("error marking refresh token as used", slog.Any("error", err))
+		return nil, domain.NewInternalError("error refreshing token")
+	}
+	if !marked {
+		logger.Warn("refresh token reuse detected, revoking token family")
+		if err := a.refreshTokens.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			logger.Error("error revoking refresh token family", slog.Any("error", err))
+		}
+		return nil, domain.NewUnauthorizedError("refresh token reuse detected")
+	}
+
+	user, err := a.userStore.GetByID(ctx, stored.UserID)
+	if err != nil {
+		logger.Error("error fetching user by ID", slog.Any("error", err))
+		return nil, domain.NewInternalError("error fetching user")
+	}
+	if user == nil || user.IsDeleted() {
+		logger.Info("refresh token belongs to non-existent user")
+		return nil, domain.NewUnauthorizedError("invalid refresh token")
+	}
+	if !user.IsActive() {
+		logger.Info("refresh attempt on non-active account", slog.String("status", string(user.Status)))
+		return nil, domain.NewAccountStatusError(user.Status)
+	}
+
+	pair, err := a.issueTokenPair(ctx, user, stored.FamilyID)
+	if err != nil {
+		logger.Error("error generating tokens", slog.Any("error", err))
+		return nil, domain.NewInternalError("error generating authentication token")
+	}
+
+	logger.Info("token refreshed successfully")
+	return pair, nil
+}
+
+// Logout revokes the access token in claims and, when given, the refresh token family
+// issued with it.
+func (a *AuthServiceImpl) Logout(ctx context.Context, claims *domain.AuthClaims, refreshToken string) error {
+	logger := slog.With(
+		slog.String("service", "AuthService"),
+		slog.String("method", "Logout"),
+		slog.String("userID", claims.UserID),
+	)
+
+	if _, err := a.revocations.Revoke(ctx, claims); err != nil {
+		logger.Error("error revoking access token", slog.Any("error", err))
+		return err
+	}
+
+	if refreshToken != "" {
+		stored, err := a.lookupRefreshToken(ctx, refreshToken)
+		if err != nil || stored.UserID != claims.UserID {
+			logger.Info("ignoring invalid refresh token on logout")
+		} else if err := a.refreshTokens.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			logger.Error("error revoking refresh token family", slog.Any("error", err))
+			return domain.NewInternalError("error revoking refresh token")
+		}
+	}
+
+	logger.Info("user logged out successfully")
+	return nil
+}
+
+// LogoutAll revokes every access and refresh token of the user in claims.
+func (a *AuthServiceImpl) LogoutAll(ctx context.Context, claims *domain.AuthClaims) error {
+	logger := slog.With(
+		slog.String("service", "AuthService"),
+		slog.String("method", "LogoutAll"),
+		slog.String("userID", claims.UserID),
+	)
+
+	if err := a.revocations.RevokeAllForUser(ctx, claims.UserID); err != nil {
+		logger.Error("error revoking user sessions", slog.Any("error", err))
+		return domain.NewInternalError("error revoking sessions")
+	}
+
+	logger.Info("user logged out of all sessions")
+	return nil
 }
 
 func (a *AuthServiceImpl) GenerateRefreshToken(ctx context.Context, user *domain.User) (string, error) {
@@ -151,7 +417,7 @@ func (a *AuthServiceImpl) GenerateRefreshToken(ctx context.Context, user *domain
 		slog.String("userID", user.GetID()),
 	)
 
-	refreshToken, err := a.jwt.GenerateRefreshToken(user)
+	refreshToken, err := a.newRefreshToken(ctx, user, pkg.GenerateID())
 	if err != nil {
 		logger.Error("error generating refresh token", slog.Any("error", err))
 		return "", domain.NewInternalError("error generating refresh token")
@@ -166,13 +432,16 @@ func (a *AuthServiceImpl) ValidateRefreshToken(ctx context.Context, token string
 		slog.String("method", "ValidateRefreshToken"),
 	)
 
-	claims, err := a.jwt.ValidateRefreshToken(token)
+	stored, err := a.lookupRefreshToken(ctx, token)
 	if err != nil {
-		logger.Error("error validating refresh token", slog.Any("error", err))
+		return nil, err
+	}
+	if stored.IsUsed() || stored.IsRevoked() {
+		logger.Info("refresh token is no longer valid", slog.String("familyID", stored.FamilyID))
 		return nil, domain.NewUnauthorizedError("invalid refresh token")
 	}
 
-	user, err := a.userStore.GetByID(ctx, claims.UserID)
+	user, err := a.userStore.GetByID(ctx, stored.UserID)
 	if err != nil {
 		logger.Error("error fetching user by ID", slog.Any("error", err))
 		return nil, domain.NewInternalError("error fetching user")
@@ -180,3 +449,163 @@ func (a *AuthServiceImpl) ValidateRefreshToken(ctx context.Context, token string
 
 	return user, nil
 }
+
+// lookupRefreshToken validates the refresh token signature and loads its persisted record.
+func (a *AuthServiceImpl) lookupRefreshToken(ctx context.Context, token string) (*domain.RefreshToken, error) {
+	logger := slog.With(
+		slog.String("service", "AuthService"),
+		slog.String("method", "lookupRefreshToken"),
+	)
+
+	claims, err := a.jwt.ValidateRefreshToken(token)
+	if err != nil {
+		logger.Info("error validating refresh token", slog.Any("error", err))
+		return nil, domain.NewUnauthorizedError("invalid refresh token")
+	}
+
+	stored, err := a.refreshTokens.GetByID(ctx, claims.ID)
+	if err != nil {
+		logger.Error("error fetching refresh token", slog.Any("error", err))
+		return nil, domain.NewInternalError("error validating refresh token")
+	}
+	if stored == nil || stored.UserID != claims.UserID {
+		logger.Info("refresh token not found", slog.String("tokenID", claims.ID))
+		return nil, domain.NewUnauthorizedError("invalid refresh token")
+	}
+
+	return stored, nil
+}
+
+// issueTokenPair generates an access token and a refresh token in the given family. Since
+// every login and refresh goes through it, it also records the activity of the user.
+func (a *AuthServiceImpl) issueTokenPair(ctx context.Context, user *domain.User, familyID string) (*domain.TokenPair, error) {
+	accessToken, err := a.jwt.Generate(user)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := a.newRefreshToken(ctx, user, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Activity only feeds statistics, so failing to record it doesn't fail the login
+	if err := a.userStore.RecordActivity(ctx, user.ID, time.Now()); err != nil {
+		slog.Error("error recording user activity", slog.String("userID", user.ID), slog.Any("error", err))
+	}
+
+	return &domain.TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// newRefreshToken persists a refresh token record and returns its signed form.
+func (a *AuthServiceImpl) newRefreshToken(ctx context.Context, user *domain.User, familyID string) (string, error) {
+	now := time.Now()
+	record := &domain.RefreshToken{
+		ID:        pkg.GenerateID(),
+		FamilyID:  familyID,
+		UserID:    user.ID,
+		ExpiresAt: now.Add(a.refreshTokenTTL),
+		CreatedAt: now,
+	}
+
+	signed, err := a.jwt.GenerateRefreshToken(record)
+	if err != nil {
+		return "", err
+	}
+
+	if err := a.refreshTokens.Create(ctx, record); err != nil {
+		return "", err
+	}
+
+	return signed, nil
+}
+
+// initialStatus returns the status of a self-registered account. Patients must verify
+// their email before they can log in.
+func initialStatus(userType domain.UserType) domain.UserStatus {
+	if userType == domain.UserTypePatient {
+		return domain.UserStatusPending
+	}
+	return domain.UserStatusActive
+}
+
+// sendVerification emails the verification link of a new account. Failures are only logged
+// since the user can ask for the email again.
+func (a *AuthServiceImpl) sendVerification(ctx context.Context, logger *slog.Logger, user *domain.User) {
+	if user.Status != domain.UserStatusPending {
+		return
+	}
+	if err := a.verifications.SendVerification(ctx, user); err != nil {
+		logger.Warn("error sending verification email", slog.String("userID", user.ID), slog.Any("error", err))
+	}
+}
+
+// lookupMFAChallenge validates an MFA challenge token and loads its user, who must still be active.
+func (a *AuthServiceImpl) lookupMFAChallenge(ctx context.Context, mfaToken string) (*domain.AuthClaims, *domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "AuthService"),
+		slog.String("method", "lookupMFAChallenge"),
+	)
+
+	claims, err := a.jwt.ValidateScopedToken(mfaToken, domain.TokenPurposeMFAChallenge)
+	if err != nil {
+		logger.Info("invalid mfa challenge token", slog.Any("error", err))
+		return nil, nil, domain.NewUnauthorizedError("invalid or expired mfa token")
+	}
+
+	// Challenges are revoked like access tokens, by their jti
+	challenge := &domain.AuthClaims{UserID: claims.Subject, Email: claims.Email, RegisteredClaims: claims.RegisteredClaims}
+	revoked, err := a.revocations.IsRevoked(ctx, challenge)
+	if err != nil {
+		logger.Error("error checking mfa challenge revocation", slog.Any("error", err))
+		return nil, nil, domain.NewInternalError("error processing login")
+	}
+	if revoked {
+		logger.Info("revoked mfa challenge token", slog.String("userID", claims.Subject))
+		return nil, nil, domain.NewUnauthorizedError("invalid or expired mfa token")
+	}
+
+	user, err := a.userStore.GetByID(ctx, claims.Subject)
+	if err != nil {
+		logger.Error("error fetching user by ID", slog.Any("error", err))
+		return nil, nil, domain.NewInternalError("error fetching user")
+	}
+	if user == nil || user.IsDeleted() {
+		logger.Info("mfa challenge for non-existent user")
+		return nil, nil, domain.NewUnauthorizedError("invalid or expired mfa token")
+	}
+	if !user.IsActive() {
+		logger.Info("mfa challenge for non-active account", slog.String("status", string(user.Status)))
+		return nil, nil, domain.NewAccountStatusError(user.Status)
+	}
+
+	return challenge, user, nil
+}
+
+// recordChallengeFailure counts a wrong code for an MFA challenge and revokes the challenge
+// once it took mfaChallengeAttempts. Errors are logged only, so the caller still gets the
+// verification error.
+func (a *AuthServiceImpl) recordChallengeFailure(ctx context.Context, logger *slog.Logger, challenge *domain.AuthClaims) {
+	failures, err := a.throttle.RecordChallengeFailure(ctx, challenge.ID, challenge.ExpiresAt.Time)
+	if err != nil {
+		logger.Error("error recording mfa challenge failure", slog.Any("error", err))
+		return
+	}
+	if failures < mfaChallengeAttempts {
+		return
+	}
+
+	if _, err := a.revocations.Revoke(ctx, challenge); err != nil {
+		logger.Error("error revoking mfa challenge", slog.Any("error", err))
+		return
+	}
+	logger.Warn("mfa challenge revoked after repeated wrong codes", slog.Int("failures", failures))
+}
+
+// recordFailure counts a failed login. Errors are logged only, so the caller still gets the
+// credentials error.
+func (a *AuthServiceImpl) recordFailure(ctx context.Context, logger *slog.Logger, email, ip string, user *domain.User) {
+	if err := a.throttle.RecordFailure(ctx, email, ip, user); err != nil {
+		logger.Error("error recording login failure", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	mocks "github.com/vida-plus/api/mocks"
+)
+
+type authServiceMocks struct {
+	users       *mocks.UserStoreMock
+	jwt         *mocks.JWTManagerMock
+	refresh     *mocks.RefreshTokenRepositoryMock
+	revocations *mocks.TokenRevocationStoreMock
+	mfa         *mocks.MFAServiceMock
+	throttle    *mocks.LoginThrottleMock
+}
+
+func newTestAuthService(t *testing.T) (*AuthServiceImpl, authServiceMocks) {
+	m := authServiceMocks{
+		users:       mocks.NewUserStoreMock(t),
+		jwt:         mocks.NewJWTManagerMock(t),
+		refresh:     mocks.NewRefreshTokenRepositoryMock(t),
+		revocations: mocks.NewTokenRevocationStoreMock(t),
+		mfa:         mocks.NewMFAServiceMock(t),
+		throttle:    mocks.NewLoginThrottleMock(t),
+	}
+	s := NewAuthService(m.users, m.jwt, m.refresh, m.revocations, mocks.NewEmailVerificationServiceMock(t), m.mfa,
+		m.throttle, time.Hour).(*AuthServiceImpl)
+	return s, m
+}
+
+func Test_AuthService_VerifyMFA(t *testing.T) {
+	ctx := context.Background()
+	user := &domain.User{ID: "user-1", Email: "user@test.com", Status: domain.UserStatusActive}
+	expiresAt := time.Now().Add(mfaChallengeTTL).Truncate(time.Second)
+	registered := jwt.RegisteredClaims{ID: "challenge-1", Subject: user.ID, ExpiresAt: jwt.NewNumericDate(expiresAt)}
+	challenge := &domain.AuthClaims{UserID: user.ID, Email: user.Email, RegisteredClaims: registered}
+
+	// expectChallenge expects the lookup of a valid challenge token, revoked or not
+	expectChallenge := func(m authServiceMocks, revoked bool) {
+		m.jwt.EXPECT().ValidateScopedToken("mfa-token", domain.TokenPurposeMFAChallenge).
+			Return(&domain.ScopedClaims{Email: user.Email, Purpose: domain.TokenPurposeMFAChallenge, RegisteredClaims: registered}, nil).Once()
+		m.revocations.EXPECT().IsRevoked(ctx, challenge).Return(revoked, nil).Once()
+		if !revoked {
+			m.users.EXPECT().GetByID(ctx, user.ID).Return(user, nil).Once()
+		}
+	}
+
+	t.Run("CHALLENGE REVOKED AFTER TOO MANY WRONG CODES", func(t *testing.T) {
+		s, m := newTestAuthService(t)
+		for attempt := 1; attempt <= mfaChallengeAttempts; attempt++ {
+			expectChallenge(m, false)
+			m.throttle.EXPECT().Check(ctx, user.Email, "10.0.0.1").Return(nil).Once()
+			m.mfa.EXPECT().Verify(ctx, user.ID, "000000").Return(domain.NewUnauthorizedError("invalid mfa code")).Once()
+			m.throttle.EXPECT().RecordFailure(ctx, user.Email, "10.0.0.1", user).Return(nil).Once()
+			m.throttle.EXPECT().RecordChallengeFailure(ctx, "challenge-1", expiresAt).Return(attempt, nil).Once()
+			if attempt == mfaChallengeAttempts {
+				m.revocations.EXPECT().Revoke(ctx, challenge).Return(true, nil).Once()
+			}
+
+			_, err := s.VerifyMFA(ctx, "mfa-token", "000000", "10.0.0.1")
+			requireStatus(t, err, http.StatusUnauthorized)
+		}
+
+		// Even the right code is refused once the challenge is revoked
+		expectChallenge(m, true)
+		_, err := s.VerifyMFA(ctx, "mfa-token", "123456", "10.0.0.1")
+		requireStatus(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("THROTTLED", func(t *testing.T) {
+		s, m := newTestAuthService(t)
+		expectChallenge(m, false)
+		m.throttle.EXPECT().Check(ctx, user.Email, "10.0.0.1").Return(domain.NewTooManyRequestsError("too many failed login attempts"))
+
+		_, err := s.VerifyMFA(ctx, "mfa-token", "123456", "10.0.0.1")
+		requireStatus(t, err, http.StatusTooManyRequests)
+	})
+
+	t.Run("CHALLENGE USED ONCE", func(t *testing.T) {
+		s, m := newTestAuthService(t)
+		expectChallenge(m, false)
+		m.throttle.EXPECT().Check(ctx, user.Email, "10.0.0.1").Return(nil)
+		m.mfa.EXPECT().Verify(ctx, user.ID, "123456").Return(nil)
+		m.revocations.EXPECT().Revoke(ctx, challenge).Return(true, nil)
+		m.throttle.EXPECT().RecordSuccess(ctx, user.Email).Return(nil)
+		m.jwt.EXPECT().Generate(user).Return("access-token", nil)
+		m.jwt.EXPECT().GenerateRefreshToken(mock.Anything).Return("refresh-token", nil)
+		m.refresh.EXPECT().Create(ctx, mock.Anything).Return(nil)
+		m.users.EXPECT().RecordActivity(ctx, user.ID, mock.Anything).Return(nil)
+
+		pair, err := s.VerifyMFA(ctx, "mfa-token", "123456", "10.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", pair.AccessToken)
+
+		expectChallenge(m, true)
+		_, err = s.VerifyMFA(ctx, "mfa-token", "123456", "10.0.0.1")
+		requireStatus(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("CONCURRENT VERIFIES OF ONE CHALLENGE", func(t *testing.T) {
+		s, m := newTestAuthService(t)
+		codes := []string{"recovery-1", "recovery-2"}
+		for _, code := range codes {
+			expectChallenge(m, false)
+			m.throttle.EXPECT().Check(ctx, user.Email, "10.0.0.1").Return(nil).Once()
+			m.mfa.EXPECT().Verify(ctx, user.ID, code).Return(nil).Once()
+		}
+		// Both passed the revocation check, but only one revokes the challenge first
+		m.revocations.EXPECT().Revoke(ctx, challenge).Return(true, nil).Once()
+		m.revocations.EXPECT().Revoke(ctx, challenge).Return(false, nil).Once()
+		m.throttle.EXPECT().RecordSuccess(ctx, user.Email).Return(nil).Once()
+		m.jwt.EXPECT().Generate(user).Return("access-token", nil).Once()
+		m.jwt.EXPECT().GenerateRefreshToken(mock.Anything).Return("refresh-token", nil).Once()
+		m.refresh.EXPECT().Create(ctx, mock.Anything).Return(nil).Once()
+		m.users.EXPECT().RecordActivity(ctx, user.ID, mock.Anything).Return(nil).Once()
+
+		errs := make([]error, len(codes))
+		var wg sync.WaitGroup
+		for i, code := range codes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = s.VerifyMFA(ctx, "mfa-token", code, "10.0.0.1")
+			}()
+		}
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+			requireStatus(t, err, http.StatusUnauthorized)
+		}
+		assert.Equal(t, 1, succeeded)
+	})
+}
+
+func Test_AuthService_SetupMFA(t *testing.T) {
+	ctx := context.Background()
+	user := &domain.User{ID: "user-1", Email: "user@test.com", Status: domain.UserStatusActive}
+	registered := jwt.RegisteredClaims{ID: "challenge-1", Subject: user.ID, ExpiresAt: jwt.NewNumericDate(time.Now().Add(mfaChallengeTTL))}
+
+	t.Run("THROTTLED", func(t *testing.T) {
+		s, m := newTestAuthService(t)
+		m.jwt.EXPECT().ValidateScopedToken("mfa-token", domain.TokenPurposeMFAChallenge).
+			Return(&domain.ScopedClaims{Email: user.Email, Purpose: domain.TokenPurposeMFAChallenge, RegisteredClaims: registered}, nil)
+		m.revocations.EXPECT().IsRevoked(ctx, mock.Anything).Return(false, nil)
+		m.users.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+		m.throttle.EXPECT().Check(ctx, user.Email, "10.0.0.1").Return(domain.NewTooManyRequestsError("too many failed login attempts"))
+
+		_, err := s.SetupMFA(ctx, "mfa-token", "10.0.0.1")
+		requireStatus(t, err, http.StatusTooManyRequests)
+	})
+}
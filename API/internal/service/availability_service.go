@@ -0,0 +1,464 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+)
+
+// Days covered when a date range leaves out its end
+const (
+	defaultSlotDays = 7
+	// maxExceptionDays bounds a single exception, such as a vacation
+	maxExceptionDays = 366
+)
+
+// AvailabilityConfig controls how the calendars of the doctors are read
+type AvailabilityConfig struct {
+	// Location is the timezone of the clinic, in which working hours and dates are given
+	Location *time.Location
+	// SlotDuration is the length of the slots of doctors whose speciality has none of its own
+	SlotDuration time.Duration
+	// SpecialitySlotDurations maps specialities, ignoring case, to the length of their slots
+	SpecialitySlotDurations map[string]time.Duration
+}
+
+// AvailabilityServiceImpl implements AvailabilityService interface.
+type AvailabilityServiceImpl struct {
+	availability domain.AvailabilityRepository
+	appointments domain.AppointmentRepository
+	users        domain.UserRepository
+	config       AvailabilityConfig
+	// slotDurations holds config.SpecialitySlotDurations keyed by specialityKey
+	slotDurations map[string]time.Duration
+	now           func() time.Time
+}
+
+// NewAvailabilityService creates an AvailabilityService that reads calendars in config.Location
+func NewAvailabilityService(availability domain.AvailabilityRepository, appointments domain.AppointmentRepository,
+	users domain.UserRepository, config AvailabilityConfig) domain.AvailabilityService {
+	slotDurations := make(map[string]time.Duration, len(config.SpecialitySlotDurations))
+	for speciality, duration := range config.SpecialitySlotDurations {
+		slotDurations[specialityKey(speciality)] = duration
+	}
+
+	return &AvailabilityServiceImpl{
+		availability:  availability,
+		appointments:  appointments,
+		users:         users,
+		config:        config,
+		slotDurations: slotDurations,
+		now:           time.Now,
+	}
+}
+
+// specialityKey normalizes a speciality for case-insensitive lookups
+func specialityKey(speciality string) string {
+	return strings.ToLower(strings.TrimSpace(speciality))
+}
+
+// canManageCalendar reports whether actor may change the calendar of doctorID. Doctors only
+// manage their own calendar.
+func canManageCalendar(actor *domain.AuthClaims, doctorID string) bool {
+	switch actor.UserType {
+	case domain.UserTypeAdmin, domain.UserTypeReceptionist:
+		return true
+	case domain.UserTypeDoctor:
+		return actor.UserID == doctorID
+	default:
+		return false
+	}
+}
+
+func (s *AvailabilityServiceImpl) GetAvailability(ctx context.Context, doctorID string, actor *domain.AuthClaims) (*domain.Availability, error) {
+	if _, err := s.manageableDoctor(ctx, doctorID, actor); err != nil {
+		return nil, err
+	}
+	return s.weekly(ctx, doctorID)
+}
+
+func (s *AvailabilityServiceImpl) SetAvailability(ctx context.Context, doctorID string, req domain.SetAvailabilityRequest,
+	actor *domain.AuthClaims) (*domain.Availability, error) {
+	logger := slog.With(
+		slog.String("service", "AvailabilityService"),
+		slog.String("method", "SetAvailability"),
+		slog.String("doctorID", doctorID),
+		slog.String("actorID", actor.UserID),
+	)
+
+	if _, err := s.manageableDoctor(ctx, doctorID, actor); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateWeekly(req.Weekly); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	availability := &domain.Availability{
+		DoctorID:  doctorID,
+		Weekly:    req.Weekly,
+		UpdatedBy: actor.UserID,
+		UpdatedAt: &now,
+	}
+	if availability.Weekly == nil {
+		availability.Weekly = []domain.WeeklyHours{}
+	}
+	if err := s.availability.SaveAvailability(ctx, availability); err != nil {
+		logger.Error("error saving availability", slog.Any("error", err))
+		return nil, err
+	}
+
+	logger.Info("availability updated")
+	return availability, nil
+}
+
+func (s *AvailabilityServiceImpl) ListExceptions(ctx context.Context, doctorID string, r domain.DateRange,
+	actor *domain.AuthClaims) ([]*domain.AvailabilityException, error) {
+	if _, err := s.manageableDoctor(ctx, doctorID, actor); err != nil {
+		return nil, err
+	}
+
+	// Upcoming exceptions by default
+	if r.From == "" {
+		r.From = s.now().In(s.config.Location).Format(domain.DateLayout)
+	}
+	if r.To == "" {
+		r.To = "9999-12-31"
+	}
+	if r.To < r.From {
+		return nil, domain.NewBadRequestError("from must not be after to")
+	}
+
+	return s.availability.ListExceptions(ctx, doctorID, r.From, r.To)
+}
+
+func (s *AvailabilityServiceImpl) AddException(ctx context.Context, doctorID string, req domain.CreateAvailabilityExceptionRequest,
+	actor *domain.AuthClaims) (*domain.AvailabilityException, error) {
+	logger := slog.With(
+		slog.String("service", "AvailabilityService"),
+		slog.String("method", "AddException"),
+		slog.String("doctorID", doctorID),
+		slog.String("actorID", actor.UserID),
+	)
+
+	if _, err := s.manageableDoctor(ctx, doctorID, actor); err != nil {
+		return nil, err
+	}
+	start, end, err := s.parseRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if days := daysBetween(start, end) + 1; days > maxExceptionDays {
+		return nil, domain.NewBadRequestError(fmt.Sprintf("exceptions can't cover more than %d days", maxExceptionDays))
+	}
+	if err := domain.ValidateWindows(req.Hours); err != nil {
+		return nil, err
+	}
+
+	exception := &domain.AvailabilityException{
+		ID:        pkg.GenerateID(),
+		DoctorID:  doctorID,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Reason:    req.Reason,
+		Hours:     req.Hours,
+		CreatedBy: actor.UserID,
+		CreatedAt: s.now(),
+	}
+	if err := s.availability.CreateException(ctx, exception); err != nil {
+		logger.Error("error creating availability exception", slog.Any("error", err))
+		return nil, err
+	}
+
+	logger.Info("availability exception added", slog.String("exceptionID", exception.ID))
+	return exception, nil
+}
+
+func (s *AvailabilityServiceImpl) DeleteException(ctx context.Context, doctorID, id string, actor *domain.AuthClaims) error {
+	logger := slog.With(
+		slog.String("service", "AvailabilityService"),
+		slog.String("method", "DeleteException"),
+		slog.String("doctorID", doctorID),
+		slog.String("exceptionID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	if _, err := s.manageableDoctor(ctx, doctorID, actor); err != nil {
+		return err
+	}
+
+	deleted, err := s.availability.DeleteException(ctx, doctorID, id)
+	if err != nil {
+		logger.Error("error deleting availability exception", slog.Any("error", err))
+		return err
+	}
+	if !deleted {
+		return domain.NewNotFoundError("availability exception not found")
+	}
+
+	logger.Info("availability exception deleted")
+	return nil
+}
+
+func (s *AvailabilityServiceImpl) Slots(ctx context.Context, doctorID string, r domain.DateRange) (*domain.SlotList, error) {
+	doctor, err := s.doctor(ctx, doctorID)
+	if err != nil {
+		return nil, err
+	}
+	if !doctor.IsActive() {
+		return nil, domain.NewNotFoundError("doctor not found")
+	}
+
+	if r.From == "" {
+		r.From = s.now().In(s.config.Location).Format(domain.DateLayout)
+	}
+	from, err := time.ParseInLocation(domain.DateLayout, r.From, s.config.Location)
+	if err != nil {
+		return nil, domain.NewBadRequestError("from must be a date as YYYY-MM-DD")
+	}
+	if r.To == "" {
+		r.To = from.AddDate(0, 0, defaultSlotDays-1).Format(domain.DateLayout)
+	}
+	from, to, err := s.parseRange(r.From, r.To)
+	if err != nil {
+		return nil, err
+	}
+	if days := daysBetween(from, to) + 1; days > domain.MaxSlotRangeDays {
+		return nil, domain.NewBadRequestError(fmt.Sprintf("slots can be searched for up to %d days at a time", domain.MaxSlotRangeDays))
+	}
+
+	duration := s.slotDuration(doctor.Profile.Speciality)
+	slots, err := s.freeSlots(ctx, doctor.ID, from, to, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SlotList{
+		DoctorID:    doctorID,
+		Timezone:    s.config.Location.String(),
+		SlotMinutes: int(duration.Minutes()),
+		Slots:       slots,
+	}, nil
+}
+
+// NextSlots loads the calendars of all the doctors with one query per collection, so listing a
+// page of the directory costs the same whatever its size.
+func (s *AvailabilityServiceImpl) NextSlots(ctx context.Context, doctors []*domain.User) (map[string]*domain.Slot, error) {
+	logger := slog.With(
+		slog.String("service", "AvailabilityService"),
+		slog.String("method", "NextSlots"),
+	)
+
+	next := make(map[string]*domain.Slot, len(doctors))
+	if len(doctors) == 0 {
+		return next, nil
+	}
+	ids := make([]string, 0, len(doctors))
+	for _, doctor := range doctors {
+		ids = append(ids, doctor.ID)
+	}
+
+	from := s.now().In(s.config.Location)
+	to := from.AddDate(0, 0, domain.NextSlotDays-1)
+	availabilities, err := s.availability.ListAvailabilities(ctx, ids)
+	if err != nil {
+		logger.Error("error listing availabilities", slog.Any("error", err))
+		return nil, err
+	}
+	exceptions, err := s.availability.ListExceptionsForDoctors(ctx, ids, from.Format(domain.DateLayout), to.Format(domain.DateLayout))
+	if err != nil {
+		logger.Error("error listing availability exceptions", slog.Any("error", err))
+		return nil, err
+	}
+	start, end := s.bookingWindow(from, to)
+	booked, err := s.appointments.ListBlockingForDoctors(ctx, ids, start, end)
+	if err != nil {
+		logger.Error("error listing appointments", slog.Any("error", err))
+		return nil, err
+	}
+
+	weeklyByDoctor := make(map[string][]domain.WeeklyHours, len(availabilities))
+	for _, availability := range availabilities {
+		weeklyByDoctor[availability.DoctorID] = availability.Weekly
+	}
+	exceptionsByDoctor := make(map[string][]*domain.AvailabilityException)
+	for _, exception := range exceptions {
+		exceptionsByDoctor[exception.DoctorID] = append(exceptionsByDoctor[exception.DoctorID], exception)
+	}
+	bookedByDoctor := make(map[string][]*domain.Appointment)
+	for _, appointment := range booked {
+		bookedByDoctor[appointment.DoctorID] = append(bookedByDoctor[appointment.DoctorID], appointment)
+	}
+
+	for _, doctor := range doctors {
+		search := domain.SlotSearch{
+			Weekly:     weeklyByDoctor[doctor.ID],
+			Exceptions: exceptionsByDoctor[doctor.ID],
+			Booked:     bookedByDoctor[doctor.ID],
+			From:       from,
+			To:         to,
+			Location:   s.config.Location,
+			Duration:   s.slotDuration(doctor.Profile.Speciality),
+			NotBefore:  s.now(),
+		}
+		if slots := search.FreeSlots(); len(slots) > 0 {
+			next[doctor.ID] = &slots[0]
+		}
+	}
+
+	return next, nil
+}
+
+// CheckSlot leaves the booked appointments to the appointment repository, which checks them
+// while holding the lock of the calendar, and so does not count the one being rescheduled.
+func (s *AvailabilityServiceImpl) CheckSlot(ctx context.Context, doctorID string, startsAt, endsAt time.Time) error {
+	logger := slog.With(
+		slog.String("service", "AvailabilityService"),
+		slog.String("method", "CheckSlot"),
+		slog.String("doctorID", doctorID),
+	)
+
+	doctor, err := s.doctor(ctx, doctorID)
+	if err != nil {
+		return err
+	}
+	availability, err := s.weekly(ctx, doctorID)
+	if err != nil {
+		return err
+	}
+	day := startsAt.In(s.config.Location)
+	date := day.Format(domain.DateLayout)
+	exceptions, err := s.availability.ListExceptions(ctx, doctorID, date, date)
+	if err != nil {
+		logger.Error("error listing availability exceptions", slog.Any("error", err))
+		return err
+	}
+
+	search := domain.SlotSearch{
+		Weekly:     availability.Weekly,
+		Exceptions: exceptions,
+		From:       day,
+		To:         day,
+		Location:   s.config.Location,
+		Duration:   s.slotDuration(doctor.Profile.Speciality),
+		NotBefore:  s.now(),
+	}
+	if !search.Offers(domain.Slot{StartsAt: startsAt, EndsAt: endsAt}) {
+		logger.Info("time is not a slot of the doctor", slog.Time("startsAt", startsAt), slog.Time("endsAt", endsAt))
+		return domain.NewBadRequestError("the doctor has no slot at this time, pick one of the free slots of the doctor")
+	}
+	return nil
+}
+
+// freeSlots computes the free slots of a doctor from the day of from to the day of to
+func (s *AvailabilityServiceImpl) freeSlots(ctx context.Context, doctorID string, from, to time.Time, duration time.Duration) ([]domain.Slot, error) {
+	logger := slog.With(
+		slog.String("service", "AvailabilityService"),
+		slog.String("method", "freeSlots"),
+		slog.String("doctorID", doctorID),
+	)
+
+	availability, err := s.weekly(ctx, doctorID)
+	if err != nil {
+		return nil, err
+	}
+	exceptions, err := s.availability.ListExceptions(ctx, doctorID, from.Format(domain.DateLayout), to.Format(domain.DateLayout))
+	if err != nil {
+		logger.Error("error listing availability exceptions", slog.Any("error", err))
+		return nil, err
+	}
+	start, end := s.bookingWindow(from, to)
+	booked, err := s.appointments.ListBlocking(ctx, doctorID, start, end)
+	if err != nil {
+		logger.Error("error listing appointments", slog.Any("error", err))
+		return nil, err
+	}
+
+	search := domain.SlotSearch{
+		Weekly:     availability.Weekly,
+		Exceptions: exceptions,
+		Booked:     booked,
+		From:       from,
+		To:         to,
+		Location:   s.config.Location,
+		Duration:   duration,
+		NotBefore:  s.now(),
+	}
+	return search.FreeSlots(), nil
+}
+
+// bookingWindow returns the times bookings are read from for slots from the day of from to the
+// day of to: the first midnight through the one after the last day
+func (s *AvailabilityServiceImpl) bookingWindow(from, to time.Time) (time.Time, time.Time) {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, s.config.Location)
+	end := time.Date(to.Year(), to.Month(), to.Day()+1, 0, 0, 0, 0, s.config.Location)
+	return start, end
+}
+
+// slotDuration returns the slot length of speciality
+func (s *AvailabilityServiceImpl) slotDuration(speciality string) time.Duration {
+	if duration, ok := s.slotDurations[specialityKey(speciality)]; ok {
+		return duration
+	}
+	return s.config.SlotDuration
+}
+
+// weekly returns the weekly hours of a doctor, which are empty until set
+func (s *AvailabilityServiceImpl) weekly(ctx context.Context, doctorID string) (*domain.Availability, error) {
+	availability, err := s.availability.GetAvailability(ctx, doctorID)
+	if err != nil {
+		return nil, err
+	}
+	if availability == nil {
+		return &domain.Availability{DoctorID: doctorID, Weekly: []domain.WeeklyHours{}}, nil
+	}
+	return availability, nil
+}
+
+// manageableDoctor returns a doctor whose calendar actor can manage
+func (s *AvailabilityServiceImpl) manageableDoctor(ctx context.Context, doctorID string, actor *domain.AuthClaims) (*domain.User, error) {
+	if !canManageCalendar(actor, doctorID) {
+		return nil, domain.NewForbiddenError("only the doctor, receptionists and admins manage this calendar")
+	}
+	return s.doctor(ctx, doctorID)
+}
+
+// doctor returns the doctor with id, failing with not found for other users and deleted doctors
+func (s *AvailabilityServiceImpl) doctor(ctx context.Context, id string) (*domain.User, error) {
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.IsDeleted() || user.Type != domain.UserTypeDoctor {
+		return nil, domain.NewNotFoundError("doctor not found")
+	}
+	return user, nil
+}
+
+// parseRange parses two dates as days of the clinic, failing when end comes before start
+func (s *AvailabilityServiceImpl) parseRange(startDate, endDate string) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation(domain.DateLayout, startDate, s.config.Location)
+	if err != nil {
+		return time.Time{}, time.Time{}, domain.NewBadRequestError("dates must be given as YYYY-MM-DD")
+	}
+	end, err := time.ParseInLocation(domain.DateLayout, endDate, s.config.Location)
+	if err != nil {
+		return time.Time{}, time.Time{}, domain.NewBadRequestError("dates must be given as YYYY-MM-DD")
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, domain.NewBadRequestError("the end date can't come before the start date")
+	}
+	return start, end, nil
+}
+
+// daysBetween counts the calendar days from start to end, which don't all last 24 hours with DST
+func daysBetween(start, end time.Time) int {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	return int(time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).Sub(time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+}
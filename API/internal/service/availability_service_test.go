@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	mocks "github.com/vida-plus/api/mocks"
+)
+
+func newTestAvailabilityService(t *testing.T) (*AvailabilityServiceImpl, *mocks.AvailabilityRepositoryMock,
+	*mocks.AppointmentRepositoryMock, *mocks.UserRepositoryMock) {
+	availability := mocks.NewAvailabilityRepositoryMock(t)
+	appointments := mocks.NewAppointmentRepositoryMock(t)
+	users := mocks.NewUserRepositoryMock(t)
+
+	// Monday, November 2nd 2026 at 10:00 in the clinic
+	now := time.Date(2026, 11, 2, 13, 0, 0, 0, time.UTC)
+	s := NewAvailabilityService(availability, appointments, users, AvailabilityConfig{
+		Location:                time.FixedZone("BRT", -3*60*60),
+		SlotDuration:            30 * time.Minute,
+		SpecialitySlotDurations: map[string]time.Duration{"Psiquiatria": 50 * time.Minute},
+	}).(*AvailabilityServiceImpl)
+	s.now = func() time.Time { return now }
+	return s, availability, appointments, users
+}
+
+func Test_AvailabilityService_SetAvailability(t *testing.T) {
+	ctx := context.Background()
+	doctor := &domain.User{ID: "doctor-1", Type: domain.UserTypeDoctor, Status: domain.UserStatusActive}
+	weekly := domain.SetAvailabilityRequest{Weekly: []domain.WeeklyHours{{Weekday: time.Monday, Start: "08:00", End: "12:00"}}}
+
+	t.Run("DOCTOR SETS OWN HOURS", func(t *testing.T) {
+		s, availability, _, users := newTestAvailabilityService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+		availability.EXPECT().SaveAvailability(ctx, mock.MatchedBy(func(a *domain.Availability) bool {
+			return a.DoctorID == doctor.ID && len(a.Weekly) == 1 && a.UpdatedBy == doctor.ID
+		})).Return(nil)
+
+		_, err := s.SetAvailability(ctx, doctor.ID, weekly, &domain.AuthClaims{UserID: doctor.ID, UserType: domain.UserTypeDoctor})
+		require.NoError(t, err)
+	})
+
+	t.Run("RECEPTIONIST SETS ANY DOCTOR", func(t *testing.T) {
+		s, availability, _, users := newTestAvailabilityService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+		availability.EXPECT().SaveAvailability(ctx, mock.Anything).Return(nil)
+
+		_, err := s.SetAvailability(ctx, doctor.ID, weekly, testReceptionist)
+		require.NoError(t, err)
+	})
+
+	t.Run("ANOTHER DOCTOR", func(t *testing.T) {
+		s, _, _, _ := newTestAvailabilityService(t)
+
+		_, err := s.SetAvailability(ctx, doctor.ID, weekly, &domain.AuthClaims{UserID: "doctor-2", UserType: domain.UserTypeDoctor})
+		requireStatus(t, err, http.StatusForbidden)
+	})
+
+	t.Run("NOT A DOCTOR", func(t *testing.T) {
+		s, _, _, users := newTestAvailabilityService(t)
+		users.EXPECT().GetByID(ctx, "patient-1").Return(&domain.User{ID: "patient-1", Type: domain.UserTypePatient}, nil)
+
+		_, err := s.SetAvailability(ctx, "patient-1", weekly, testReceptionist)
+		requireStatus(t, err, http.StatusNotFound)
+	})
+
+	t.Run("OVERLAPPING HOURS", func(t *testing.T) {
+		s, _, _, users := newTestAvailabilityService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+
+		_, err := s.SetAvailability(ctx, doctor.ID, domain.SetAvailabilityRequest{Weekly: []domain.WeeklyHours{
+			{Weekday: time.Monday, Start: "08:00", End: "12:00"},
+			{Weekday: time.Monday, Start: "11:00", End: "13:00"},
+		}}, testReceptionist)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+}
+
+func Test_AvailabilityService_AddException(t *testing.T) {
+	ctx := context.Background()
+	doctor := &domain.User{ID: "doctor-1", Type: domain.UserTypeDoctor, Status: domain.UserStatusActive}
+
+	t.Run("DAYS OFF", func(t *testing.T) {
+		s, availability, _, users := newTestAvailabilityService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+		availability.EXPECT().CreateException(ctx, mock.MatchedBy(func(e *domain.AvailabilityException) bool {
+			return e.ID != "" && e.StartDate == "2026-12-24" && e.EndDate == "2026-12-26" && len(e.Hours) == 0
+		})).Return(nil)
+
+		_, err := s.AddException(ctx, doctor.ID, domain.CreateAvailabilityExceptionRequest{
+			StartDate: "2026-12-24",
+			EndDate:   "2026-12-26",
+			Reason:    "Natal",
+		}, testReceptionist)
+		require.NoError(t, err)
+	})
+
+	t.Run("ENDS BEFORE STARTING", func(t *testing.T) {
+		s, _, _, users := newTestAvailabilityService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+
+		_, err := s.AddException(ctx, doctor.ID, domain.CreateAvailabilityExceptionRequest{
+			StartDate: "2026-12-26",
+			EndDate:   "2026-12-24",
+			Reason:    "Natal",
+		}, testReceptionist)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+}
+
+func Test_AvailabilityService_Slots(t *testing.T) {
+	ctx := context.Background()
+	doctor := &domain.User{ID: "doctor-1", Type: domain.UserTypeDoctor, Status: domain.UserStatusActive,
+		Profile: domain.UserProfile{Speciality: "psiquiatria"}}
+	weekly := &domain.Availability{DoctorID: doctor.ID, Weekly: []domain.WeeklyHours{
+		{Weekday: time.Monday, Start: "08:00", End: "13:00"},
+	}}
+
+	t.Run("TODAY BY DEFAULT", func(t *testing.T) {
+		s, availability, appointments, users := newTestAvailabilityService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+		availability.EXPECT().GetAvailability(ctx, doctor.ID).Return(weekly, nil)
+		availability.EXPECT().ListExceptions(ctx, doctor.ID, "2026-11-02", "2026-11-08").Return(nil, nil)
+		appointments.EXPECT().ListBlocking(ctx, doctor.ID, mock.Anything, mock.Anything).Return([]*domain.Appointment{{
+			StartsAt: time.Date(2026, 11, 2, 13, 45, 0, 0, time.UTC),
+			EndsAt:   time.Date(2026, 11, 2, 14, 15, 0, 0, time.UTC),
+		}}, nil)
+
+		list, err := s.Slots(ctx, doctor.ID, domain.DateRange{})
+		require.NoError(t, err)
+
+		// The speciality sets 50 minute slots; 08:00 to 09:40 are past and 10:30 is booked
+		assert.Equal(t, 50, list.SlotMinutes)
+		assert.Equal(t, "BRT", list.Timezone)
+		require.Len(t, list.Slots, 2)
+		assert.Equal(t, time.Date(2026, 11, 2, 14, 20, 0, 0, time.UTC), list.Slots[0].StartsAt.UTC())
+		assert.Equal(t, time.Date(2026, 11, 2, 15, 10, 0, 0, time.UTC), list.Slots[1].StartsAt.UTC())
+	})
+
+	t.Run("RANGE TOO LONG", func(t *testing.T) {
+		s, _, _, users := newTestAvailabilityService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+
+		_, err := s.Slots(ctx, doctor.ID, domain.DateRange{From: "2026-11-02", To: "2026-12-31"})
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("INACTIVE DOCTOR", func(t *testing.T) {
+		s, _, _, users := newTestAvailabilityService(t)
+		users.EXPECT().GetByID(ctx, doctor.ID).Return(&domain.User{ID: doctor.ID, Type: domain.UserTypeDoctor,
+			Status: domain.UserStatusBlocked}, nil)
+
+		_, err := s.Slots(ctx, doctor.ID, domain.DateRange{})
+		requireStatus(t, err, http.StatusNotFound)
+	})
+}
+
+func Test_AvailabilityService_CheckSlot(t *testing.T) {
+	ctx := context.Background()
+	doctor := &domain.User{ID: "doctor-1", Type: domain.UserTypeDoctor, Status: domain.UserStatusActive}
+	weekly := &domain.Availability{DoctorID: doctor.ID, Weekly: []domain.WeeklyHours{
+		{Weekday: time.Tuesday, Start: "08:00", End: "12:00"},
+	}}
+	holiday := &domain.AvailabilityException{StartDate: "2026-11-03", EndDate: "2026-11-03", Reason: "Feriado"}
+	// Tuesday, November 3rd 2026 at the given time in the clinic
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 11, 3, hour+3, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		startsAt   time.Time
+		endsAt     time.Time
+		exceptions []*domain.AvailabilityException
+		wantErr    int
+	}{
+		{"ON A SLOT", at(9, 30), at(10, 0), nil, 0},
+		{"OUTSIDE WORKING HOURS", at(13, 0), at(13, 30), nil, http.StatusBadRequest},
+		{"ACROSS TWO SLOTS", at(9, 15), at(9, 45), nil, http.StatusBadRequest},
+		{"LONGER THAN A SLOT", at(9, 0), at(10, 0), nil, http.StatusBadRequest},
+		{"EXCEPTION DAY", at(9, 30), at(10, 0), []*domain.AvailabilityException{holiday}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, availability, _, users := newTestAvailabilityService(t)
+			users.EXPECT().GetByID(ctx, doctor.ID).Return(doctor, nil)
+			availability.EXPECT().GetAvailability(ctx, doctor.ID).Return(weekly, nil)
+			availability.EXPECT().ListExceptions(ctx, doctor.ID, "2026-11-03", "2026-11-03").Return(tt.exceptions, nil)
+
+			err := s.CheckSlot(ctx, doctor.ID, tt.startsAt, tt.endsAt)
+			if tt.wantErr == 0 {
+				require.NoError(t, err)
+				return
+			}
+			requireStatus(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_AvailabilityService_NextSlots(t *testing.T) {
+	ctx := context.Background()
+	doctors := []*domain.User{
+		{ID: "doctor-1", Profile: domain.UserProfile{Speciality: "Psiquiatria"}},
+		{ID: "doctor-2"},
+		{ID: "doctor-3"},
+	}
+	monday := []domain.WeeklyHours{{Weekday: time.Monday, Start: "08:00", End: "13:00"}}
+	ids := []string{"doctor-1", "doctor-2", "doctor-3"}
+
+	s, availability, appointments, _ := newTestAvailabilityService(t)
+	// One query per collection for the whole page
+	availability.EXPECT().ListAvailabilities(ctx, ids).Return([]*domain.Availability{
+		{DoctorID: "doctor-1", Weekly: monday},
+		{DoctorID: "doctor-2", Weekly: monday},
+	}, nil).Once()
+	availability.EXPECT().ListExceptionsForDoctors(ctx, ids, "2026-11-02", "2026-11-15").Return([]*domain.AvailabilityException{
+		{DoctorID: "doctor-2", StartDate: "2026-11-02", EndDate: "2026-11-02", Reason: "Congresso"},
+	}, nil).Once()
+	appointments.EXPECT().ListBlockingForDoctors(ctx, ids, mock.Anything, mock.Anything).Return([]*domain.Appointment{{
+		DoctorID: "doctor-1",
+		StartsAt: time.Date(2026, 11, 2, 13, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2026, 11, 2, 14, 15, 0, 0, time.UTC),
+	}}, nil).Once()
+
+	next, err := s.NextSlots(ctx, doctors)
+	require.NoError(t, err)
+
+	// doctor-1 is booked until 11:15, doctor-2 is away today and doctor-3 has no hours
+	require.Len(t, next, 2)
+	assert.Equal(t, time.Date(2026, 11, 2, 14, 20, 0, 0, time.UTC), next["doctor-1"].StartsAt.UTC())
+	assert.Equal(t, time.Date(2026, 11, 9, 11, 0, 0, 0, time.UTC), next["doctor-2"].StartsAt.UTC())
+}
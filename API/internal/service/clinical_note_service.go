@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+)
+
+// ClinicalNoteServiceImpl implements ClinicalNoteService interface. Notes are part of the medical
+// record, so access follows MedicalRecordService, except that nurses don't see them.
+type ClinicalNoteServiceImpl struct {
+	notes   domain.ClinicalNoteRepository
+	records domain.MedicalRecordRepository
+	users   domain.UserRepository
+	now     func() time.Time
+}
+
+// NewClinicalNoteService creates a new ClinicalNoteService
+func NewClinicalNoteService(notes domain.ClinicalNoteRepository, records domain.MedicalRecordRepository,
+	users domain.UserRepository) domain.ClinicalNoteService {
+	return &ClinicalNoteServiceImpl{
+		notes:   notes,
+		records: records,
+		users:   users,
+		// MongoDB stores milliseconds, so the updated_at returned to clients is the one they sign with
+		now: func() time.Time { return time.Now().Truncate(time.Millisecond) },
+	}
+}
+
+func (s *ClinicalNoteServiceImpl) ListNotes(ctx context.Context, patientID string, filter domain.ClinicalNoteFilter,
+	query domain.ListQuery, actor *domain.AuthClaims) (*domain.ClinicalNotePage, error) {
+	logger := slog.With(
+		slog.String("service", "ClinicalNoteService"),
+		slog.String("method", "ListNotes"),
+		slog.String("patientID", patientID),
+		slog.String("actorID", actor.UserID),
+	)
+
+	page, err := query.PageRequest(domain.ClinicalNoteSortFields, domain.SortOrder{Field: "created_at", Desc: true})
+	if err != nil {
+		logger.Info("invalid list query", slog.Any("error", err))
+		return nil, err
+	}
+
+	access, err := s.authorize(ctx, patientID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	noteQuery := domain.ClinicalNoteQuery{PatientID: patientID, Status: filter.Status, EncounterID: filter.EncounterID}
+	if access != domain.RecordAccessFull {
+		if filter.Status == domain.NoteDraft {
+			return nil, domain.NewForbiddenError("drafts are only seen by doctors")
+		}
+		noteQuery.Status = domain.NoteSigned
+	}
+
+	notes, err := s.notes.List(ctx, noteQuery, page)
+	if err != nil {
+		logger.Error("error listing clinical notes", slog.Any("error", err))
+		return nil, err
+	}
+	if err := s.loadAmendments(ctx, notes.Items...); err != nil {
+		logger.Error("error listing clinical note amendments", slog.Any("error", err))
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+func (s *ClinicalNoteServiceImpl) GetNote(ctx context.Context, patientID, id string, actor *domain.AuthClaims) (*domain.ClinicalNote, error) {
+	logger := slog.With(
+		slog.String("service", "ClinicalNoteService"),
+		slog.String("method", "GetNote"),
+		slog.String("patientID", patientID),
+		slog.String("noteID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	access, err := s.authorize(ctx, patientID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := s.notes.GetByID(ctx, patientID, id)
+	if err != nil {
+		logger.Error("error fetching clinical note", slog.Any("error", err))
+		return nil, err
+	}
+	// Drafts are reported as not found to patients, so their IDs can't be probed
+	if note == nil || (access != domain.RecordAccessFull && note.Status != domain.NoteSigned) {
+		logger.Info("clinical note not found or not visible")
+		return nil, domain.NewNotFoundError("clinical note not found")
+	}
+	if err := s.loadAmendments(ctx, note); err != nil {
+		logger.Error("error listing clinical note amendments", slog.Any("error", err))
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (s *ClinicalNoteServiceImpl) CreateNote(ctx context.Context, patientID string, req domain.CreateClinicalNoteRequest,
+	actor *domain.AuthClaims) (*domain.ClinicalNote, error) {
+	logger := slog.With(
+		slog.String("service", "ClinicalNoteService"),
+		slog.String("method", "CreateNote"),
+		slog.String("patientID", patientID),
+		slog.String("actorID", actor.UserID),
+	)
+
+	if err := authorizeRecordWrite(ctx, s.users, patientID, actor); err != nil {
+		logger.Info("not allowed to write the record", slog.Any("error", err))
+		return nil, err
+	}
+	if err := checkEncounter(ctx, s.records, patientID, req.EncounterID); err != nil {
+		logger.Info("invalid encounter", slog.Any("error", err))
+		return nil, err
+	}
+
+	now := s.now()
+	note := &domain.ClinicalNote{
+		ID:          pkg.GenerateID(),
+		PatientID:   patientID,
+		Status:      domain.NoteDraft,
+		EncounterID: req.EncounterID,
+		NoteContent: req.NoteContent,
+		AuthorID:    actor.UserID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if req.Sign {
+		note.Status = domain.NoteSigned
+		note.SignedAt = &now
+	}
+	if err := s.notes.Create(ctx, note); err != nil {
+		logger.Error("error creating clinical note", slog.Any("error", err))
+		return nil, err
+	}
+	note.SetAmendments([]*domain.NoteAmendment{})
+
+	logger.Info("clinical note created", slog.String("noteID", note.ID), slog.String("status", string(note.Status)))
+	return note, nil
+}
+
+func (s *ClinicalNoteServiceImpl) UpdateNote(ctx context.Context, patientID, id string, req domain.UpdateClinicalNoteRequest,
+	actor *domain.AuthClaims) (*domain.ClinicalNote, error) {
+	logger := slog.With(
+		slog.String("service", "ClinicalNoteService"),
+		slog.String("method", "UpdateNote"),
+		slog.String("patientID", patientID),
+		slog.String("noteID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	current, err := s.getDraft(ctx, patientID, id, actor)
+	if err != nil {
+		logger.Info("draft can't be changed", slog.Any("error", err))
+		return nil, err
+	}
+	if err := checkEncounter(ctx, s.records, patientID, req.EncounterID); err != nil {
+		logger.Info("invalid encounter", slog.Any("error", err))
+		return nil, err
+	}
+
+	updated := *current
+	updated.EncounterID = req.EncounterID
+	updated.NoteContent = req.NoteContent
+	updated.UpdatedAt = s.now()
+	note, err := s.notes.UpdateDraft(ctx, &updated)
+	if err != nil {
+		logger.Error("error updating clinical note", slog.Any("error", err))
+		return nil, err
+	}
+	if note == nil {
+		logger.Info("clinical note signed while updating")
+		return nil, domain.NewConflictError("clinical note is signed and can only be amended")
+	}
+	note.SetAmendments([]*domain.NoteAmendment{})
+
+	logger.Info("clinical note updated")
+	return note, nil
+}
+
+func (s *ClinicalNoteServiceImpl) SignNote(ctx context.Context, patientID, id string, req domain.SignClinicalNoteRequest,
+	actor *domain.AuthClaims) (*domain.ClinicalNote, error) {
+	logger := slog.With(
+		slog.String("service", "ClinicalNoteService"),
+		slog.String("method", "SignNote"),
+		slog.String("patientID", patientID),
+		slog.String("noteID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	draft, err := s.getDraft(ctx, patientID, id, actor)
+	if err != nil {
+		logger.Info("draft can't be signed", slog.Any("error", err))
+		return nil, err
+	}
+	if !req.UpdatedAt.Equal(draft.UpdatedAt) {
+		logger.Info("signing an outdated draft", slog.Time("updatedAt", req.UpdatedAt), slog.Time("draftUpdatedAt", draft.UpdatedAt))
+		return nil, domain.NewConflictError("clinical note was changed meanwhile, review it and try again")
+	}
+
+	note, err := s.notes.Sign(ctx, patientID, id, req.UpdatedAt, s.now())
+	if err != nil {
+		logger.Error("error signing clinical note", slog.Any("error", err))
+		return nil, err
+	}
+	if note == nil {
+		logger.Info("clinical note changed or signed meanwhile")
+		return nil, domain.NewConflictError("clinical note was changed or signed meanwhile, review it and try again")
+	}
+	note.SetAmendments([]*domain.NoteAmendment{})
+
+	logger.Info("clinical note signed")
+	return note, nil
+}
+
+// AmendNote only lets the author of a note amend it: the author attested the signed text, so
+// they are the one to correct it, while other doctors record their view in notes of their own.
+func (s *ClinicalNoteServiceImpl) AmendNote(ctx context.Context, patientID, id string, req domain.AmendClinicalNoteRequest,
+	actor *domain.AuthClaims) (*domain.ClinicalNote, error) {
+	logger := slog.With(
+		slog.String("service", "ClinicalNoteService"),
+		slog.String("method", "AmendNote"),
+		slog.String("patientID", patientID),
+		slog.String("noteID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	note, err := s.getOwnNote(ctx, patientID, id, actor)
+	if err != nil {
+		logger.Info("clinical note can't be amended", slog.Any("error", err))
+		return nil, err
+	}
+	if note.Status != domain.NoteSigned {
+		return nil, domain.NewConflictError("clinical note is a draft, change it instead of amending it")
+	}
+	if err := s.loadAmendments(ctx, note); err != nil {
+		logger.Error("error listing clinical note amendments", slog.Any("error", err))
+		return nil, err
+	}
+	if req.Amends != 0 && req.Amends != note.Version {
+		logger.Info("amending an outdated version", slog.Int("amends", req.Amends), slog.Int("version", note.Version))
+		return nil, domain.NewConflictError("clinical note has a later version, review it and try again")
+	}
+
+	diff := req.NoteContent.DiffFrom(note.CurrentContent())
+	if diff == nil {
+		return nil, domain.NewBadRequestError("the amendment doesn't change the note")
+	}
+
+	amendment := &domain.NoteAmendment{
+		ID:          pkg.GenerateID(),
+		NoteID:      note.ID,
+		Version:     note.Version + 1,
+		Amends:      note.Version,
+		NoteContent: req.NoteContent,
+		Reason:      req.Reason,
+		Diff:        *diff,
+		AuthorID:    actor.UserID,
+		CreatedAt:   s.now(),
+	}
+	if err := s.notes.CreateAmendment(ctx, amendment); err != nil {
+		logger.Error("error amending clinical note", slog.Any("error", err))
+		return nil, err
+	}
+	note.SetAmendments(append(note.Amendments, amendment))
+
+	logger.Info("clinical note amended", slog.String("amendmentID", amendment.ID), slog.Int("version", note.Version))
+	return note, nil
+}
+
+// authorize returns the access of actor to the notes of a patient. Nurses only get the basic view
+// of records, which has no notes.
+func (s *ClinicalNoteServiceImpl) authorize(ctx context.Context, patientID string, actor *domain.AuthClaims) (domain.RecordAccess, error) {
+	if domain.RecordAccessOf(actor.UserType) == domain.RecordAccessBasic {
+		return domain.RecordAccessBasic, domain.NewForbiddenError("clinical notes are only seen by doctors and the patient")
+	}
+	return authorizeRecord(ctx, s.users, patientID, actor)
+}
+
+// getOwnNote returns a note written by actor, the only doctor who changes, signs or amends it.
+func (s *ClinicalNoteServiceImpl) getOwnNote(ctx context.Context, patientID, id string, actor *domain.AuthClaims) (*domain.ClinicalNote, error) {
+	if err := authorizeRecordWrite(ctx, s.users, patientID, actor); err != nil {
+		return nil, err
+	}
+
+	note, err := s.notes.GetByID(ctx, patientID, id)
+	if err != nil {
+		return nil, err
+	}
+	if note == nil {
+		return nil, domain.NewNotFoundError("clinical note not found")
+	}
+	if note.AuthorID != actor.UserID {
+		return nil, domain.NewForbiddenError("only the author of a note can change, sign or amend it")
+	}
+	return note, nil
+}
+
+// getDraft returns a draft of actor that can still change.
+func (s *ClinicalNoteServiceImpl) getDraft(ctx context.Context, patientID, id string, actor *domain.AuthClaims) (*domain.ClinicalNote, error) {
+	note, err := s.getOwnNote(ctx, patientID, id, actor)
+	if err != nil {
+		return nil, err
+	}
+	if note.Status != domain.NoteDraft {
+		return nil, domain.NewConflictError("clinical note is signed and can only be amended")
+	}
+	return note, nil
+}
+
+// loadAmendments sets the amendments of notes, loading those of every signed note at once.
+func (s *ClinicalNoteServiceImpl) loadAmendments(ctx context.Context, notes ...*domain.ClinicalNote) error {
+	byNote := make(map[string][]*domain.NoteAmendment, len(notes))
+	var signed []string
+	for _, note := range notes {
+		byNote[note.ID] = []*domain.NoteAmendment{}
+		if note.Status == domain.NoteSigned {
+			signed = append(signed, note.ID)
+		}
+	}
+
+	if len(signed) > 0 {
+		amendments, err := s.notes.ListAmendments(ctx, signed)
+		if err != nil {
+			return err
+		}
+		for _, amendment := range amendments {
+			byNote[amendment.NoteID] = append(byNote[amendment.NoteID], amendment)
+		}
+	}
+
+	for _, note := range notes {
+		note.SetAmendments(byNote[note.ID])
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	mocks "github.com/vida-plus/api/mocks"
+)
+
+func newTestClinicalNoteService(t *testing.T) (*ClinicalNoteServiceImpl, *mocks.ClinicalNoteRepositoryMock, *mocks.UserRepositoryMock, time.Time) {
+	notes := mocks.NewClinicalNoteRepositoryMock(t)
+	records := mocks.NewMedicalRecordRepositoryMock(t)
+	users := mocks.NewUserRepositoryMock(t)
+
+	now := time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC)
+	s := NewClinicalNoteService(notes, records, users).(*ClinicalNoteServiceImpl)
+	s.now = func() time.Time { return now }
+	return s, notes, users, now
+}
+
+func Test_ClinicalNoteService_ListNotes(t *testing.T) {
+	ctx := context.Background()
+	page := domain.PageRequest{Limit: domain.DefaultPageLimit, Sort: domain.SortOrder{Field: "created_at", Desc: true}}
+
+	t.Run("DOCTOR SEES DRAFTS", func(t *testing.T) {
+		s, notes, users, _ := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().List(ctx, domain.ClinicalNoteQuery{PatientID: "patient-1", Status: domain.NoteDraft}, page).
+			Return(&domain.ClinicalNotePage{}, nil)
+
+		_, err := s.ListNotes(ctx, "patient-1", domain.ClinicalNoteFilter{Status: domain.NoteDraft}, domain.ListQuery{}, testDoctor)
+		require.NoError(t, err)
+	})
+
+	t.Run("AMENDMENTS LOADED AT ONCE", func(t *testing.T) {
+		s, notes, users, _ := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().List(ctx, domain.ClinicalNoteQuery{PatientID: "patient-1"}, page).
+			Return(&domain.ClinicalNotePage{Items: []*domain.ClinicalNote{
+				{ID: "note-1", Status: domain.NoteSigned},
+				{ID: "note-2", Status: domain.NoteDraft},
+				{ID: "note-3", Status: domain.NoteSigned},
+			}}, nil)
+		notes.EXPECT().ListAmendments(ctx, []string{"note-1", "note-3"}).
+			Return([]*domain.NoteAmendment{{NoteID: "note-3", Version: 2}}, nil)
+
+		got, err := s.ListNotes(ctx, "patient-1", domain.ClinicalNoteFilter{}, domain.ListQuery{}, testDoctor)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 0, 2}, []int{got.Items[0].Version, got.Items[1].Version, got.Items[2].Version})
+		assert.Empty(t, got.Items[0].Amendments)
+		assert.Len(t, got.Items[2].Amendments, 1)
+	})
+
+	t.Run("PATIENT SEES OWN SIGNED NOTES", func(t *testing.T) {
+		s, notes, users, _ := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().List(ctx, domain.ClinicalNoteQuery{PatientID: "patient-1", Status: domain.NoteSigned}, page).
+			Return(&domain.ClinicalNotePage{}, nil)
+
+		_, err := s.ListNotes(ctx, "patient-1", domain.ClinicalNoteFilter{}, domain.ListQuery{}, testPatient)
+		require.NoError(t, err)
+	})
+
+	t.Run("NURSE", func(t *testing.T) {
+		s, _, _, _ := newTestClinicalNoteService(t)
+
+		_, err := s.ListNotes(ctx, "patient-1", domain.ClinicalNoteFilter{}, domain.ListQuery{}, testNurse)
+		requireStatus(t, err, http.StatusForbidden)
+	})
+}
+
+func Test_ClinicalNoteService_CreateNote(t *testing.T) {
+	ctx := context.Background()
+	content := domain.NoteContent{Title: "Evolução", Body: "Estável"}
+
+	t.Run("SIGNED RIGHT AWAY", func(t *testing.T) {
+		s, notes, users, now := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().Create(ctx, mock.Anything).Return(nil)
+
+		note, err := s.CreateNote(ctx, "patient-1", domain.CreateClinicalNoteRequest{NoteContent: content, Sign: true}, testDoctor)
+		require.NoError(t, err)
+		assert.Equal(t, domain.NoteSigned, note.Status)
+		assert.Equal(t, &now, note.SignedAt)
+		assert.Equal(t, 1, note.Version)
+		assert.Empty(t, note.Amendments)
+	})
+
+	t.Run("ONLY DOCTORS WRITE", func(t *testing.T) {
+		s, _, _, _ := newTestClinicalNoteService(t)
+
+		_, err := s.CreateNote(ctx, "patient-1", domain.CreateClinicalNoteRequest{NoteContent: content}, testNurse)
+		requireStatus(t, err, http.StatusForbidden)
+	})
+}
+
+func Test_ClinicalNoteService_SignNote(t *testing.T) {
+	ctx := context.Background()
+	draft := &domain.ClinicalNote{
+		ID:          "note-1",
+		AuthorID:    "doctor-1",
+		Status:      domain.NoteDraft,
+		NoteContent: domain.NoteContent{Title: "Evolução", Body: "Estável"},
+		UpdatedAt:   time.Date(2026, 11, 2, 11, 30, 0, 0, time.UTC),
+	}
+	req := domain.SignClinicalNoteRequest{UpdatedAt: draft.UpdatedAt}
+
+	tests := []struct {
+		name    string
+		note    *domain.ClinicalNote
+		wantErr int
+	}{
+		{"ANOTHER AUTHOR", &domain.ClinicalNote{AuthorID: "doctor-2", Status: domain.NoteDraft}, http.StatusForbidden},
+		{"ALREADY SIGNED", &domain.ClinicalNote{AuthorID: "doctor-1", Status: domain.NoteSigned}, http.StatusConflict},
+		{"MISSING", nil, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, notes, users, _ := newTestClinicalNoteService(t)
+			expectPatient(ctx, users)
+			notes.EXPECT().GetByID(ctx, "patient-1", "note-1").Return(tt.note, nil)
+
+			_, err := s.SignNote(ctx, "patient-1", "note-1", req, testDoctor)
+			requireStatus(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("BY THE AUTHOR", func(t *testing.T) {
+		s, notes, users, now := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().GetByID(ctx, "patient-1", "note-1").Return(draft, nil)
+		notes.EXPECT().Sign(ctx, "patient-1", "note-1", draft.UpdatedAt, now).
+			Return(&domain.ClinicalNote{ID: "note-1", Status: domain.NoteSigned, SignedAt: &now}, nil)
+
+		note, err := s.SignNote(ctx, "patient-1", "note-1", req, testDoctor)
+		require.NoError(t, err)
+		assert.Equal(t, domain.NoteSigned, note.Status)
+		assert.Equal(t, 1, note.Version)
+	})
+
+	t.Run("CHANGED SINCE READ", func(t *testing.T) {
+		s, notes, users, _ := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().GetByID(ctx, "patient-1", "note-1").Return(draft, nil)
+
+		stale := domain.SignClinicalNoteRequest{UpdatedAt: draft.UpdatedAt.Add(-time.Minute)}
+		_, err := s.SignNote(ctx, "patient-1", "note-1", stale, testDoctor)
+		requireStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("CHANGED MEANWHILE", func(t *testing.T) {
+		s, notes, users, now := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().GetByID(ctx, "patient-1", "note-1").Return(draft, nil)
+		notes.EXPECT().Sign(ctx, "patient-1", "note-1", draft.UpdatedAt, now).Return(nil, nil)
+
+		_, err := s.SignNote(ctx, "patient-1", "note-1", req, testDoctor)
+		requireStatus(t, err, http.StatusConflict)
+	})
+}
+
+func Test_ClinicalNoteService_AmendNote(t *testing.T) {
+	ctx := context.Background()
+	signed := func() *domain.ClinicalNote {
+		return &domain.ClinicalNote{
+			ID:          "note-1",
+			AuthorID:    "doctor-1",
+			Status:      domain.NoteSigned,
+			NoteContent: domain.NoteContent{Title: "Evolução", Body: "Prescrito salbutamol 100 mcg"},
+		}
+	}
+	amended := []*domain.NoteAmendment{{
+		ID:          "amendment-1",
+		NoteID:      "note-1",
+		Version:     2,
+		Amends:      1,
+		NoteContent: domain.NoteContent{Title: "Evolução", Body: "Prescrito salbutamol 200 mcg"},
+	}}
+	amendment := domain.AmendClinicalNoteRequest{
+		NoteContent: domain.NoteContent{Title: "Evolução", Body: "Prescrito salbutamol 200 mcg\nRetorno em 7 dias"},
+		Reason:      "Retorno incluído",
+	}
+
+	t.Run("BY THE AUTHOR", func(t *testing.T) {
+		s, notes, users, now := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().GetByID(ctx, "patient-1", "note-1").Return(signed(), nil)
+		notes.EXPECT().ListAmendments(ctx, []string{"note-1"}).Return(amended, nil)
+		notes.EXPECT().CreateAmendment(ctx, mock.MatchedBy(func(a *domain.NoteAmendment) bool {
+			return a.ID != "" && a.NoteID == "note-1" && a.Version == 3 && a.Amends == 2 &&
+				a.NoteContent == amendment.NoteContent && a.Reason == "Retorno incluído" &&
+				a.AuthorID == "doctor-1" && a.CreatedAt.Equal(now)
+		})).Return(nil)
+
+		note, err := s.AmendNote(ctx, "patient-1", "note-1", amendment, testDoctor)
+		require.NoError(t, err)
+		assert.Equal(t, 3, note.Version)
+		assert.Equal(t, "Prescrito salbutamol 100 mcg", note.Body)
+		require.Len(t, note.Amendments, 2)
+		assert.Equal(t, domain.NoteDiff{Body: []domain.DiffLine{
+			{Op: domain.DiffEqual, Text: "Prescrito salbutamol 200 mcg"},
+			{Op: domain.DiffInsert, Text: "Retorno em 7 dias"},
+		}}, note.Amendments[1].Diff)
+	})
+
+	t.Run("OUTDATED VERSION", func(t *testing.T) {
+		s, notes, users, _ := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().GetByID(ctx, "patient-1", "note-1").Return(signed(), nil)
+		notes.EXPECT().ListAmendments(ctx, []string{"note-1"}).Return(amended, nil)
+
+		outdated := amendment
+		outdated.Amends = 1
+		_, err := s.AmendNote(ctx, "patient-1", "note-1", outdated, testDoctor)
+		requireStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("UNCHANGED TEXT", func(t *testing.T) {
+		s, notes, users, _ := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().GetByID(ctx, "patient-1", "note-1").Return(signed(), nil)
+		notes.EXPECT().ListAmendments(ctx, []string{"note-1"}).Return(amended, nil)
+
+		_, err := s.AmendNote(ctx, "patient-1", "note-1", domain.AmendClinicalNoteRequest{
+			NoteContent: amended[0].NoteContent,
+			Reason:      "Revisão",
+		}, testDoctor)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("AMENDED MEANWHILE", func(t *testing.T) {
+		s, notes, users, _ := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().GetByID(ctx, "patient-1", "note-1").Return(signed(), nil)
+		notes.EXPECT().ListAmendments(ctx, []string{"note-1"}).Return([]*domain.NoteAmendment{}, nil)
+		notes.EXPECT().CreateAmendment(ctx, mock.Anything).Return(domain.NewConflictError("clinical note was amended by someone else"))
+
+		_, err := s.AmendNote(ctx, "patient-1", "note-1", amendment, testDoctor)
+		requireStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("ANOTHER DOCTOR", func(t *testing.T) {
+		s, notes, users, _ := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().GetByID(ctx, "patient-1", "note-1").Return(signed(), nil)
+
+		_, err := s.AmendNote(ctx, "patient-1", "note-1", amendment, &domain.AuthClaims{UserID: "doctor-2", UserType: domain.UserTypeDoctor})
+		requireStatus(t, err, http.StatusForbidden)
+	})
+
+	t.Run("DRAFT", func(t *testing.T) {
+		s, notes, users, _ := newTestClinicalNoteService(t)
+		expectPatient(ctx, users)
+		notes.EXPECT().GetByID(ctx, "patient-1", "note-1").
+			Return(&domain.ClinicalNote{ID: "note-1", AuthorID: "doctor-1", Status: domain.NoteDraft}, nil)
+
+		_, err := s.AmendNote(ctx, "patient-1", "note-1", amendment, testDoctor)
+		requireStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("PATIENT", func(t *testing.T) {
+		s, _, _, _ := newTestClinicalNoteService(t)
+
+		_, err := s.AmendNote(ctx, "patient-1", "note-1", amendment, testPatient)
+		requireStatus(t, err, http.StatusForbidden)
+	})
+}
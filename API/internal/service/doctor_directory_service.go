@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// DoctorDirectoryServiceImpl implements DoctorDirectoryService interface.
+type DoctorDirectoryServiceImpl struct {
+	users        domain.UserRepository
+	availability domain.AvailabilityService
+}
+
+// NewDoctorDirectoryService creates a DoctorDirectoryService that takes the next free slots from availability
+func NewDoctorDirectoryService(users domain.UserRepository, availability domain.AvailabilityService) domain.DoctorDirectoryService {
+	return &DoctorDirectoryServiceImpl{
+		users:        users,
+		availability: availability,
+	}
+}
+
+func (s *DoctorDirectoryServiceImpl) List(ctx context.Context, filter domain.DoctorFilter, query domain.ListQuery) (*domain.DoctorPage, error) {
+	logger := slog.With(
+		slog.String("service", "DoctorDirectoryService"),
+		slog.String("method", "List"),
+	)
+
+	page, err := query.PageRequest(domain.DoctorSortFields, domain.SortOrder{Field: "first_name"})
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.users.ListDoctors(ctx, filter, page)
+	if err != nil {
+		logger.Error("error listing doctors", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &domain.DoctorPage{
+		Items:      s.profiles(ctx, users.Items),
+		NextCursor: users.NextCursor,
+		Total:      users.Total,
+	}, nil
+}
+
+func (s *DoctorDirectoryServiceImpl) Get(ctx context.Context, id string) (*domain.DoctorProfile, error) {
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.IsDeleted() || user.Type != domain.UserTypeDoctor || !user.IsActive() {
+		return nil, domain.NewNotFoundError("doctor not found")
+	}
+
+	return s.profiles(ctx, []*domain.User{user})[0], nil
+}
+
+// profiles returns the public profiles of doctors with their next free slots. The slots are
+// only hints, so the profiles are returned without them when they can't be computed.
+func (s *DoctorDirectoryServiceImpl) profiles(ctx context.Context, doctors []*domain.User) []*domain.DoctorProfile {
+	slots, err := s.availability.NextSlots(ctx, doctors)
+	if err != nil {
+		slog.Warn("error finding the next free slots",
+			slog.String("service", "DoctorDirectoryService"),
+			slog.Any("error", err),
+		)
+	}
+
+	profiles := make([]*domain.DoctorProfile, 0, len(doctors))
+	for _, doctor := range doctors {
+		profile := domain.NewDoctorProfile(doctor)
+		profile.NextSlot = slots[doctor.ID]
+		profiles = append(profiles, profile)
+	}
+	return profiles
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	mocks "github.com/vida-plus/api/mocks"
+)
+
+func Test_DoctorDirectoryService_List(t *testing.T) {
+	ctx := context.Background()
+	doctor := &domain.User{
+		ID:     "doctor-1",
+		Email:  "ana@vidaplus.com",
+		Type:   domain.UserTypeDoctor,
+		Status: domain.UserStatusActive,
+		Profile: domain.UserProfile{
+			FirstName:  "Ana",
+			LastName:   "Souza",
+			Phone:      "+5511999999999",
+			CPF:        "52998224725",
+			CRM:        "CRM/SP 123456",
+			Speciality: "Cardiologia",
+		},
+	}
+	slot := &domain.Slot{StartsAt: time.Date(2026, 11, 3, 12, 0, 0, 0, time.UTC), EndsAt: time.Date(2026, 11, 3, 12, 30, 0, 0, time.UTC)}
+
+	t.Run("PUBLIC PROFILE WITH NEXT SLOT", func(t *testing.T) {
+		users := mocks.NewUserRepositoryMock(t)
+		availability := mocks.NewAvailabilityServiceMock(t)
+		users.EXPECT().ListDoctors(ctx, domain.DoctorFilter{Speciality: "cardiologia"},
+			domain.PageRequest{Limit: domain.DefaultPageLimit, Sort: domain.SortOrder{Field: "first_name"}}).
+			Return(&domain.UserPage{Items: []*domain.User{doctor}, NextCursor: "next", Total: 3}, nil)
+		availability.EXPECT().NextSlots(ctx, []*domain.User{doctor}).Return(map[string]*domain.Slot{doctor.ID: slot}, nil)
+
+		page, err := NewDoctorDirectoryService(users, availability).List(ctx, domain.DoctorFilter{Speciality: "cardiologia"}, domain.ListQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, "next", page.NextCursor)
+		assert.Equal(t, int64(3), page.Total)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, "CRM/SP 123456", page.Items[0].CRM)
+		assert.Equal(t, slot, page.Items[0].NextSlot)
+
+		// Contact details and documents never leave the directory
+		body, err := json.Marshal(page)
+		require.NoError(t, err)
+		for _, private := range []string{doctor.Email, doctor.Profile.Phone, doctor.Profile.CPF, "status"} {
+			assert.NotContains(t, string(body), private)
+		}
+	})
+
+	t.Run("SLOT HINT FAILURE", func(t *testing.T) {
+		users := mocks.NewUserRepositoryMock(t)
+		availability := mocks.NewAvailabilityServiceMock(t)
+		users.EXPECT().ListDoctors(ctx, domain.DoctorFilter{}, domain.PageRequest{Limit: domain.DefaultPageLimit, Sort: domain.SortOrder{Field: "first_name"}}).
+			Return(&domain.UserPage{Items: []*domain.User{doctor}}, nil)
+		availability.EXPECT().NextSlots(ctx, []*domain.User{doctor}).Return(nil, errors.New("timeout"))
+
+		page, err := NewDoctorDirectoryService(users, availability).List(ctx, domain.DoctorFilter{}, domain.ListQuery{})
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Nil(t, page.Items[0].NextSlot)
+	})
+
+	t.Run("INVALID SORT", func(t *testing.T) {
+		users := mocks.NewUserRepositoryMock(t)
+		availability := mocks.NewAvailabilityServiceMock(t)
+
+		_, err := NewDoctorDirectoryService(users, availability).List(ctx, domain.DoctorFilter{}, domain.ListQuery{Sort: "email"})
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+}
+
+func Test_DoctorDirectoryService_Get(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		user *domain.User
+	}{
+		{"MISSING", nil},
+		{"NOT A DOCTOR", &domain.User{ID: "user-1", Type: domain.UserTypePatient, Status: domain.UserStatusActive}},
+		{"INACTIVE", &domain.User{ID: "user-1", Type: domain.UserTypeDoctor, Status: domain.UserStatusPending}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := mocks.NewUserRepositoryMock(t)
+			availability := mocks.NewAvailabilityServiceMock(t)
+			users.EXPECT().GetByID(ctx, "user-1").Return(tt.user, nil)
+
+			_, err := NewDoctorDirectoryService(users, availability).Get(ctx, "user-1")
+			requireStatus(t, err, http.StatusNotFound)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+const (
+	// emailVerificationTTL is how long a verification link stays valid.
+	emailVerificationTTL = 24 * time.Hour
+	// verificationResendCooldown is the minimum time between two verification emails to the same account.
+	verificationResendCooldown = time.Minute
+)
+
+// EmailVerificationServiceImpl implements EmailVerificationService interface.
+type EmailVerificationServiceImpl struct {
+	users     domain.UserRepository
+	jwt       domain.JWTManager
+	mailer    domain.Mailer
+	statuses  domain.UserStatusCache
+	verifyURL string
+}
+
+// NewEmailVerificationService creates an EmailVerificationService that emails links pointing to verifyURL
+func NewEmailVerificationService(users domain.UserRepository, jwt domain.JWTManager, mailer domain.Mailer,
+	statuses domain.UserStatusCache, verifyURL string) domain.EmailVerificationService {
+	return &EmailVerificationServiceImpl{
+		users:     users,
+		jwt:       jwt,
+		mailer:    mailer,
+		statuses:  statuses,
+		verifyURL: verifyURL,
+	}
+}
+
+// SendVerification emails a verification link to a pending account. Sends are throttled
+// per account, and accounts that are no longer pending are skipped.
+func (e *EmailVerificationServiceImpl) SendVerification(ctx context.Context, user *domain.User) error {
+	logger := slog.With(
+		slog.String("service", "EmailVerificationService"),
+		slog.String("method", "SendVerification"),
+		slog.String("userID", user.ID),
+	)
+
+	if user.Status != domain.UserStatusPending || user.EmailVerifiedAt != nil {
+		logger.Info("account does not need verification", slog.String("status", string(user.Status)))
+		return nil
+	}
+
+	now := time.Now()
+	marked, err := e.users.MarkVerificationSent(ctx, user.ID, now, now.Add(-verificationResendCooldown))
+	if err != nil {
+		logger.Error("error recording verification email", slog.Any("error", err))
+		return err
+	}
+	if !marked {
+		logger.Info("verification email throttled")
+		return domain.NewTooManyRequestsError("a verification email was sent recently, try again later")
+	}
+
+	token, err := e.jwt.GenerateScopedToken(user.ID, user.Email, domain.TokenPurposeEmailVerification, emailVerificationTTL)
+	if err != nil {
+		logger.Error("error generating verification token", slog.Any("error", err))
+		return domain.NewInternalError("error generating verification token")
+	}
+
+	link := e.verifyURL + "?token=" + url.QueryEscape(token)
+	message := &domain.Email{
+		To:      user.Email,
+		Subject: "Vida Plus - Confirme seu email",
+		Body: fmt.Sprintf("Olá %s,\n\nBem-vindo ao Vida Plus! Confirme seu email para ativar sua conta "+
+			"usando o link abaixo em até %d horas:\n\n%s\n\n"+
+			"Se você não criou esta conta, ignore este email.\n",
+			user.Profile.FirstName, int(emailVerificationTTL.Hours()), link),
+	}
+	if err := e.mailer.Send(ctx, message); err != nil {
+		logger.Error("error sending verification email", slog.Any("error", err))
+		return domain.NewInternalError("error sending verification email")
+	}
+
+	logger.Info("verification email sent")
+	return nil
+}
+
+// Resend emails a new verification link. Unknown or already verified emails are ignored, and
+// resends within the cooldown are dropped, so the endpoint can't be used to find out which
+// emails are registered.
+func (e *EmailVerificationServiceImpl) Resend(ctx context.Context, email string) error {
+	logger := slog.With(
+		slog.String("service", "EmailVerificationService"),
+		slog.String("method", "Resend"),
+		slog.String("email", email),
+	)
+
+	user, err := e.users.GetUserByEmail(ctx, email)
+	if err != nil {
+		logger.Error("error fetching user", slog.Any("error", err))
+		return domain.NewInternalError("error processing verification request")
+	}
+	if user == nil || user.IsDeleted() {
+		logger.Info("verification resend requested for non-existent user")
+		return nil
+	}
+
+	err = e.SendVerification(ctx, user)
+	var apiErr *domain.APIError
+	if errors.As(err, &apiErr) && apiErr.Status == http.StatusTooManyRequests {
+		logger.Info("verification resend dropped within cooldown")
+		return nil
+	}
+	return err
+}
+
+// Verify activates the account a verification token was issued for.
+func (e *EmailVerificationServiceImpl) Verify(ctx context.Context, token string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "EmailVerificationService"),
+		slog.String("method", "Verify"),
+	)
+
+	claims, err := e.jwt.ValidateScopedToken(token, domain.TokenPurposeEmailVerification)
+	if err != nil {
+		logger.Info("invalid verification token", slog.Any("error", err))
+		return nil, domain.NewBadRequestError("invalid or expired verification token")
+	}
+	logger = logger.With(slog.String("userID", claims.Subject))
+
+	// The email must still match so links sent before an email change stop working
+	user, err := e.users.MarkEmailVerified(ctx, claims.Subject, claims.Email, "email verified", claims.Subject)
+	if err != nil {
+		logger.Error("error verifying email", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		logger.Info("verification token no longer applies")
+		return nil, domain.NewBadRequestError("invalid or expired verification token")
+	}
+
+	e.statuses.Invalidate(user.ID)
+
+	logger.Info("email verified successfully")
+	return user, nil
+}
+
+// VerifyByAdmin marks the email of a pending account as verified without a token.
+func (e *EmailVerificationServiceImpl) VerifyByAdmin(ctx context.Context, userID, adminID string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "EmailVerificationService"),
+		slog.String("method", "VerifyByAdmin"),
+		slog.String("userID", userID),
+		slog.String("adminID", adminID),
+	)
+
+	user, err := e.users.MarkEmailVerified(ctx, userID, "", "email verified by administrator", adminID)
+	if err != nil {
+		logger.Error("error verifying email", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		existing, err := e.users.GetByID(ctx, userID)
+		if err != nil {
+			logger.Error("error fetching user", slog.Any("error", err))
+			return nil, err
+		}
+		if existing == nil {
+			logger.Info("user not found")
+			return nil, domain.NewNotFoundError("user not found")
+		}
+		logger.Info("user is not awaiting verification", slog.String("status", string(existing.Status)))
+		return nil, domain.NewConflictError("user is not awaiting email verification")
+	}
+
+	e.statuses.Invalidate(user.ID)
+
+	logger.Info("email verified by administrator")
+	return user, nil
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+)
+
+// invitationTTL is how long an invitation link stays valid.
+const invitationTTL = 7 * 24 * time.Hour
+
+// invitationTypeNames are the user types as written in invitation emails.
+var invitationTypeNames = map[domain.UserType]string{
+	domain.UserTypePatient:      "paciente",
+	domain.UserTypeDoctor:       "médico",
+	domain.UserTypeNurse:        "enfermeiro",
+	domain.UserTypeAdmin:        "administrador",
+	domain.UserTypeReceptionist: "recepcionista",
+}
+
+// InvitationServiceImpl implements InvitationService interface.
+type InvitationServiceImpl struct {
+	users       domain.UserRepository
+	invitations domain.InvitationRepository
+	mailer      domain.Mailer
+	audit       domain.AuditRepository
+	acceptURL   string
+}
+
+// NewInvitationService creates an InvitationService that emails links pointing to acceptURL
+func NewInvitationService(users domain.UserRepository, invitations domain.InvitationRepository, mailer domain.Mailer,
+	audit domain.AuditRepository, acceptURL string) domain.InvitationService {
+	return &InvitationServiceImpl{
+		users:       users,
+		invitations: invitations,
+		mailer:      mailer,
+		audit:       audit,
+		acceptURL:   acceptURL,
+	}
+}
+
+func (i *InvitationServiceImpl) Invite(ctx context.Context, req domain.InviteUserRequest, invitedBy string) (*domain.Invitation, error) {
+	logger := slog.With(
+		slog.String("service", "InvitationService"),
+		slog.String("method", "Invite"),
+		slog.String("email", req.Email),
+		slog.String("type", string(req.Type)),
+		slog.String("invitedBy", invitedBy),
+	)
+
+	if !req.Type.IsValid() {
+		return nil, domain.NewBadRequestError("invalid user type")
+	}
+
+	existing, err := i.users.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		logger.Error("error checking existing user", slog.Any("error", err))
+		return nil, domain.NewInternalError("error checking user existence")
+	}
+	if existing != nil {
+		logger.Info("invitation for existing user")
+		return nil, domain.NewConflictError("user already exists")
+	}
+
+	// Only the most recent invitation is valid
+	if err := i.invitations.DeleteByEmail(ctx, req.Email); err != nil {
+		logger.Error("error invalidating previous invitations", slog.Any("error", err))
+		return nil, err
+	}
+
+	rawToken := pkg.GenerateToken()
+	now := time.Now()
+	invitation := &domain.Invitation{
+		ID:        pkg.HashToken(rawToken),
+		Email:     req.Email,
+		Type:      req.Type,
+		InvitedBy: invitedBy,
+		ExpiresAt: now.Add(invitationTTL),
+		CreatedAt: now,
+	}
+	if err := i.invitations.Create(ctx, invitation); err != nil {
+		logger.Error("error creating invitation", slog.Any("error", err))
+		return nil, err
+	}
+
+	link := i.acceptURL + "?token=" + url.QueryEscape(rawToken)
+	message := &domain.Email{
+		To:      req.Email,
+		Subject: "Vida Plus - Convite para criar sua conta",
+		Body: fmt.Sprintf("Olá,\n\nVocê foi convidado para criar uma conta de %s na Vida Plus. "+
+			"Use o link abaixo em até %d dias para definir sua senha e completar seu perfil:\n\n%s\n\n"+
+			"Se você não esperava este convite, ignore este email.\n",
+			invitationTypeNames[req.Type], int(invitationTTL.Hours()/24), link),
+	}
+	if err := i.mailer.Send(ctx, message); err != nil {
+		logger.Error("error sending invitation email", slog.Any("error", err))
+		return nil, domain.NewInternalError("error sending invitation email")
+	}
+
+	recordAudit(ctx, i.audit, logger, &domain.AuditEvent{
+		ID:        pkg.GenerateID(),
+		Action:    domain.AuditActionUserInvited,
+		ActorID:   invitedBy,
+		Details:   map[string]string{"email": req.Email, "type": string(req.Type)},
+		CreatedAt: now,
+	})
+
+	logger.Info("invitation sent")
+	return invitation, nil
+}
+
+// Accept creates the account of an invitation. The email is verified since the link was sent to it.
+func (i *InvitationServiceImpl) Accept(ctx context.Context, req domain.AcceptInvitationRequest) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "InvitationService"),
+		slog.String("method", "Accept"),
+	)
+
+	now := time.Now()
+	tokenHash := pkg.HashToken(req.Token)
+
+	// The profile is checked against the invited type before the invitation is used up, so
+	// a missing CRM or COREN can be fixed and sent again
+	pending, err := i.invitations.GetPending(ctx, tokenHash, now)
+	if err != nil {
+		logger.Error("error fetching invitation", slog.Any("error", err))
+		return nil, err
+	}
+	if pending == nil {
+		logger.Info("invalid, expired or accepted invitation")
+		return nil, domain.NewBadRequestError("invalid or expired invitation")
+	}
+	profile := req.Profile
+	if err := profile.Normalize(pending.Type); err != nil {
+		logger.Info("invalid profile", slog.Any("error", err))
+		return nil, err
+	}
+
+	invitation, err := i.invitations.Consume(ctx, tokenHash, now)
+	if err != nil {
+		logger.Error("error consuming invitation", slog.Any("error", err))
+		return nil, err
+	}
+	if invitation == nil {
+		logger.Info("invalid, expired or accepted invitation")
+		return nil, domain.NewBadRequestError("invalid or expired invitation")
+	}
+	logger = logger.With(slog.String("email", invitation.Email), slog.String("type", string(invitation.Type)))
+
+	// The email may have been registered after the invitation was sent
+	existing, err := i.users.GetUserByEmail(ctx, invitation.Email)
+	if err != nil {
+		logger.Error("error checking existing user", slog.Any("error", err))
+		return nil, domain.NewInternalError("error checking user existence")
+	}
+	if existing != nil {
+		logger.Info("invitation accepted for existing user")
+		return nil, domain.NewConflictError("user already exists")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("error hashing password", slog.Any("error", err))
+		return nil, domain.NewInternalError("error processing password")
+	}
+
+	user := &domain.User{
+		ID:              pkg.GenerateID(),
+		Email:           invitation.Email,
+		Password:        string(hashedPassword),
+		Type:            invitation.Type,
+		Status:          domain.UserStatusActive,
+		Profile:         profile,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		EmailVerifiedAt: &now,
+	}
+	if err := i.users.CreateUser(ctx, user); err != nil {
+		logger.Error("error creating user", slog.Any("error", err))
+		return nil, err
+	}
+
+	recordAudit(ctx, i.audit, logger, &domain.AuditEvent{
+		ID:        pkg.GenerateID(),
+		Action:    domain.AuditActionInviteAccepted,
+		ActorID:   user.ID,
+		TargetID:  user.ID,
+		Details:   map[string]string{"invited_by": invitation.InvitedBy, "type": string(user.Type)},
+		CreatedAt: now,
+	})
+
+	logger.Info("invitation accepted", slog.String("userID", user.ID))
+	return user, nil
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+)
+
+// lockoutReason is the status reason of accounts blocked by the login throttle.
+const lockoutReason = "too many failed login attempts"
+
+// LoginThrottleConfig controls how failed logins are delayed and when accounts are blocked.
+type LoginThrottleConfig struct {
+	// AccountFreeAttempts and IPFreeAttempts are the failures allowed before delays start.
+	AccountFreeAttempts int
+	IPFreeAttempts      int
+	// BaseDelay doubles for every failure past the free attempts, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// BlockThreshold is the number of failures that blocks the account until an admin unlocks it.
+	BlockThreshold int
+	// Window is how long failures are remembered after the last one.
+	Window time.Duration
+}
+
+// DefaultLoginThrottleConfig returns the configuration used in production.
+func DefaultLoginThrottleConfig() LoginThrottleConfig {
+	return LoginThrottleConfig{
+		AccountFreeAttempts: 3,
+		IPFreeAttempts:      20,
+		BaseDelay:           time.Second,
+		MaxDelay:            15 * time.Minute,
+		BlockThreshold:      10,
+		Window:              24 * time.Hour,
+	}
+}
+
+// LoginThrottleServiceImpl implements LoginThrottle interface.
+type LoginThrottleServiceImpl struct {
+	attempts domain.LoginAttemptStore
+	users    domain.UserStore
+	audit    domain.AuditRepository
+	config   LoginThrottleConfig
+	now      func() time.Time
+}
+
+func NewLoginThrottleService(attempts domain.LoginAttemptStore, users domain.UserStore, audit domain.AuditRepository,
+	config LoginThrottleConfig) domain.LoginThrottle {
+	return &LoginThrottleServiceImpl{
+		attempts: attempts,
+		users:    users,
+		audit:    audit,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+func accountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+func challengeKey(challengeID string) string {
+	return "mfa:" + challengeID
+}
+
+// Check rejects the login while the account or the client IP is serving a backoff delay.
+func (l *LoginThrottleServiceImpl) Check(ctx context.Context, email, ip string) error {
+	logger := slog.With(
+		slog.String("service", "LoginThrottleService"),
+		slog.String("method", "Check"),
+		slog.String("email", email),
+		slog.String("ip", ip),
+	)
+
+	type throttleKey struct {
+		key  string
+		free int
+	}
+	keys := []throttleKey{{accountKey(email), l.config.AccountFreeAttempts}}
+	if ip != "" {
+		keys = append(keys, throttleKey{ipKey(ip), l.config.IPFreeAttempts})
+	}
+
+	now := l.now()
+	for _, check := range keys {
+		attempts, err := l.attempts.Get(ctx, check.key)
+		if err != nil {
+			logger.Error("error fetching login attempts", slog.Any("error", err))
+			return domain.NewInternalError("error processing login")
+		}
+		if attempts == nil {
+			continue
+		}
+
+		if until := l.lockedUntil(attempts, check.free); now.Before(until) {
+			retryAfter := until.Sub(now).Round(time.Second)
+			if retryAfter < time.Second {
+				retryAfter = time.Second
+			}
+			logger.Info("login throttled", slog.String("key", check.key), slog.Int("failures", attempts.Failures))
+			return domain.NewTooManyRequestsError(fmt.Sprintf("too many failed login attempts, try again in %s", retryAfter))
+		}
+	}
+
+	return nil
+}
+
+// RecordFailure counts a failed login for the account and the client IP. user is nil when
+// the email does not belong to any account.
+func (l *LoginThrottleServiceImpl) RecordFailure(ctx context.Context, email, ip string, user *domain.User) error {
+	logger := slog.With(
+		slog.String("service", "LoginThrottleService"),
+		slog.String("method", "RecordFailure"),
+		slog.String("email", email),
+		slog.String("ip", ip),
+	)
+
+	now := l.now()
+	expiresAt := now.Add(l.config.Window)
+
+	account, err := l.attempts.RegisterFailure(ctx, accountKey(email), now, expiresAt)
+	if err != nil {
+		logger.Error("error registering account failure", slog.Any("error", err))
+		return err
+	}
+	if account.Failures == l.config.AccountFreeAttempts {
+		l.record(ctx, logger, domain.AuditActionLoginLocked, domain.SystemActor, userID(user), ip, map[string]string{
+			"key":      accountKey(email),
+			"failures": fmt.Sprint(account.Failures),
+		})
+	}
+
+	if ip != "" {
+		byIP, err := l.attempts.RegisterFailure(ctx, ipKey(ip), now, expiresAt)
+		if err != nil {
+			logger.Error("error registering ip failure", slog.Any("error", err))
+			return err
+		}
+		if byIP.Failures == l.config.IPFreeAttempts {
+			l.record(ctx, logger, domain.AuditActionLoginLocked, domain.SystemActor, "", ip, map[string]string{
+				"key":      ipKey(ip),
+				"failures": fmt.Sprint(byIP.Failures),
+			})
+		}
+	}
+
+	if user == nil || !user.IsActive() || account.Failures < l.config.BlockThreshold {
+		return nil
+	}
+
+	if _, err := l.users.UpdateStatus(ctx, user.ID, domain.UserStatusBlocked, lockoutReason, domain.SystemActor); err != nil {
+		logger.Error("error blocking account", slog.Any("error", err))
+		return err
+	}
+	l.record(ctx, logger, domain.AuditActionAccountLocked, domain.SystemActor, user.ID, ip, map[string]string{
+		"failures": fmt.Sprint(account.Failures),
+	})
+
+	logger.Warn("account blocked after repeated login failures", slog.String("userID", user.ID))
+	return nil
+}
+
+// RecordSuccess clears the account counter. The IP counter is kept so a client cannot reset
+// it by logging into an account it controls.
+func (l *LoginThrottleServiceImpl) RecordSuccess(ctx context.Context, email string) error {
+	return l.attempts.Reset(ctx, accountKey(email))
+}
+
+// RecordChallengeFailure counts a wrong MFA code separately from the account, so that a
+// challenge can be given up after a few codes even when the account isn't throttled yet.
+func (l *LoginThrottleServiceImpl) RecordChallengeFailure(ctx context.Context, challengeID string, expiresAt time.Time) (int, error) {
+	logger := slog.With(
+		slog.String("service", "LoginThrottleService"),
+		slog.String("method", "RecordChallengeFailure"),
+	)
+
+	attempts, err := l.attempts.RegisterFailure(ctx, challengeKey(challengeID), l.now(), expiresAt)
+	if err != nil {
+		logger.Error("error registering mfa challenge failure", slog.Any("error", err))
+		return 0, err
+	}
+	return attempts.Failures, nil
+}
+
+// Unlock clears the failure counter of an account and reactivates it when the throttle blocked it.
+func (l *LoginThrottleServiceImpl) Unlock(ctx context.Context, userID, adminID string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "LoginThrottleService"),
+		slog.String("method", "Unlock"),
+		slog.String("userID", userID),
+		slog.String("adminID", adminID),
+	)
+
+	user, err := l.users.GetByID(ctx, userID)
+	if err != nil {
+		logger.Error("error fetching user", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.NewNotFoundError("user not found")
+	}
+
+	if err := l.attempts.Reset(ctx, accountKey(user.Email)); err != nil {
+		logger.Error("error resetting login attempts", slog.Any("error", err))
+		return nil, err
+	}
+
+	if user.Status == domain.UserStatusBlocked {
+		if user, err = l.users.UpdateStatus(ctx, userID, domain.UserStatusActive, "unlocked by admin", adminID); err != nil {
+			logger.Error("error reactivating account", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	l.record(ctx, logger, domain.AuditActionAccountUnlocked, adminID, user.ID, "", nil)
+
+	logger.Info("account unlocked")
+	return user, nil
+}
+
+// delay returns the backoff owed after the given number of failures.
+func (l *LoginThrottleServiceImpl) delay(failures, free int) time.Duration {
+	if failures < free {
+		return 0
+	}
+
+	delay := l.config.BaseDelay
+	for i := free; i < failures && delay < l.config.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, l.config.MaxDelay)
+}
+
+func (l *LoginThrottleServiceImpl) lockedUntil(attempts *domain.LoginAttempts, free int) time.Time {
+	return attempts.LastFailureAt.Add(l.delay(attempts.Failures, free))
+}
+
+// record writes an audit event. Failures are logged only, so the audit trail never blocks logins.
+func (l *LoginThrottleServiceImpl) record(ctx context.Context, logger *slog.Logger, action domain.AuditAction,
+	actorID, targetID, ip string, details map[string]string) {
+	recordAudit(ctx, l.audit, logger, &domain.AuditEvent{
+		ID:        pkg.GenerateID(),
+		Action:    action,
+		ActorID:   actorID,
+		TargetID:  targetID,
+		IP:        ip,
+		Details:   details,
+		CreatedAt: l.now(),
+	})
+}
+
+func userID(user *domain.User) string {
+	if user == nil {
+		return ""
+	}
+	return user.ID
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/internal/repository"
+	mocks "github.com/vida-plus/api/mocks"
+)
+
+func newTestLoginThrottle(t *testing.T, users domain.UserStore) (*LoginThrottleServiceImpl, *time.Time) {
+	audit := mocks.NewAuditRepositoryMock(t)
+	audit.EXPECT().Record(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	now := time.Now()
+	throttle := NewLoginThrottleService(repository.NewMemoryLoginAttemptStore(), users, audit,
+		DefaultLoginThrottleConfig()).(*LoginThrottleServiceImpl)
+	throttle.now = func() time.Time { return now }
+	return throttle, &now
+}
+
+func Test_LoginThrottle_delay(t *testing.T) {
+	throttle, _ := newTestLoginThrottle(t, nil)
+
+	tests := []struct {
+		name     string
+		failures int
+		want     time.Duration
+	}{
+		{"FREE ATTEMPTS", 2, 0},
+		{"FIRST DELAY", 3, time.Second},
+		{"DOUBLES", 5, 4 * time.Second},
+		{"CAPPED", 40, 15 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equalf(t, tt.want, throttle.delay(tt.failures, 3), "delay(%v, 3)", tt.failures)
+		})
+	}
+}
+
+func Test_LoginThrottle_Check(t *testing.T) {
+	ctx := context.Background()
+	throttle, now := newTestLoginThrottle(t, nil)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, throttle.Check(ctx, "user@test.com", "10.0.0.1"))
+		require.NoError(t, throttle.RecordFailure(ctx, "user@test.com", "10.0.0.1", nil))
+	}
+
+	err := throttle.Check(ctx, "USER@test.com", "10.0.0.2")
+	var apiErr *domain.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.Status)
+
+	*now = now.Add(time.Second)
+	assert.NoError(t, throttle.Check(ctx, "user@test.com", "10.0.0.1"))
+}
+
+func Test_LoginThrottle_RecordFailure_blocksAccount(t *testing.T) {
+	ctx := context.Background()
+	user := &domain.User{ID: "user-1", Email: "user@test.com", Status: domain.UserStatusActive}
+
+	users := mocks.NewUserStoreMock(t)
+	users.EXPECT().UpdateStatus(ctx, user.ID, domain.UserStatusBlocked, lockoutReason, domain.SystemActor).
+		Return(&domain.User{ID: user.ID, Status: domain.UserStatusBlocked}, nil).Once()
+
+	throttle, now := newTestLoginThrottle(t, users)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, throttle.Check(ctx, user.Email, "10.0.0.1"))
+		require.NoError(t, throttle.RecordFailure(ctx, user.Email, "10.0.0.1", user))
+		*now = now.Add(15 * time.Minute)
+	}
+}
+
+func Test_LoginThrottle_RecordChallengeFailure(t *testing.T) {
+	ctx := context.Background()
+	throttle, now := newTestLoginThrottle(t, nil)
+
+	for want := 1; want <= 3; want++ {
+		failures, err := throttle.RecordChallengeFailure(ctx, "challenge-1", now.Add(mfaChallengeTTL))
+		require.NoError(t, err)
+		assert.Equal(t, want, failures)
+	}
+
+	// Each challenge and the account keep their own counters
+	failures, err := throttle.RecordChallengeFailure(ctx, "challenge-2", now.Add(mfaChallengeTTL))
+	require.NoError(t, err)
+	assert.Equal(t, 1, failures)
+	assert.NoError(t, throttle.Check(ctx, "user@test.com", ""))
+}
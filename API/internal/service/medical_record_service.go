@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+)
+
+// MedicalRecordServiceImpl implements MedicalRecordService interface. Access is checked here
+// rather than only by the routes, so every caller gets the same view of a record.
+type MedicalRecordServiceImpl struct {
+	records      domain.MedicalRecordRepository
+	appointments domain.AppointmentRepository
+	users        domain.UserRepository
+	now          func() time.Time
+}
+
+// NewMedicalRecordService creates a new MedicalRecordService
+func NewMedicalRecordService(records domain.MedicalRecordRepository, appointments domain.AppointmentRepository,
+	users domain.UserRepository) domain.MedicalRecordService {
+	return &MedicalRecordServiceImpl{
+		records:      records,
+		appointments: appointments,
+		users:        users,
+		now:          time.Now,
+	}
+}
+
+// canSee reports whether an entry is part of what access shows
+func canSee(access domain.RecordAccess, entry *domain.RecordEntry) bool {
+	switch access {
+	case domain.RecordAccessFull:
+		return true
+	case domain.RecordAccessBasic:
+		return entry.Status == domain.RecordEntryFinal && entry.IsBasic()
+	case domain.RecordAccessOwn:
+		return entry.Status == domain.RecordEntryFinal
+	default:
+		return false
+	}
+}
+
+// project returns entry as shown to a user with access
+func project(access domain.RecordAccess, entry *domain.RecordEntry) *domain.RecordEntry {
+	if access == domain.RecordAccessBasic {
+		return entry.Basic()
+	}
+	return entry
+}
+
+func (s *MedicalRecordServiceImpl) GetRecord(ctx context.Context, patientID string, actor *domain.AuthClaims) (*domain.MedicalRecord, error) {
+	logger := slog.With(
+		slog.String("service", "MedicalRecordService"),
+		slog.String("method", "GetRecord"),
+		slog.String("patientID", patientID),
+		slog.String("actorID", actor.UserID),
+	)
+
+	access, err := authorizeRecord(ctx, s.users, patientID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	// The overview only has final entries, which every access sees
+	entries, err := s.records.ListAll(ctx, domain.RecordEntryQuery{
+		PatientID: patientID,
+		Kinds:     []domain.RecordEntryKind{domain.RecordEntryAllergy, domain.RecordEntryMedication, domain.RecordEntryDiagnosis},
+		Status:    domain.RecordEntryFinal,
+	})
+	if err != nil {
+		logger.Error("error listing record entries", slog.Any("error", err))
+		return nil, err
+	}
+
+	record := &domain.MedicalRecord{
+		PatientID:   patientID,
+		Allergies:   []*domain.RecordEntry{},
+		Medications: []*domain.RecordEntry{},
+		Diagnoses:   []*domain.RecordEntry{},
+	}
+	for _, entry := range entries {
+		entry = project(access, entry)
+		switch entry.Kind {
+		case domain.RecordEntryAllergy:
+			record.Allergies = append(record.Allergies, entry)
+		case domain.RecordEntryMedication:
+			record.Medications = append(record.Medications, entry)
+		case domain.RecordEntryDiagnosis:
+			record.Diagnoses = append(record.Diagnoses, entry)
+		}
+	}
+
+	return record, nil
+}
+
+func (s *MedicalRecordServiceImpl) ListEntries(ctx context.Context, patientID string, filter domain.RecordEntryFilter,
+	query domain.ListQuery, actor *domain.AuthClaims) (*domain.RecordEntryPage, error) {
+	logger := slog.With(
+		slog.String("service", "MedicalRecordService"),
+		slog.String("method", "ListEntries"),
+		slog.String("patientID", patientID),
+		slog.String("actorID", actor.UserID),
+	)
+
+	page, err := query.PageRequest(domain.RecordEntrySortFields, domain.SortOrder{Field: "created_at", Desc: true})
+	if err != nil {
+		logger.Info("invalid list query", slog.Any("error", err))
+		return nil, err
+	}
+
+	access, err := authorizeRecord(ctx, s.users, patientID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	entryQuery := domain.RecordEntryQuery{PatientID: patientID, Status: filter.Status, EncounterID: filter.EncounterID}
+	if filter.Kind != "" {
+		entryQuery.Kinds = []domain.RecordEntryKind{filter.Kind}
+	}
+	// Narrow the query down to what access shows, so pages are never short of hidden entries
+	if access != domain.RecordAccessFull {
+		if filter.Status == domain.RecordEntryDraft {
+			return nil, domain.NewForbiddenError("drafts are only seen by doctors")
+		}
+		entryQuery.Status = domain.RecordEntryFinal
+	}
+	if access == domain.RecordAccessBasic {
+		if filter.Kind != "" && !slices.Contains(domain.BasicRecordEntryKinds, filter.Kind) {
+			return nil, domain.NewForbiddenError(string(filter.Kind) + " entries are only seen by doctors and the patient")
+		}
+		if filter.Kind == "" {
+			entryQuery.Kinds = domain.BasicRecordEntryKinds
+		}
+	}
+
+	entries, err := s.records.List(ctx, entryQuery, page)
+	if err != nil {
+		logger.Error("error listing record entries", slog.Any("error", err))
+		return nil, err
+	}
+	for i, entry := range entries.Items {
+		entries.Items[i] = project(access, entry)
+	}
+
+	return entries, nil
+}
+
+func (s *MedicalRecordServiceImpl) GetEntry(ctx context.Context, patientID, id string, actor *domain.AuthClaims) (*domain.RecordEntry, error) {
+	logger := slog.With(
+		slog.String("service", "MedicalRecordService"),
+		slog.String("method", "GetEntry"),
+		slog.String("patientID", patientID),
+		slog.String("entryID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	access, err := authorizeRecord(ctx, s.users, patientID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.records.GetByID(ctx, patientID, id)
+	if err != nil {
+		logger.Error("error fetching record entry", slog.Any("error", err))
+		return nil, err
+	}
+	// Hidden entries are reported as not found, so their IDs can't be probed
+	if entry == nil || !canSee(access, entry) {
+		logger.Info("record entry not found or not visible")
+		return nil, domain.NewNotFoundError("record entry not found")
+	}
+
+	return project(access, entry), nil
+}
+
+func (s *MedicalRecordServiceImpl) CreateEntry(ctx context.Context, patientID string, req domain.CreateRecordEntryRequest,
+	actor *domain.AuthClaims) (*domain.RecordEntry, error) {
+	logger := slog.With(
+		slog.String("service", "MedicalRecordService"),
+		slog.String("method", "CreateEntry"),
+		slog.String("patientID", patientID),
+		slog.String("kind", string(req.Kind)),
+		slog.String("actorID", actor.UserID),
+	)
+
+	if err := authorizeRecordWrite(ctx, s.users, patientID, actor); err != nil {
+		logger.Info("not allowed to write the record", slog.Any("error", err))
+		return nil, err
+	}
+	if err := s.checkContent(ctx, patientID, req.Kind, req.EncounterID, req.RecordContent); err != nil {
+		logger.Info("invalid record entry", slog.Any("error", err))
+		return nil, err
+	}
+
+	now := s.now()
+	entry := &domain.RecordEntry{
+		ID:            pkg.GenerateID(),
+		PatientID:     patientID,
+		Kind:          req.Kind,
+		Status:        domain.RecordEntryDraft,
+		EncounterID:   req.EncounterID,
+		RecordContent: req.RecordContent,
+		AuthorID:      actor.UserID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if req.Finalize {
+		entry.Status = domain.RecordEntryFinal
+		entry.FinalizedAt = &now
+	}
+	if err := s.records.Create(ctx, entry); err != nil {
+		logger.Error("error creating record entry", slog.Any("error", err))
+		return nil, err
+	}
+
+	logger.Info("record entry created", slog.String("entryID", entry.ID), slog.String("status", string(entry.Status)))
+	return entry, nil
+}
+
+func (s *MedicalRecordServiceImpl) UpdateEntry(ctx context.Context, patientID, id string, req domain.UpdateRecordEntryRequest,
+	actor *domain.AuthClaims) (*domain.RecordEntry, error) {
+	logger := slog.With(
+		slog.String("service", "MedicalRecordService"),
+		slog.String("method", "UpdateEntry"),
+		slog.String("patientID", patientID),
+		slog.String("entryID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	current, err := s.getDraft(ctx, patientID, id, actor)
+	if err != nil {
+		logger.Info("draft can't be changed", slog.Any("error", err))
+		return nil, err
+	}
+	if err := s.checkContent(ctx, patientID, current.Kind, req.EncounterID, req.RecordContent); err != nil {
+		logger.Info("invalid record entry", slog.Any("error", err))
+		return nil, err
+	}
+
+	updated := *current
+	updated.EncounterID = req.EncounterID
+	updated.RecordContent = req.RecordContent
+	updated.UpdatedAt = s.now()
+	entry, err := s.records.UpdateDraft(ctx, &updated)
+	if err != nil {
+		logger.Error("error updating record entry", slog.Any("error", err))
+		return nil, err
+	}
+	if entry == nil {
+		logger.Info("record entry finalized while updating")
+		return nil, domain.NewConflictError("record entry is final and can't change")
+	}
+
+	logger.Info("record entry updated")
+	return entry, nil
+}
+
+func (s *MedicalRecordServiceImpl) FinalizeEntry(ctx context.Context, patientID, id string, actor *domain.AuthClaims) (*domain.RecordEntry, error) {
+	logger := slog.With(
+		slog.String("service", "MedicalRecordService"),
+		slog.String("method", "FinalizeEntry"),
+		slog.String("patientID", patientID),
+		slog.String("entryID", id),
+		slog.String("actorID", actor.UserID),
+	)
+
+	if _, err := s.getDraft(ctx, patientID, id, actor); err != nil {
+		logger.Info("draft can't be finalized", slog.Any("error", err))
+		return nil, err
+	}
+
+	entry, err := s.records.Finalize(ctx, patientID, id, s.now())
+	if err != nil {
+		logger.Error("error finalizing record entry", slog.Any("error", err))
+		return nil, err
+	}
+	if entry == nil {
+		logger.Info("record entry finalized meanwhile")
+		return nil, domain.NewConflictError("record entry is already final")
+	}
+
+	logger.Info("record entry finalized")
+	return entry, nil
+}
+
+// authorizeRecord returns the access of actor to the record of a patient. Patients asking for the
+// record of someone else are told it doesn't exist.
+func authorizeRecord(ctx context.Context, users domain.UserRepository, patientID string, actor *domain.AuthClaims) (domain.RecordAccess, error) {
+	access := domain.RecordAccessOf(actor.UserType)
+	switch {
+	case access == domain.RecordAccessNone:
+		return access, domain.NewForbiddenError("not allowed to see medical records")
+	case access == domain.RecordAccessOwn && patientID != actor.UserID:
+		return access, domain.NewNotFoundError("patient not found")
+	}
+
+	if err := checkPatient(ctx, users, patientID); err != nil {
+		return access, err
+	}
+	return access, nil
+}
+
+// authorizeRecordWrite checks that actor may write the record of a patient. Only doctors write records.
+func authorizeRecordWrite(ctx context.Context, users domain.UserRepository, patientID string, actor *domain.AuthClaims) error {
+	if actor.UserType != domain.UserTypeDoctor {
+		return domain.NewForbiddenError("only doctors can write medical records")
+	}
+	return checkPatient(ctx, users, patientID)
+}
+
+// checkPatient checks that patientID is a patient that wasn't deleted
+func checkPatient(ctx context.Context, users domain.UserRepository, patientID string) error {
+	user, err := users.GetByID(ctx, patientID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.IsDeleted() || user.Type != domain.UserTypePatient {
+		return domain.NewNotFoundError("patient not found")
+	}
+	return nil
+}
+
+// getDraft returns a draft of actor that can still change
+func (s *MedicalRecordServiceImpl) getDraft(ctx context.Context, patientID, id string, actor *domain.AuthClaims) (*domain.RecordEntry, error) {
+	if err := authorizeRecordWrite(ctx, s.users, patientID, actor); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.records.GetByID(ctx, patientID, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, domain.NewNotFoundError("record entry not found")
+	}
+	if entry.AuthorID != actor.UserID {
+		return nil, domain.NewForbiddenError("only the author of a draft can change it")
+	}
+	if entry.Kind == domain.RecordEntryNote {
+		return nil, domain.NewConflictError("note entries are moved to clinical notes and can't change")
+	}
+	if entry.Status != domain.RecordEntryDraft {
+		return nil, domain.NewConflictError("record entry is final and can't change")
+	}
+	return entry, nil
+}
+
+// checkContent checks that the details of an entry match its kind and that the encounter and
+// appointment they refer to belong to the patient
+func (s *MedicalRecordServiceImpl) checkContent(ctx context.Context, patientID string, kind domain.RecordEntryKind,
+	encounterID string, content domain.RecordContent) error {
+	if err := content.CheckKind(kind); err != nil {
+		return err
+	}
+
+	if encounterID != "" && kind == domain.RecordEntryEncounter {
+		return domain.NewBadRequestError("an encounter can't be recorded in another encounter")
+	}
+	if err := checkEncounter(ctx, s.records, patientID, encounterID); err != nil {
+		return err
+	}
+
+	if content.Encounter != nil && content.Encounter.AppointmentID != "" {
+		appointment, err := s.appointments.GetByID(ctx, content.Encounter.AppointmentID)
+		if err != nil {
+			return err
+		}
+		if appointment == nil || appointment.PatientID != patientID {
+			return domain.NewBadRequestError("appointment not found")
+		}
+	}
+
+	return nil
+}
+
+// checkEncounter checks that encounterID, if set, is an encounter of the record of a patient
+func checkEncounter(ctx context.Context, records domain.MedicalRecordRepository, patientID, encounterID string) error {
+	if encounterID == "" {
+		return nil
+	}
+	encounter, err := records.GetByID(ctx, patientID, encounterID)
+	if err != nil {
+		return err
+	}
+	if encounter == nil || encounter.Kind != domain.RecordEntryEncounter {
+		return domain.NewBadRequestError("encounter not found")
+	}
+	return nil
+}
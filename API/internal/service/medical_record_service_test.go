@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	mocks "github.com/vida-plus/api/mocks"
+)
+
+var (
+	testDoctor = &domain.AuthClaims{UserID: "doctor-1", UserType: domain.UserTypeDoctor}
+	testNurse  = &domain.AuthClaims{UserID: "nurse-1", UserType: domain.UserTypeNurse}
+)
+
+func newTestMedicalRecordService(t *testing.T) (*MedicalRecordServiceImpl, *mocks.MedicalRecordRepositoryMock,
+	*mocks.AppointmentRepositoryMock, *mocks.UserRepositoryMock, time.Time) {
+	records := mocks.NewMedicalRecordRepositoryMock(t)
+	appointments := mocks.NewAppointmentRepositoryMock(t)
+	users := mocks.NewUserRepositoryMock(t)
+
+	now := time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC)
+	s := NewMedicalRecordService(records, appointments, users).(*MedicalRecordServiceImpl)
+	s.now = func() time.Time { return now }
+	return s, records, appointments, users, now
+}
+
+// expectPatient makes patient-1 an existing patient
+func expectPatient(ctx context.Context, users *mocks.UserRepositoryMock) {
+	users.EXPECT().GetByID(ctx, "patient-1").
+		Return(&domain.User{ID: "patient-1", Type: domain.UserTypePatient, Status: domain.UserStatusActive}, nil)
+}
+
+func Test_MedicalRecordService_ListEntries(t *testing.T) {
+	ctx := context.Background()
+	page := domain.PageRequest{Limit: domain.DefaultPageLimit, Sort: domain.SortOrder{Field: "created_at", Desc: true}}
+	diagnosis := &domain.RecordEntry{
+		ID:            "entry-1",
+		PatientID:     "patient-1",
+		Kind:          domain.RecordEntryDiagnosis,
+		Status:        domain.RecordEntryFinal,
+		RecordContent: domain.RecordContent{Diagnosis: &domain.Diagnosis{Description: "Asma", Notes: "Piora no inverno"}},
+	}
+
+	t.Run("DOCTOR SEES EVERY ENTRY", func(t *testing.T) {
+		s, records, _, users, _ := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+		records.EXPECT().List(ctx, domain.RecordEntryQuery{PatientID: "patient-1"}, page).
+			Return(&domain.RecordEntryPage{Items: []*domain.RecordEntry{diagnosis}}, nil)
+
+		entries, err := s.ListEntries(ctx, "patient-1", domain.RecordEntryFilter{}, domain.ListQuery{}, testDoctor)
+		require.NoError(t, err)
+		assert.Equal(t, "Piora no inverno", entries.Items[0].Diagnosis.Notes)
+	})
+
+	t.Run("NURSE GETS THE BASIC VIEW", func(t *testing.T) {
+		s, records, _, users, _ := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+		records.EXPECT().List(ctx, domain.RecordEntryQuery{
+			PatientID: "patient-1",
+			Kinds:     domain.BasicRecordEntryKinds,
+			Status:    domain.RecordEntryFinal,
+		}, page).Return(&domain.RecordEntryPage{Items: []*domain.RecordEntry{diagnosis}}, nil)
+
+		entries, err := s.ListEntries(ctx, "patient-1", domain.RecordEntryFilter{}, domain.ListQuery{}, testNurse)
+		require.NoError(t, err)
+		assert.Equal(t, "Asma", entries.Items[0].Diagnosis.Description)
+		assert.Empty(t, entries.Items[0].Diagnosis.Notes)
+	})
+
+	t.Run("NURSE ASKING FOR NOTES", func(t *testing.T) {
+		s, _, _, users, _ := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+
+		_, err := s.ListEntries(ctx, "patient-1", domain.RecordEntryFilter{Kind: domain.RecordEntryNote}, domain.ListQuery{}, testNurse)
+		requireStatus(t, err, http.StatusForbidden)
+	})
+
+	t.Run("NURSE ASKING FOR DRAFTS", func(t *testing.T) {
+		s, _, _, users, _ := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+
+		_, err := s.ListEntries(ctx, "patient-1", domain.RecordEntryFilter{Status: domain.RecordEntryDraft}, domain.ListQuery{}, testNurse)
+		requireStatus(t, err, http.StatusForbidden)
+	})
+
+	t.Run("PATIENT SEES OWN FINAL ENTRIES", func(t *testing.T) {
+		s, records, _, users, _ := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+		records.EXPECT().List(ctx, domain.RecordEntryQuery{PatientID: "patient-1", Status: domain.RecordEntryFinal}, page).
+			Return(&domain.RecordEntryPage{Items: []*domain.RecordEntry{diagnosis}}, nil)
+
+		entries, err := s.ListEntries(ctx, "patient-1", domain.RecordEntryFilter{}, domain.ListQuery{}, testPatient)
+		require.NoError(t, err)
+		assert.Equal(t, "Piora no inverno", entries.Items[0].Diagnosis.Notes)
+	})
+
+	t.Run("PATIENT ASKING FOR DRAFTS", func(t *testing.T) {
+		s, _, _, users, _ := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+
+		_, err := s.ListEntries(ctx, "patient-1", domain.RecordEntryFilter{Status: domain.RecordEntryDraft}, domain.ListQuery{}, testPatient)
+		requireStatus(t, err, http.StatusForbidden)
+	})
+
+	t.Run("PATIENT ASKING FOR ANOTHER RECORD", func(t *testing.T) {
+		s, _, _, _, _ := newTestMedicalRecordService(t)
+
+		_, err := s.ListEntries(ctx, "patient-2", domain.RecordEntryFilter{}, domain.ListQuery{}, testPatient)
+		requireStatus(t, err, http.StatusNotFound)
+	})
+
+	t.Run("RECEPTIONIST", func(t *testing.T) {
+		s, _, _, _, _ := newTestMedicalRecordService(t)
+
+		_, err := s.ListEntries(ctx, "patient-1", domain.RecordEntryFilter{}, domain.ListQuery{}, testReceptionist)
+		requireStatus(t, err, http.StatusForbidden)
+	})
+}
+
+func Test_MedicalRecordService_GetEntry(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		entry   *domain.RecordEntry
+		actor   *domain.AuthClaims
+		wantErr int
+	}{
+		{"DOCTOR SEES DRAFTS", &domain.RecordEntry{Kind: domain.RecordEntryAllergy, Status: domain.RecordEntryDraft}, testDoctor, 0},
+		{"PATIENT SEES FINAL ENTRIES", &domain.RecordEntry{Kind: domain.RecordEntryAllergy, Status: domain.RecordEntryFinal}, testPatient, 0},
+		{"PATIENT DOESN'T SEE DRAFTS", &domain.RecordEntry{Kind: domain.RecordEntryAllergy, Status: domain.RecordEntryDraft}, testPatient,
+			http.StatusNotFound},
+		{"NURSE DOESN'T SEE DRAFTS", &domain.RecordEntry{Kind: domain.RecordEntryAllergy, Status: domain.RecordEntryDraft}, testNurse,
+			http.StatusNotFound},
+		{"PATIENT SEES FINAL NOTES", &domain.RecordEntry{Kind: domain.RecordEntryNote, Status: domain.RecordEntryFinal}, testPatient, 0},
+		{"NURSE DOESN'T SEE NOTES", &domain.RecordEntry{Kind: domain.RecordEntryNote, Status: domain.RecordEntryFinal}, testNurse,
+			http.StatusNotFound},
+		{"MISSING", nil, testDoctor, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, records, _, users, _ := newTestMedicalRecordService(t)
+			expectPatient(ctx, users)
+			records.EXPECT().GetByID(ctx, "patient-1", "entry-1").Return(tt.entry, nil)
+
+			entry, err := s.GetEntry(ctx, "patient-1", "entry-1", tt.actor)
+			if tt.wantErr != 0 {
+				requireStatus(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.entry, entry)
+		})
+	}
+}
+
+func Test_MedicalRecordService_CreateEntry(t *testing.T) {
+	ctx := context.Background()
+	allergy := domain.CreateRecordEntryRequest{
+		Kind:          domain.RecordEntryAllergy,
+		RecordContent: domain.RecordContent{Allergy: &domain.Allergy{Substance: "Penicilina", Severity: domain.AllergySeveritySevere}},
+	}
+
+	t.Run("DRAFT BY DOCTOR", func(t *testing.T) {
+		s, records, _, users, now := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+		records.EXPECT().Create(ctx, mock.Anything).Return(nil)
+
+		entry, err := s.CreateEntry(ctx, "patient-1", allergy, testDoctor)
+		require.NoError(t, err)
+		assert.Equal(t, domain.RecordEntryDraft, entry.Status)
+		assert.Equal(t, testDoctor.UserID, entry.AuthorID)
+		assert.Equal(t, now, entry.CreatedAt)
+		assert.Nil(t, entry.FinalizedAt)
+	})
+
+	t.Run("FINALIZED RIGHT AWAY", func(t *testing.T) {
+		s, records, _, users, now := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+		records.EXPECT().Create(ctx, mock.Anything).Return(nil)
+
+		req := allergy
+		req.Finalize = true
+		entry, err := s.CreateEntry(ctx, "patient-1", req, testDoctor)
+		require.NoError(t, err)
+		assert.Equal(t, domain.RecordEntryFinal, entry.Status)
+		require.NotNil(t, entry.FinalizedAt)
+		assert.Equal(t, now, *entry.FinalizedAt)
+	})
+
+	t.Run("ONLY DOCTORS WRITE", func(t *testing.T) {
+		for _, actor := range []*domain.AuthClaims{testNurse, testPatient, testReceptionist} {
+			s, _, _, _, _ := newTestMedicalRecordService(t)
+
+			_, err := s.CreateEntry(ctx, "patient-1", allergy, actor)
+			requireStatus(t, err, http.StatusForbidden)
+		}
+	})
+
+	t.Run("DETAILS OF ANOTHER KIND", func(t *testing.T) {
+		s, _, _, users, _ := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+
+		req := allergy
+		req.Kind = domain.RecordEntryMedication
+		_, err := s.CreateEntry(ctx, "patient-1", req, testDoctor)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("APPOINTMENT OF ANOTHER PATIENT", func(t *testing.T) {
+		s, _, appointments, users, now := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+		appointments.EXPECT().GetByID(ctx, "appointment-1").Return(&domain.Appointment{ID: "appointment-1", PatientID: "patient-2"}, nil)
+
+		_, err := s.CreateEntry(ctx, "patient-1", domain.CreateRecordEntryRequest{
+			Kind: domain.RecordEntryEncounter,
+			RecordContent: domain.RecordContent{
+				Encounter: &domain.Encounter{AppointmentID: "appointment-1", OccurredAt: now, Reason: "Retorno"},
+			},
+		}, testDoctor)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("UNKNOWN ENCOUNTER", func(t *testing.T) {
+		s, records, _, users, _ := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+		records.EXPECT().GetByID(ctx, "patient-1", "entry-9").
+			Return(&domain.RecordEntry{ID: "entry-9", Kind: domain.RecordEntryDiagnosis}, nil)
+
+		req := allergy
+		req.EncounterID = "entry-9"
+		_, err := s.CreateEntry(ctx, "patient-1", req, testDoctor)
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+}
+
+func Test_MedicalRecordService_FinalizeEntry(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		entry   *domain.RecordEntry
+		wantErr int
+	}{
+		{"ANOTHER AUTHOR", &domain.RecordEntry{AuthorID: "doctor-2", Status: domain.RecordEntryDraft}, http.StatusForbidden},
+		{"ALREADY FINAL", &domain.RecordEntry{AuthorID: "doctor-1", Status: domain.RecordEntryFinal}, http.StatusConflict},
+		{"NOTE", &domain.RecordEntry{AuthorID: "doctor-1", Kind: domain.RecordEntryNote, Status: domain.RecordEntryDraft}, http.StatusConflict},
+		{"MISSING", nil, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, records, _, users, _ := newTestMedicalRecordService(t)
+			expectPatient(ctx, users)
+			records.EXPECT().GetByID(ctx, "patient-1", "entry-1").Return(tt.entry, nil)
+
+			_, err := s.FinalizeEntry(ctx, "patient-1", "entry-1", testDoctor)
+			requireStatus(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("FINALIZED MEANWHILE", func(t *testing.T) {
+		s, records, _, users, now := newTestMedicalRecordService(t)
+		expectPatient(ctx, users)
+		records.EXPECT().GetByID(ctx, "patient-1", "entry-1").
+			Return(&domain.RecordEntry{AuthorID: "doctor-1", Status: domain.RecordEntryDraft}, nil)
+		records.EXPECT().Finalize(ctx, "patient-1", "entry-1", now).Return(nil, nil)
+
+		_, err := s.FinalizeEntry(ctx, "patient-1", "entry-1", testDoctor)
+		requireStatus(t, err, http.StatusConflict)
+	})
+}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+	"github.com/vida-plus/api/pkg/totp"
+)
+
+const (
+	// mfaIssuer is the account issuer shown by authenticator apps.
+	mfaIssuer = "Vida Plus"
+	// recoveryCodeCount is how many single-use recovery codes an enrollment gets.
+	recoveryCodeCount = 10
+	// totpSkew is how many 30 second steps of clock drift are tolerated in each direction.
+	totpSkew = 1
+)
+
+// MFAServiceImpl implements MFAService interface.
+type MFAServiceImpl struct {
+	users       domain.UserRepository
+	enrollments domain.MFARepository
+	policies    domain.MFAPolicyRepository
+}
+
+func NewMFAService(users domain.UserRepository, enrollments domain.MFARepository, policies domain.MFAPolicyRepository) domain.MFAService {
+	return &MFAServiceImpl{users: users, enrollments: enrollments, policies: policies}
+}
+
+// Enroll starts a TOTP enrollment, replacing any unconfirmed one. MFA is only enforced
+// after the enrollment is confirmed with a code from the authenticator app.
+func (m *MFAServiceImpl) Enroll(ctx context.Context, userID string) (*domain.MFASetup, error) {
+	logger := slog.With(
+		slog.String("service", "MFAService"),
+		slog.String("method", "Enroll"),
+		slog.String("userID", userID),
+	)
+
+	user, err := m.users.GetByID(ctx, userID)
+	if err != nil {
+		logger.Error("error fetching user", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		logger.Info("user not found")
+		return nil, domain.NewNotFoundError("user not found")
+	}
+
+	existing, err := m.enrollments.GetEnrollment(ctx, userID)
+	if err != nil {
+		logger.Error("error fetching mfa enrollment", slog.Any("error", err))
+		return nil, err
+	}
+	if existing != nil && existing.IsConfirmed() {
+		logger.Info("mfa already enabled")
+		return nil, domain.NewConflictError("mfa is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		logger.Error("error generating totp secret", slog.Any("error", err))
+		return nil, domain.NewInternalError("error generating mfa secret")
+	}
+
+	codes, hashes, err := generateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		logger.Error("error generating recovery codes", slog.Any("error", err))
+		return nil, domain.NewInternalError("error generating recovery codes")
+	}
+
+	enrollment := &domain.MFAEnrollment{
+		UserID:        userID,
+		Secret:        secret,
+		RecoveryCodes: hashes,
+		CreatedAt:     time.Now(),
+	}
+	if err := m.enrollments.SaveEnrollment(ctx, enrollment); err != nil {
+		logger.Error("error saving mfa enrollment", slog.Any("error", err))
+		return nil, err
+	}
+
+	logger.Info("mfa enrollment started")
+	return &domain.MFASetup{
+		Secret:        secret,
+		URI:           totp.URI(mfaIssuer, user.Email, secret),
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// Confirm enables MFA once the user proves the authenticator app was set up.
+func (m *MFAServiceImpl) Confirm(ctx context.Context, userID, code string) error {
+	logger := slog.With(
+		slog.String("service", "MFAService"),
+		slog.String("method", "Confirm"),
+		slog.String("userID", userID),
+	)
+
+	enrollment, err := m.enrollments.GetEnrollment(ctx, userID)
+	if err != nil {
+		logger.Error("error fetching mfa enrollment", slog.Any("error", err))
+		return err
+	}
+	if enrollment == nil {
+		logger.Info("no mfa enrollment in progress")
+		return domain.NewBadRequestError("mfa enrollment has not been started")
+	}
+	if enrollment.IsConfirmed() {
+		logger.Info("mfa already enabled")
+		return domain.NewConflictError("mfa is already enabled")
+	}
+
+	if err := m.checkTOTP(ctx, enrollment, code); err != nil {
+		logger.Info("invalid code on mfa confirmation")
+		return err
+	}
+
+	if err := m.enrollments.ConfirmEnrollment(ctx, userID, time.Now()); err != nil {
+		logger.Error("error confirming mfa enrollment", slog.Any("error", err))
+		return err
+	}
+
+	logger.Info("mfa enabled")
+	return nil
+}
+
+// Disable removes the MFA enrollment of a user. A valid code is required, and users whose
+// type requires MFA can't disable it.
+func (m *MFAServiceImpl) Disable(ctx context.Context, userID, code string) error {
+	logger := slog.With(
+		slog.String("service", "MFAService"),
+		slog.String("method", "Disable"),
+		slog.String("userID", userID),
+	)
+
+	user, err := m.users.GetByID(ctx, userID)
+	if err != nil {
+		logger.Error("error fetching user", slog.Any("error", err))
+		return err
+	}
+	if user == nil {
+		logger.Info("user not found")
+		return domain.NewNotFoundError("user not found")
+	}
+
+	required, err := m.IsRequired(ctx, user.Type)
+	if err != nil {
+		return err
+	}
+	if required {
+		logger.Info("attempt to disable required mfa")
+		return domain.NewForbiddenError("mfa is required for your account type")
+	}
+
+	enabled, err := m.IsEnabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		logger.Info("mfa not enabled")
+		return domain.NewBadRequestError("mfa is not enabled")
+	}
+
+	if err := m.Verify(ctx, userID, code); err != nil {
+		return err
+	}
+
+	if err := m.enrollments.DeleteEnrollment(ctx, userID); err != nil {
+		logger.Error("error deleting mfa enrollment", slog.Any("error", err))
+		return err
+	}
+
+	logger.Info("mfa disabled")
+	return nil
+}
+
+// Verify checks a TOTP or recovery code. A valid TOTP code confirms a pending enrollment,
+// which is how users enroll when MFA is required before their first login.
+func (m *MFAServiceImpl) Verify(ctx context.Context, userID, code string) error {
+	logger := slog.With(
+		slog.String("service", "MFAService"),
+		slog.String("method", "Verify"),
+		slog.String("userID", userID),
+	)
+
+	enrollment, err := m.enrollments.GetEnrollment(ctx, userID)
+	if err != nil {
+		logger.Error("error fetching mfa enrollment", slog.Any("error", err))
+		return err
+	}
+	if enrollment == nil {
+		logger.Info("no mfa enrollment")
+		return domain.NewBadRequestError("mfa enrollment has not been started")
+	}
+
+	if isTOTPCode(code) {
+		if err := m.checkTOTP(ctx, enrollment, code); err != nil {
+			logger.Info("invalid totp code")
+			return err
+		}
+		if !enrollment.IsConfirmed() {
+			if err := m.enrollments.ConfirmEnrollment(ctx, userID, time.Now()); err != nil {
+				logger.Error("error confirming mfa enrollment", slog.Any("error", err))
+				return err
+			}
+			logger.Info("mfa enabled")
+		}
+		return nil
+	}
+
+	used, err := m.enrollments.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+	if err != nil {
+		logger.Error("error using recovery code", slog.Any("error", err))
+		return err
+	}
+	if !used {
+		logger.Info("invalid recovery code")
+		return domain.NewUnauthorizedError("invalid mfa code")
+	}
+
+	logger.Warn("recovery code used")
+	return nil
+}
+
+// IsEnabled checks if the user has a confirmed enrollment.
+func (m *MFAServiceImpl) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	enrollment, err := m.enrollments.GetEnrollment(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return enrollment != nil && enrollment.IsConfirmed(), nil
+}
+
+// IsRequired checks if the policy of the user type requires MFA.
+func (m *MFAServiceImpl) IsRequired(ctx context.Context, userType domain.UserType) (bool, error) {
+	policy, err := m.policies.GetPolicy(ctx, userType)
+	if err != nil {
+		return false, err
+	}
+	return policy != nil && policy.Required, nil
+}
+
+// GetPolicies returns the policy of every user type. Types without a stored policy don't require MFA.
+func (m *MFAServiceImpl) GetPolicies(ctx context.Context) ([]*domain.MFAPolicy, error) {
+	stored, err := m.policies.GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[domain.UserType]*domain.MFAPolicy, len(stored))
+	for _, policy := range stored {
+		byType[policy.UserType] = policy
+	}
+
+	policies := make([]*domain.MFAPolicy, 0, len(domain.UserTypes))
+	for _, userType := range domain.UserTypes {
+		policy, ok := byType[userType]
+		if !ok {
+			policy = &domain.MFAPolicy{UserType: userType}
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// SetPolicy changes whether users of a type must use MFA.
+func (m *MFAServiceImpl) SetPolicy(ctx context.Context, userType domain.UserType, required bool, adminID string) (*domain.MFAPolicy, error) {
+	logger := slog.With(
+		slog.String("service", "MFAService"),
+		slog.String("method", "SetPolicy"),
+		slog.String("userType", string(userType)),
+		slog.String("adminID", adminID),
+	)
+
+	if !userType.IsValid() {
+		logger.Info("invalid user type")
+		return nil, domain.NewBadRequestError("invalid user type")
+	}
+
+	policy := &domain.MFAPolicy{
+		UserType:  userType,
+		Required:  required,
+		UpdatedBy: adminID,
+		UpdatedAt: time.Now(),
+	}
+	if err := m.policies.SetPolicy(ctx, policy); err != nil {
+		logger.Error("error saving mfa policy", slog.Any("error", err))
+		return nil, err
+	}
+
+	logger.Info("mfa policy updated", slog.Bool("required", required))
+	return policy, nil
+}
+
+// checkTOTP validates a TOTP code and records its time step so it can't be replayed.
+func (m *MFAServiceImpl) checkTOTP(ctx context.Context, enrollment *domain.MFAEnrollment, code string) error {
+	step, ok := totp.Validate(enrollment.Secret, code, time.Now(), totpSkew)
+	if !ok {
+		return domain.NewUnauthorizedError("invalid mfa code")
+	}
+
+	fresh, err := m.enrollments.UseStep(ctx, enrollment.UserID, step)
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		return domain.NewUnauthorizedError("mfa code already used")
+	}
+	return nil
+}
+
+// isTOTPCode checks if code looks like a TOTP code rather than a recovery code.
+func isTOTPCode(code string) bool {
+	if len(code) != totp.DefaultOptions.Digits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// generateRecoveryCodes returns n codes formatted as XXXX-XXXX along with their hashes.
+func generateRecoveryCodes(n int) ([]string, []string, error) {
+	codes := make([]string, n)
+	hashes := make([]string, n)
+	for i := range codes {
+		raw := make([]byte, 5)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		code := base32.StdEncoding.EncodeToString(raw)
+		codes[i] = code[:4] + "-" + code[4:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode hashes a recovery code ignoring case, spaces and dashes.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return pkg.HashToken(normalized)
+}
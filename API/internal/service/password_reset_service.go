@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+)
+
+const (
+	// passwordResetTTL is how long a password reset link stays valid.
+	passwordResetTTL = time.Hour
+	// passwordResetCooldown is the minimum time between two reset emails to the same account.
+	passwordResetCooldown = time.Minute
+)
+
+// PasswordResetServiceImpl implements PasswordResetService interface.
+type PasswordResetServiceImpl struct {
+	users       domain.UserRepository
+	tokens      domain.PasswordResetRepository
+	mailer      domain.Mailer
+	revocations domain.TokenRevocationStore
+	resetURL    string
+}
+
+// NewPasswordResetService creates a PasswordResetService that emails links pointing to resetURL
+func NewPasswordResetService(users domain.UserRepository, tokens domain.PasswordResetRepository, mailer domain.Mailer,
+	revocations domain.TokenRevocationStore, resetURL string) domain.PasswordResetService {
+	return &PasswordResetServiceImpl{
+		users:       users,
+		tokens:      tokens,
+		mailer:      mailer,
+		revocations: revocations,
+		resetURL:    resetURL,
+	}
+}
+
+// RequestReset emails a reset link to the user. Unknown emails are ignored, requests within
+// the cooldown are dropped, and failures to send the email are only logged, so the endpoint
+// can't be used to flood a mailbox or to find out which emails are registered.
+func (p *PasswordResetServiceImpl) RequestReset(ctx context.Context, email string) error {
+	logger := slog.With(
+		slog.String("service", "PasswordResetService"),
+		slog.String("method", "RequestReset"),
+		slog.String("email", email),
+	)
+
+	user, err := p.users.GetUserByEmail(ctx, email)
+	if err != nil {
+		logger.Error("error fetching user", slog.Any("error", err))
+		return domain.NewInternalError("error processing password reset")
+	}
+	if user == nil || user.IsDeleted() {
+		logger.Info("password reset requested for non-existent user")
+		return nil
+	}
+
+	now := time.Now()
+	marked, err := p.users.MarkResetSent(ctx, user.ID, now, now.Add(-passwordResetCooldown))
+	if err != nil {
+		logger.Error("error recording reset email", slog.Any("error", err))
+		return err
+	}
+	if !marked {
+		logger.Info("password reset request dropped within cooldown", slog.String("userID", user.ID))
+		return nil
+	}
+
+	// Only the most recent link is valid
+	if err := p.tokens.DeleteByUser(ctx, user.ID); err != nil {
+		logger.Error("error invalidating previous reset tokens", slog.Any("error", err))
+		return err
+	}
+
+	rawToken := pkg.GenerateToken()
+	token := &domain.PasswordResetToken{
+		ID:        pkg.HashToken(rawToken),
+		UserID:    user.ID,
+		ExpiresAt: now.Add(passwordResetTTL),
+		CreatedAt: now,
+	}
+	if err := p.tokens.Create(ctx, token); err != nil {
+		logger.Error("error creating reset token", slog.Any("error", err))
+		return err
+	}
+
+	link := p.resetURL + "?token=" + url.QueryEscape(rawToken)
+	message := &domain.Email{
+		To:      user.Email,
+		Subject: "Vida Plus - Redefinição de senha",
+		Body: fmt.Sprintf("Olá %s,\n\nRecebemos uma solicitação para redefinir sua senha. "+
+			"Use o link abaixo em até %d minutos:\n\n%s\n\n"+
+			"Se você não fez essa solicitação, ignore este email.\n",
+			user.Profile.FirstName, int(passwordResetTTL.Minutes()), link),
+	}
+	if err := p.mailer.Send(ctx, message); err != nil {
+		logger.Error("error sending reset email", slog.Any("error", err))
+		return nil
+	}
+
+	logger.Info("password reset email sent", slog.String("userID", user.ID))
+	return nil
+}
+
+// ResetPassword sets a new password using a reset token and ends every existing session of the user.
+// The token is consumed before the password changes, so concurrent resets can't both use it, and
+// released again when the password can't be changed, so the emailed link keeps working.
+func (p *PasswordResetServiceImpl) ResetPassword(ctx context.Context, rawToken, newPassword string) error {
+	logger := slog.With(
+		slog.String("service", "PasswordResetService"),
+		slog.String("method", "ResetPassword"),
+	)
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("error hashing password", slog.Any("error", err))
+		return domain.NewInternalError("error processing password")
+	}
+
+	tokenID := pkg.HashToken(rawToken)
+	token, err := p.tokens.Consume(ctx, tokenID, time.Now())
+	if err != nil {
+		logger.Error("error consuming reset token", slog.Any("error", err))
+		return err
+	}
+	if token == nil {
+		logger.Info("invalid, expired or used reset token")
+		return domain.NewBadRequestError("invalid or expired reset token")
+	}
+	logger = logger.With(slog.String("userID", token.UserID))
+
+	if err := p.users.UpdatePassword(ctx, token.UserID, string(hashedPassword)); err != nil {
+		logger.Error("error updating password", slog.Any("error", err))
+		if err := p.tokens.Release(ctx, tokenID); err != nil {
+			logger.Error("error releasing reset token", slog.Any("error", err))
+		}
+		return err
+	}
+
+	if err := p.revocations.RevokeAllForUser(ctx, token.UserID); err != nil {
+		logger.Error("error revoking user sessions", slog.Any("error", err))
+		return err
+	}
+
+	if err := p.tokens.DeleteByUser(ctx, token.UserID); err != nil {
+		logger.Warn("error cleaning up reset tokens", slog.Any("error", err))
+	}
+
+	logger.Info("password reset successfully")
+	return nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	mocks "github.com/vida-plus/api/mocks"
+	"github.com/vida-plus/api/pkg"
+)
+
+type passwordResetMocks struct {
+	users       *mocks.UserRepositoryMock
+	tokens      *mocks.PasswordResetRepositoryMock
+	mailer      *mocks.MailerMock
+	revocations *mocks.TokenRevocationStoreMock
+}
+
+func newTestPasswordResetService(t *testing.T) (*PasswordResetServiceImpl, passwordResetMocks) {
+	m := passwordResetMocks{
+		users:       mocks.NewUserRepositoryMock(t),
+		tokens:      mocks.NewPasswordResetRepositoryMock(t),
+		mailer:      mocks.NewMailerMock(t),
+		revocations: mocks.NewTokenRevocationStoreMock(t),
+	}
+	s := NewPasswordResetService(m.users, m.tokens, m.mailer, m.revocations,
+		"http://localhost:5173/reset-password").(*PasswordResetServiceImpl)
+	return s, m
+}
+
+func Test_PasswordResetService_RequestReset(t *testing.T) {
+	ctx := context.Background()
+	user := &domain.User{ID: "user-1", Email: "user@test.com", Status: domain.UserStatusActive}
+
+	t.Run("MAILER FAILS", func(t *testing.T) {
+		// Answered like an unknown email, so the failure doesn't reveal that the account exists
+		s, m := newTestPasswordResetService(t)
+		m.users.EXPECT().GetUserByEmail(ctx, user.Email).Return(user, nil)
+		m.users.EXPECT().MarkResetSent(ctx, user.ID, mock.Anything, mock.Anything).Return(true, nil)
+		m.tokens.EXPECT().DeleteByUser(ctx, user.ID).Return(nil)
+		m.tokens.EXPECT().Create(ctx, mock.Anything).Return(nil)
+		m.mailer.EXPECT().Send(ctx, mock.Anything).Return(errors.New("connection refused"))
+
+		require.NoError(t, s.RequestReset(ctx, user.Email))
+	})
+
+	t.Run("WITHIN COOLDOWN", func(t *testing.T) {
+		// Answered like an unknown email, without sending another one
+		s, m := newTestPasswordResetService(t)
+		m.users.EXPECT().GetUserByEmail(ctx, user.Email).Return(user, nil)
+		m.users.EXPECT().MarkResetSent(ctx, user.ID, mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ string, sentAt, notAfter time.Time) (bool, error) {
+				assert.Equal(t, passwordResetCooldown, sentAt.Sub(notAfter))
+				return false, nil
+			})
+
+		require.NoError(t, s.RequestReset(ctx, user.Email))
+	})
+
+	t.Run("UNKNOWN EMAIL", func(t *testing.T) {
+		s, m := newTestPasswordResetService(t)
+		m.users.EXPECT().GetUserByEmail(ctx, "unknown@test.com").Return(nil, nil)
+
+		require.NoError(t, s.RequestReset(ctx, "unknown@test.com"))
+	})
+}
+
+func Test_PasswordResetService_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TOKEN KEPT WHEN PASSWORD UPDATE FAILS", func(t *testing.T) {
+		s, m := newTestPasswordResetService(t)
+		tokenID := pkg.HashToken("raw-token")
+		token := &domain.PasswordResetToken{ID: tokenID, UserID: "user-1"}
+
+		// The token can be consumed while it isn't used, like in the repository
+		used := false
+		m.tokens.EXPECT().Consume(ctx, tokenID, mock.Anything).RunAndReturn(
+			func(context.Context, string, time.Time) (*domain.PasswordResetToken, error) {
+				if used {
+					return nil, nil
+				}
+				used = true
+				return token, nil
+			})
+		m.tokens.EXPECT().Release(ctx, tokenID).RunAndReturn(func(context.Context, string) error {
+			used = false
+			return nil
+		}).Once()
+		m.users.EXPECT().UpdatePassword(ctx, "user-1", mock.Anything).Return(domain.NewInternalError("failed to update password")).Once()
+
+		err := s.ResetPassword(ctx, "raw-token", "new-password123")
+		requireStatus(t, err, http.StatusInternalServerError)
+
+		// The emailed link still works
+		m.users.EXPECT().UpdatePassword(ctx, "user-1", mock.Anything).Return(nil).Once()
+		m.revocations.EXPECT().RevokeAllForUser(ctx, "user-1").Return(nil)
+		m.tokens.EXPECT().DeleteByUser(ctx, "user-1").Return(nil)
+		require.NoError(t, s.ResetPassword(ctx, "raw-token", "new-password123"))
+
+		// And is used once
+		err = s.ResetPassword(ctx, "raw-token", "new-password123")
+		requireStatus(t, err, http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// ProfileServiceImpl implements ProfileService interface.
+type ProfileServiceImpl struct {
+	users       domain.UserRepository
+	jwt         domain.JWTManager
+	mailer      domain.Mailer
+	revocations domain.TokenRevocationStore
+	confirmURL  string
+}
+
+// NewProfileService creates a ProfileService that emails email change links pointing to confirmURL
+func NewProfileService(users domain.UserRepository, jwt domain.JWTManager, mailer domain.Mailer,
+	revocations domain.TokenRevocationStore, confirmURL string) domain.ProfileService {
+	return &ProfileServiceImpl{
+		users:       users,
+		jwt:         jwt,
+		mailer:      mailer,
+		revocations: revocations,
+		confirmURL:  confirmURL,
+	}
+}
+
+func (p *ProfileServiceImpl) GetProfile(ctx context.Context, userID string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "ProfileService"),
+		slog.String("method", "GetProfile"),
+		slog.String("userID", userID),
+	)
+
+	user, err := p.users.GetByID(ctx, userID)
+	if err != nil {
+		logger.Error("error fetching user", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		logger.Info("user not found")
+		return nil, domain.NewNotFoundError("user not found")
+	}
+
+	return user, nil
+}
+
+// UpdateProfile changes the profile fields set in req. Fields the user type can't change, such
+// as a patient's CRM, reject the whole update.
+func (p *ProfileServiceImpl) UpdateProfile(ctx context.Context, userID string, req domain.UpdateProfileRequest) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "ProfileService"),
+		slog.String("method", "UpdateProfile"),
+		slog.String("userID", userID),
+	)
+
+	user, err := p.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if forbidden := req.ForbiddenFields(user.Type); len(forbidden) > 0 {
+		logger.Info("attempt to change restricted profile fields", slog.Any("fields", forbidden))
+		return nil, domain.NewForbiddenError(fmt.Sprintf("%s users cannot change: %s", user.Type, strings.Join(forbidden, ", ")))
+	}
+
+	profile := user.Profile
+	req.Apply(&profile)
+	if err := profile.Normalize(user.Type); err != nil {
+		logger.Info("invalid profile", slog.Any("error", err))
+		return nil, err
+	}
+
+	updated, err := p.users.UpdateUser(ctx, userID, domain.UserUpdate{Profile: &profile})
+	if err != nil {
+		logger.Error("error updating profile", slog.Any("error", err))
+		return nil, err
+	}
+	if updated == nil {
+		return nil, domain.NewNotFoundError("user not found")
+	}
+
+	logger.Info("profile updated successfully")
+	return updated, nil
+}
+
+func (p *ProfileServiceImpl) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error {
+	logger := slog.With(
+		slog.String("service", "ProfileService"),
+		slog.String("method", "ChangePassword"),
+		slog.String("userID", userID),
+	)
+
+	user, err := p.GetProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(currentPassword)); err != nil {
+		logger.Info("password change with invalid current password")
+		return domain.NewBadRequestError("current password is incorrect")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("error hashing password", slog.Any("error", err))
+		return domain.NewInternalError("error processing password")
+	}
+
+	if err := p.users.UpdatePassword(ctx, userID, string(hashedPassword)); err != nil {
+		logger.Error("error updating password", slog.Any("error", err))
+		return err
+	}
+
+	if err := p.revocations.RevokeAllForUser(ctx, userID); err != nil {
+		logger.Error("error revoking user sessions", slog.Any("error", err))
+		return err
+	}
+
+	logger.Info("password changed successfully")
+	return nil
+}
+
+func (p *ProfileServiceImpl) RequestEmailChange(ctx context.Context, userID, password, newEmail string) error {
+	logger := slog.With(
+		slog.String("service", "ProfileService"),
+		slog.String("method", "RequestEmailChange"),
+		slog.String("userID", userID),
+	)
+
+	user, err := p.GetProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		logger.Info("email change with invalid password")
+		return domain.NewBadRequestError("password is incorrect")
+	}
+	if strings.EqualFold(newEmail, user.Email) {
+		return domain.NewBadRequestError("new email must be different from the current email")
+	}
+	if err := p.ensureEmailAvailable(ctx, logger, newEmail, userID); err != nil {
+		return err
+	}
+
+	// Only the link for the latest requested address is valid
+	if _, err := p.users.UpdateUser(ctx, userID, domain.UserUpdate{PendingEmail: &newEmail}); err != nil {
+		logger.Error("error saving pending email", slog.Any("error", err))
+		return err
+	}
+
+	token, err := p.jwt.GenerateScopedToken(userID, newEmail, domain.TokenPurposeEmailChange, emailVerificationTTL)
+	if err != nil {
+		logger.Error("error generating email change token", slog.Any("error", err))
+		return domain.NewInternalError("error generating verification token")
+	}
+
+	link := p.confirmURL + "?token=" + url.QueryEscape(token)
+	message := &domain.Email{
+		To:      newEmail,
+		Subject: "Vida Plus - Confirme seu novo email",
+		Body: fmt.Sprintf("Olá %s,\n\nRecebemos um pedido para alterar o email da sua conta Vida Plus para este endereço. "+
+			"Confirme a alteração usando o link abaixo em até %d horas:\n\n%s\n\n"+
+			"Se você não fez este pedido, ignore este email.\n",
+			user.Profile.FirstName, int(emailVerificationTTL.Hours()), link),
+	}
+	if err := p.mailer.Send(ctx, message); err != nil {
+		logger.Error("error sending email change confirmation", slog.Any("error", err))
+		return domain.NewInternalError("error sending verification email")
+	}
+
+	// Warn the current address, which is where the owner would notice an account takeover
+	notice := &domain.Email{
+		To:      user.Email,
+		Subject: "Vida Plus - Alteração de email solicitada",
+		Body: fmt.Sprintf("Olá %s,\n\nFoi solicitada a alteração do email da sua conta Vida Plus para %s. "+
+			"O email atual continua valendo até a confirmação.\n\n"+
+			"Se você não fez este pedido, altere sua senha imediatamente.\n",
+			user.Profile.FirstName, newEmail),
+	}
+	if err := p.mailer.Send(ctx, notice); err != nil {
+		logger.Warn("error sending email change notice", slog.Any("error", err))
+	}
+
+	logger.Info("email change requested")
+	return nil
+}
+
+// ConfirmEmailChange swaps the email of the user for the address the token was sent to.
+func (p *ProfileServiceImpl) ConfirmEmailChange(ctx context.Context, token string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "ProfileService"),
+		slog.String("method", "ConfirmEmailChange"),
+	)
+
+	claims, err := p.jwt.ValidateScopedToken(token, domain.TokenPurposeEmailChange)
+	if err != nil {
+		logger.Info("invalid email change token", slog.Any("error", err))
+		return nil, domain.NewBadRequestError("invalid or expired verification token")
+	}
+	logger = logger.With(slog.String("userID", claims.Subject))
+
+	if err := p.ensureEmailAvailable(ctx, logger, claims.Email, claims.Subject); err != nil {
+		return nil, err
+	}
+
+	// The pending email must still match so links for an earlier request stop working
+	user, err := p.users.ConfirmEmailChange(ctx, claims.Subject, claims.Email)
+	if err != nil {
+		logger.Error("error confirming email change", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		logger.Info("email change token no longer applies")
+		return nil, domain.NewBadRequestError("invalid or expired verification token")
+	}
+
+	logger.Info("email changed successfully")
+	return user, nil
+}
+
+// ensureEmailAvailable fails with a conflict when email belongs to a user other than userID.
+func (p *ProfileServiceImpl) ensureEmailAvailable(ctx context.Context, logger *slog.Logger, email, userID string) error {
+	existing, err := p.users.GetUserByEmail(ctx, email)
+	if err != nil {
+		logger.Error("error checking email availability", slog.Any("error", err))
+		return domain.NewInternalError("error checking user existence")
+	}
+	if existing != nil && existing.ID != userID {
+		logger.Info("email already in use")
+		return domain.NewConflictError("email already in use")
+	}
+	return nil
+}
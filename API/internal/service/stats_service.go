@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// StatsServiceImpl implements StatsService interface.
+type StatsServiceImpl struct {
+	users domain.UserRepository
+}
+
+// NewStatsService creates a StatsService that aggregates the statistics in the database.
+func NewStatsService(users domain.UserRepository) domain.StatsService {
+	return &StatsServiceImpl{users: users}
+}
+
+func (s *StatsServiceImpl) GetSystemStats(ctx context.Context, query domain.StatsQuery) (*domain.SystemStats, error) {
+	logger := slog.With(
+		slog.String("service", "StatsService"),
+		slog.String("method", "GetSystemStats"),
+	)
+
+	period, err := query.Period(time.Now())
+	if err != nil {
+		logger.Info("invalid stats query", slog.Any("error", err))
+		return nil, err
+	}
+
+	stats, err := s.users.GetStats(ctx, period)
+	if err != nil {
+		logger.Error("error aggregating user stats", slog.Any("error", err))
+		return nil, err
+	}
+
+	// The aggregation skips the buckets without registrations, which charts expect as zeros
+	stats.Registrations = period.Fill(stats.Registrations)
+	stats.From = period.From
+	stats.To = period.To
+	stats.Interval = period.Interval
+
+	return stats, nil
+}
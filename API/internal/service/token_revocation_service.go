@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+	"github.com/vida-plus/api/pkg/cache"
+)
+
+// revocationCacheTTL bounds how long a lookup is trusted before MongoDB is asked
+// again, which is how quickly revocations made by other replicas are picked up.
+const revocationCacheTTL = 30 * time.Second
+
+// TokenRevocationServiceImpl implements TokenRevocationStore with an in-memory
+// cache in front of the revocation repository.
+type TokenRevocationServiceImpl struct {
+	repo          domain.TokenRevocationRepository
+	refreshTokens domain.RefreshTokenRepository
+	revokedTokens *cache.Cache[string, bool]
+	sessions      *cache.Cache[string, time.Time]
+	// accessTokenTTL is how long revocations must be kept, since older access tokens expired
+	accessTokenTTL time.Duration
+}
+
+func NewTokenRevocationService(repo domain.TokenRevocationRepository, refreshTokens domain.RefreshTokenRepository,
+	accessTokenTTL time.Duration) domain.TokenRevocationStore {
+	return &TokenRevocationServiceImpl{
+		repo:           repo,
+		refreshTokens:  refreshTokens,
+		accessTokenTTL: accessTokenTTL,
+		revokedTokens:  cache.New[string, bool](),
+		sessions:       cache.New[string, time.Time](),
+	}
+}
+
+func (s *TokenRevocationServiceImpl) Revoke(ctx context.Context, claims *domain.AuthClaims) (bool, error) {
+	logger := slog.With(
+		slog.String("service", "TokenRevocationService"),
+		slog.String("method", "Revoke"),
+		slog.String("userID", claims.UserID),
+	)
+
+	if claims.ID == "" {
+		logger.Info("attempt to revoke token without jti")
+		return false, domain.NewBadRequestError("token cannot be revoked")
+	}
+
+	expiresAt := time.Now().Add(s.accessTokenTTL)
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+
+	token := &domain.RevokedToken{
+		ID:        claims.ID,
+		UserID:    claims.UserID,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+	first, err := s.repo.RevokeToken(ctx, token)
+	if err != nil {
+		logger.Error("failed to revoke token", slog.Any("error", err))
+		return false, err
+	}
+
+	s.revokedTokens.Set(claims.ID, true, time.Until(expiresAt))
+
+	logger.Info("token revoked successfully", slog.Bool("first", first))
+	return first, nil
+}
+
+// RevokeAllForUser revokes every access and refresh token issued to the user so far.
+func (s *TokenRevocationServiceImpl) RevokeAllForUser(ctx context.Context, userID string) error {
+	logger := slog.With(
+		slog.String("service", "TokenRevocationService"),
+		slog.String("method", "RevokeAllForUser"),
+		slog.String("userID", userID),
+	)
+
+	// MongoDB stores milliseconds, so the cached cutoff is truncated the same way
+	now := time.Now().Truncate(time.Millisecond)
+	revocation := &domain.SessionRevocation{
+		UserID:        userID,
+		RevokedBefore: now,
+		ExpiresAt:     now.Add(s.accessTokenTTL),
+	}
+	if err := s.repo.RevokeSessions(ctx, revocation); err != nil {
+		logger.Error("failed to revoke user sessions", slog.Any("error", err))
+		return err
+	}
+
+	s.sessions.Set(userID, now, revocationCacheTTL)
+
+	if err := s.refreshTokens.RevokeAllForUser(ctx, userID); err != nil {
+		logger.Error("failed to revoke refresh tokens", slog.Any("error", err))
+		return err
+	}
+
+	logger.Info("user sessions revoked successfully")
+	return nil
+}
+
+func (s *TokenRevocationServiceImpl) IsRevoked(ctx context.Context, claims *domain.AuthClaims) (bool, error) {
+	if claims.ID != "" {
+		revoked, err := s.isTokenRevoked(ctx, claims)
+		if err != nil || revoked {
+			return revoked, err
+		}
+	}
+
+	revokedBefore, err := s.sessionsRevokedBefore(ctx, claims.UserID)
+	if err != nil {
+		return false, err
+	}
+	if revokedBefore.IsZero() {
+		return false, nil
+	}
+
+	return !issuedAfter(claims, revokedBefore), nil
+}
+
+// issuedAfter reports whether a token was issued after t. iat only has second precision,
+// which can't tell a token issued right after a revocation from one issued right before
+// it, so the millisecond issue time recorded in the jti is used when there is one.
+// Tokens issued in the same millisecond, or in the same second for older jtis, count
+// as issued before t.
+func issuedAfter(claims *domain.AuthClaims, t time.Time) bool {
+	if issuedAt, ok := pkg.TimeFromID(claims.ID); ok {
+		return issuedAt.After(t.Truncate(time.Millisecond))
+	}
+	return claims.IssuedAt != nil && claims.IssuedAt.After(t.Truncate(time.Second))
+}
+
+func (s *TokenRevocationServiceImpl) isTokenRevoked(ctx context.Context, claims *domain.AuthClaims) (bool, error) {
+	if revoked, ok := s.revokedTokens.Get(claims.ID); ok {
+		return revoked, nil
+	}
+
+	revoked, err := s.repo.IsTokenRevoked(ctx, claims.ID)
+	if err != nil {
+		return false, err
+	}
+
+	ttl := revocationCacheTTL
+	if revoked && claims.ExpiresAt != nil {
+		ttl = time.Until(claims.ExpiresAt.Time)
+	}
+	s.revokedTokens.Set(claims.ID, revoked, ttl)
+
+	return revoked, nil
+}
+
+func (s *TokenRevocationServiceImpl) sessionsRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	if revokedBefore, ok := s.sessions.Get(userID); ok {
+		return revokedBefore, nil
+	}
+
+	revocation, err := s.repo.GetSessionRevocation(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var revokedBefore time.Time
+	if revocation != nil {
+		revokedBefore = revocation.RevokedBefore
+	}
+	s.sessions.Set(userID, revokedBefore, revocationCacheTTL)
+
+	return revokedBefore, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	mocks "github.com/vida-plus/api/mocks"
+	"github.com/vida-plus/api/pkg"
+)
+
+func Test_TokenRevocationService_IsRevoked_sessions(t *testing.T) {
+	ctx := context.Background()
+	revokedBefore := time.Date(2026, 11, 2, 12, 0, 0, 500_000_000, time.UTC)
+
+	tests := []struct {
+		name     string
+		id       string
+		issuedAt time.Time
+		want     bool
+	}{
+		{"ISSUED BEFORE", pkg.GenerateTimeID(revokedBefore.Add(-time.Millisecond)), revokedBefore, true},
+		{"ISSUED IN THE SAME MILLISECOND", pkg.GenerateTimeID(revokedBefore), revokedBefore, true},
+		{"ISSUED IN THE SAME SECOND AFTERWARDS", pkg.GenerateTimeID(revokedBefore.Add(time.Millisecond)), revokedBefore, false},
+		{"OLDER JTI IN THE SAME SECOND", pkg.GenerateID(), revokedBefore.Truncate(time.Second), true},
+		{"OLDER JTI IN THE NEXT SECOND", pkg.GenerateID(), revokedBefore.Truncate(time.Second).Add(time.Second), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewTokenRevocationRepositoryMock(t)
+			s := NewTokenRevocationService(repo, mocks.NewRefreshTokenRepositoryMock(t), time.Hour)
+			repo.EXPECT().IsTokenRevoked(ctx, tt.id).Return(false, nil)
+			repo.EXPECT().GetSessionRevocation(ctx, "user-1").
+				Return(&domain.SessionRevocation{UserID: "user-1", RevokedBefore: revokedBefore}, nil)
+
+			revoked, err := s.IsRevoked(ctx, &domain.AuthClaims{
+				UserID: "user-1",
+				RegisteredClaims: jwt.RegisteredClaims{
+					ID:       tt.id,
+					IssuedAt: jwt.NewNumericDate(tt.issuedAt),
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, revoked)
+		})
+	}
+}
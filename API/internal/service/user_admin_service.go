@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg"
+)
+
+// UserAdminServiceImpl implements UserAdminService interface.
+type UserAdminServiceImpl struct {
+	users       domain.UserRepository
+	userStore   domain.UserStore
+	statuses    domain.UserStatusCache
+	revocations domain.TokenRevocationStore
+	audit       domain.AuditRepository
+}
+
+// NewUserAdminService creates a UserAdminService. Status changes go through userStore so they
+// end the sessions of users that are no longer active.
+func NewUserAdminService(users domain.UserRepository, userStore domain.UserStore, statuses domain.UserStatusCache,
+	revocations domain.TokenRevocationStore, audit domain.AuditRepository) domain.UserAdminService {
+	return &UserAdminServiceImpl{
+		users:       users,
+		userStore:   userStore,
+		statuses:    statuses,
+		revocations: revocations,
+		audit:       audit,
+	}
+}
+
+func (s *UserAdminServiceImpl) ListUsers(ctx context.Context, filter domain.UserFilter, query domain.ListQuery) (*domain.UserPage, error) {
+	logger := slog.With(
+		slog.String("service", "UserAdminService"),
+		slog.String("method", "ListUsers"),
+	)
+
+	page, err := query.PageRequest(domain.UserSortFields, domain.SortOrder{Field: "created_at", Desc: true})
+	if err != nil {
+		logger.Info("invalid list query", slog.Any("error", err))
+		return nil, err
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, domain.NewBadRequestError("created_from must be before created_to")
+	}
+
+	users, err := s.users.ListUsers(ctx, filter, page)
+	if err != nil {
+		logger.Error("error listing users", slog.Any("error", err))
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (s *UserAdminServiceImpl) GetUser(ctx context.Context, id string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "UserAdminService"),
+		slog.String("method", "GetUser"),
+		slog.String("userID", id),
+	)
+
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		logger.Error("error fetching user", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		logger.Info("user not found")
+		return nil, domain.NewNotFoundError("user not found")
+	}
+
+	return user, nil
+}
+
+// CreateUser creates an account on behalf of its owner. Staff accounts are only created this
+// way, so they start active without going through email verification.
+func (s *UserAdminServiceImpl) CreateUser(ctx context.Context, req domain.CreateUserRequest, adminID string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "UserAdminService"),
+		slog.String("method", "CreateUser"),
+		slog.String("email", req.Email),
+		slog.String("type", string(req.Type)),
+		slog.String("adminID", adminID),
+	)
+
+	if !req.Type.IsValid() {
+		return nil, domain.NewBadRequestError("invalid user type")
+	}
+	profile := req.Profile
+	if err := profile.Normalize(req.Type); err != nil {
+		logger.Info("invalid profile", slog.Any("error", err))
+		return nil, err
+	}
+
+	existing, err := s.users.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		logger.Error("error checking existing user", slog.Any("error", err))
+		return nil, domain.NewInternalError("error checking user existence")
+	}
+	if existing != nil {
+		logger.Info("attempt to create existing user")
+		return nil, domain.NewConflictError("user already exists")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("error hashing password", slog.Any("error", err))
+		return nil, domain.NewInternalError("error processing password")
+	}
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        pkg.GenerateID(),
+		Email:     req.Email,
+		Password:  string(hashedPassword),
+		Type:      req.Type,
+		Status:    domain.UserStatusActive,
+		Profile:   profile,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.users.CreateUser(ctx, user); err != nil {
+		logger.Error("error creating user", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.record(ctx, logger, domain.AuditActionUserCreated, adminID, user.ID, map[string]string{
+		"type": string(user.Type),
+	})
+
+	logger.Info("user created successfully", slog.String("userID", user.ID))
+	return user, nil
+}
+
+func (s *UserAdminServiceImpl) UpdateUser(ctx context.Context, id string, req domain.AdminUpdateUserRequest, adminID string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "UserAdminService"),
+		slog.String("method", "UpdateUser"),
+		slog.String("userID", id),
+		slog.String("adminID", adminID),
+	)
+
+	user, err := s.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsDeleted() {
+		return nil, domain.NewConflictError("user is deleted")
+	}
+
+	var update domain.UserUpdate
+	details := map[string]string{}
+	typeChanged := req.Type != nil && *req.Type != user.Type
+	if typeChanged {
+		if !req.Type.IsValid() {
+			return nil, domain.NewBadRequestError("invalid user type")
+		}
+		// An admin demoting itself could leave the system without admins
+		if id == adminID {
+			logger.Info("admin attempted to change own type")
+			return nil, domain.NewBadRequestError("cannot change your own type")
+		}
+		update.Type = req.Type
+		details["previous_type"] = string(user.Type)
+		details["type"] = string(*req.Type)
+	}
+	// The profile must suit the new type, so a nurse promoted to doctor needs a CRM
+	profile := user.Profile
+	if req.Profile != nil {
+		req.Profile.Apply(&profile)
+		details["profile"] = "updated"
+	}
+	if req.Profile != nil || typeChanged {
+		userType := user.Type
+		if typeChanged {
+			userType = *req.Type
+		}
+		if err := profile.Normalize(userType); err != nil {
+			logger.Info("invalid profile", slog.Any("error", err))
+			return nil, err
+		}
+		update.Profile = &profile
+	}
+
+	updated, err := s.users.UpdateUser(ctx, id, update)
+	if err != nil {
+		logger.Error("error updating user", slog.Any("error", err))
+		return nil, err
+	}
+	if updated == nil {
+		return nil, domain.NewNotFoundError("user not found")
+	}
+
+	// Tokens carry the user type, so permissions of the old type must not outlive the change
+	if typeChanged {
+		if err := s.revocations.RevokeAllForUser(ctx, id); err != nil {
+			logger.Error("error revoking user sessions", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	s.record(ctx, logger, domain.AuditActionUserUpdated, adminID, id, details)
+
+	logger.Info("user updated successfully")
+	return updated, nil
+}
+
+func (s *UserAdminServiceImpl) UpdateStatus(ctx context.Context, id string, req domain.UpdateStatusRequest, adminID string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "UserAdminService"),
+		slog.String("method", "UpdateStatus"),
+		slog.String("userID", id),
+		slog.String("adminID", adminID),
+		slog.String("status", string(req.Status)),
+	)
+
+	if id == adminID {
+		logger.Info("admin attempted to change own status")
+		return nil, domain.NewBadRequestError("cannot change your own status")
+	}
+
+	user, err := s.userStore.UpdateStatus(ctx, id, req.Status, req.Reason, adminID)
+	if err != nil {
+		logger.Error("error updating user status", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.record(ctx, logger, domain.AuditActionStatusChanged, adminID, id, map[string]string{
+		"status": string(req.Status),
+		"reason": req.Reason,
+	})
+
+	logger.Info("user status updated successfully")
+	return user, nil
+}
+
+func (s *UserAdminServiceImpl) DeleteUser(ctx context.Context, id, adminID string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "UserAdminService"),
+		slog.String("method", "DeleteUser"),
+		slog.String("userID", id),
+		slog.String("adminID", adminID),
+	)
+
+	if id == adminID {
+		logger.Info("admin attempted to delete own account")
+		return nil, domain.NewBadRequestError("cannot delete your own account")
+	}
+
+	user, err := s.users.SoftDelete(ctx, id, adminID)
+	if err != nil {
+		logger.Error("error deleting user", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		return nil, s.notApplicable(ctx, id, "user is already deleted")
+	}
+
+	s.statuses.Invalidate(id)
+	if err := s.revocations.RevokeAllForUser(ctx, id); err != nil {
+		logger.Error("error revoking user sessions", slog.Any("error", err))
+		return nil, err
+	}
+
+	s.record(ctx, logger, domain.AuditActionUserDeleted, adminID, id, nil)
+
+	logger.Info("user deleted successfully")
+	return user, nil
+}
+
+func (s *UserAdminServiceImpl) RestoreUser(ctx context.Context, id, adminID string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "UserAdminService"),
+		slog.String("method", "RestoreUser"),
+		slog.String("userID", id),
+		slog.String("adminID", adminID),
+	)
+
+	user, err := s.users.Restore(ctx, id)
+	if err != nil {
+		logger.Error("error restoring user", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		return nil, s.notApplicable(ctx, id, "user is not deleted")
+	}
+
+	s.statuses.Invalidate(id)
+	s.record(ctx, logger, domain.AuditActionUserRestored, adminID, id, nil)
+
+	logger.Info("user restored successfully")
+	return user, nil
+}
+
+// notApplicable explains why a conditional update matched no user: either it doesn't exist,
+// or it isn't in the state the update expects.
+func (s *UserAdminServiceImpl) notApplicable(ctx context.Context, id, conflict string) error {
+	if _, err := s.GetUser(ctx, id); err != nil {
+		return err
+	}
+	return domain.NewConflictError(conflict)
+}
+
+func (s *UserAdminServiceImpl) record(ctx context.Context, logger *slog.Logger, action domain.AuditAction,
+	adminID, targetID string, details map[string]string) {
+	recordAudit(ctx, s.audit, logger, &domain.AuditEvent{
+		ID:        pkg.GenerateID(),
+		Action:    action,
+		ActorID:   adminID,
+		TargetID:  targetID,
+		Details:   details,
+		CreatedAt: time.Now(),
+	})
+}
@@ -4,17 +4,20 @@ package service
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/vida-plus/api/internal/domain"
 )
 
 // UserServiceImpl implements UserStore interface.
 type UserServiceImpl struct {
-	repo domain.UserRepository
+	repo        domain.UserRepository
+	statuses    domain.UserStatusCache
+	revocations domain.TokenRevocationStore
 }
 
-func NewUserService(repo domain.UserRepository) domain.UserStore {
-	return &UserServiceImpl{repo: repo}
+func NewUserService(repo domain.UserRepository, statuses domain.UserStatusCache, revocations domain.TokenRevocationStore) domain.UserStore {
+	return &UserServiceImpl{repo: repo, statuses: statuses, revocations: revocations}
 }
 
 func (u *UserServiceImpl) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
@@ -72,3 +75,41 @@ func (u *UserServiceImpl) GetByID(ctx context.Context, id string) (*domain.User,
 	logger.Info("user found successfully")
 	return user, nil
 }
+
+// UpdateStatus changes the status of a user. Leaving the active status revokes every
+// session of the user so existing tokens stop working immediately.
+func (u *UserServiceImpl) UpdateStatus(ctx context.Context, id string, status domain.UserStatus, reason, changedBy string) (*domain.User, error) {
+	logger := slog.With(
+		slog.String("service", "UserService"),
+		slog.String("method", "UpdateStatus"),
+		slog.String("userID", id),
+		slog.String("status", string(status)),
+		slog.String("changedBy", changedBy),
+	)
+
+	user, err := u.repo.UpdateStatus(ctx, id, status, reason, changedBy)
+	if err != nil {
+		logger.Error("failed to update user status", slog.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		logger.Info("user not found")
+		return nil, domain.NewNotFoundError("user not found")
+	}
+
+	u.statuses.Invalidate(id)
+
+	if status != domain.UserStatusActive {
+		if err := u.revocations.RevokeAllForUser(ctx, id); err != nil {
+			logger.Error("failed to revoke user sessions", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	logger.Info("user status updated successfully")
+	return user, nil
+}
+
+func (u *UserServiceImpl) RecordActivity(ctx context.Context, id string, at time.Time) error {
+	return u.repo.RecordActivity(ctx, id, at)
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg/cache"
+)
+
+// userStatusCacheTTL bounds how long a status change on another replica can go unnoticed.
+const userStatusCacheTTL = 30 * time.Second
+
+// UserStatusServiceImpl implements UserStatusCache on top of the user repository.
+type UserStatusServiceImpl struct {
+	repo     domain.UserRepository
+	statuses *cache.Cache[string, domain.UserStatus]
+}
+
+func NewUserStatusService(repo domain.UserRepository) domain.UserStatusCache {
+	return &UserStatusServiceImpl{
+		repo:     repo,
+		statuses: cache.New[string, domain.UserStatus](),
+	}
+}
+
+// GetStatus returns the status of the user, or an empty status when the user doesn't exist or was deleted.
+func (s *UserStatusServiceImpl) GetStatus(ctx context.Context, userID string) (domain.UserStatus, error) {
+	if status, ok := s.statuses.Get(userID); ok {
+		return status, nil
+	}
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		slog.Error("failed to get user status",
+			slog.String("service", "UserStatusService"),
+			slog.String("userID", userID),
+			slog.Any("error", err),
+		)
+		return "", err
+	}
+
+	var status domain.UserStatus
+	if user != nil && !user.IsDeleted() {
+		status = user.Status
+	}
+	s.statuses.Set(userID, status, userStatusCacheTTL)
+
+	return status, nil
+}
+
+func (s *UserStatusServiceImpl) Invalidate(userID string) {
+	s.statuses.Delete(userID)
+}
@@ -79,27 +79,29 @@ func (_c *AuthServiceMock_GenerateRefreshToken_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// Login provides a mock function with given fields: ctx, email, password
-func (_m *AuthServiceMock) Login(ctx context.Context, email string, password string) (string, error) {
-	ret := _m.Called(ctx, email, password)
+// Login provides a mock function with given fields: ctx, email, password, ip
+func (_m *AuthServiceMock) Login(ctx context.Context, email string, password string, ip string) (*domain.LoginResult, error) {
+	ret := _m.Called(ctx, email, password, ip)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
 	}
 
-	var r0 string
+	var r0 *domain.LoginResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
-		return rf(ctx, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.LoginResult, error)); ok {
+		return rf(ctx, email, password, ip)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
-		r0 = rf(ctx, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.LoginResult); ok {
+		r0 = rf(ctx, email, password, ip)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginResult)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, email, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, email, password, ip)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,23 +118,178 @@ type AuthServiceMock_Login_Call struct {
 //   - ctx context.Context
 //   - email string
 //   - password string
-func (_e *AuthServiceMock_Expecter) Login(ctx interface{}, email interface{}, password interface{}) *AuthServiceMock_Login_Call {
-	return &AuthServiceMock_Login_Call{Call: _e.mock.On("Login", ctx, email, password)}
+//   - ip string
+func (_e *AuthServiceMock_Expecter) Login(ctx interface{}, email interface{}, password interface{}, ip interface{}) *AuthServiceMock_Login_Call {
+	return &AuthServiceMock_Login_Call{Call: _e.mock.On("Login", ctx, email, password, ip)}
 }
 
-func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, email string, password string)) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, email string, password string, ip string)) *AuthServiceMock_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_Login_Call) Return(_a0 *domain.LoginResult, _a1 error) *AuthServiceMock_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_Login_Call) RunAndReturn(run func(context.Context, string, string, string) (*domain.LoginResult, error)) *AuthServiceMock_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, claims, refreshToken
+func (_m *AuthServiceMock) Logout(ctx context.Context, claims *domain.AuthClaims, refreshToken string) error {
+	ret := _m.Called(ctx, claims, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AuthClaims, string) error); ok {
+		r0 = rf(ctx, claims, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthServiceMock_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type AuthServiceMock_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claims *domain.AuthClaims
+//   - refreshToken string
+func (_e *AuthServiceMock_Expecter) Logout(ctx interface{}, claims interface{}, refreshToken interface{}) *AuthServiceMock_Logout_Call {
+	return &AuthServiceMock_Logout_Call{Call: _e.mock.On("Logout", ctx, claims, refreshToken)}
+}
+
+func (_c *AuthServiceMock_Logout_Call) Run(run func(ctx context.Context, claims *domain.AuthClaims, refreshToken string)) *AuthServiceMock_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.AuthClaims), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_Logout_Call) Return(_a0 error) *AuthServiceMock_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthServiceMock_Logout_Call) RunAndReturn(run func(context.Context, *domain.AuthClaims, string) error) *AuthServiceMock_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutAll provides a mock function with given fields: ctx, claims
+func (_m *AuthServiceMock) LogoutAll(ctx context.Context, claims *domain.AuthClaims) error {
+	ret := _m.Called(ctx, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.AuthClaims) error); ok {
+		r0 = rf(ctx, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthServiceMock_LogoutAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutAll'
+type AuthServiceMock_LogoutAll_Call struct {
+	*mock.Call
+}
+
+// LogoutAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claims *domain.AuthClaims
+func (_e *AuthServiceMock_Expecter) LogoutAll(ctx interface{}, claims interface{}) *AuthServiceMock_LogoutAll_Call {
+	return &AuthServiceMock_LogoutAll_Call{Call: _e.mock.On("LogoutAll", ctx, claims)}
+}
+
+func (_c *AuthServiceMock_LogoutAll_Call) Run(run func(ctx context.Context, claims *domain.AuthClaims)) *AuthServiceMock_LogoutAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.AuthClaims))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_LogoutAll_Call) Return(_a0 error) *AuthServiceMock_LogoutAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthServiceMock_LogoutAll_Call) RunAndReturn(run func(context.Context, *domain.AuthClaims) error) *AuthServiceMock_LogoutAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *AuthServiceMock) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *domain.TokenPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.TokenPair, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.TokenPair); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TokenPair)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type AuthServiceMock_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *AuthServiceMock_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *AuthServiceMock_Refresh_Call {
+	return &AuthServiceMock_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+}
+
+func (_c *AuthServiceMock_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *AuthServiceMock_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *AuthServiceMock_Login_Call) Return(token string, err error) *AuthServiceMock_Login_Call {
-	_c.Call.Return(token, err)
+func (_c *AuthServiceMock_Refresh_Call) Return(_a0 *domain.TokenPair, _a1 error) *AuthServiceMock_Refresh_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AuthServiceMock_Login_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_Refresh_Call) RunAndReturn(run func(context.Context, string) (*domain.TokenPair, error)) *AuthServiceMock_Refresh_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -256,6 +413,66 @@ func (_c *AuthServiceMock_RegisterWithProfile_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// SetupMFA provides a mock function with given fields: ctx, mfaToken, ip
+func (_m *AuthServiceMock) SetupMFA(ctx context.Context, mfaToken string, ip string) (*domain.MFASetup, error) {
+	ret := _m.Called(ctx, mfaToken, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetupMFA")
+	}
+
+	var r0 *domain.MFASetup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.MFASetup, error)); ok {
+		return rf(ctx, mfaToken, ip)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.MFASetup); ok {
+		r0 = rf(ctx, mfaToken, ip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MFASetup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, mfaToken, ip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_SetupMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetupMFA'
+type AuthServiceMock_SetupMFA_Call struct {
+	*mock.Call
+}
+
+// SetupMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mfaToken string
+//   - ip string
+func (_e *AuthServiceMock_Expecter) SetupMFA(ctx interface{}, mfaToken interface{}, ip interface{}) *AuthServiceMock_SetupMFA_Call {
+	return &AuthServiceMock_SetupMFA_Call{Call: _e.mock.On("SetupMFA", ctx, mfaToken, ip)}
+}
+
+func (_c *AuthServiceMock_SetupMFA_Call) Run(run func(ctx context.Context, mfaToken string, ip string)) *AuthServiceMock_SetupMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_SetupMFA_Call) Return(_a0 *domain.MFASetup, _a1 error) *AuthServiceMock_SetupMFA_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_SetupMFA_Call) RunAndReturn(run func(context.Context, string, string) (*domain.MFASetup, error)) *AuthServiceMock_SetupMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateRefreshToken provides a mock function with given fields: ctx, token
 func (_m *AuthServiceMock) ValidateRefreshToken(ctx context.Context, token string) (*domain.User, error) {
 	ret := _m.Called(ctx, token)
@@ -315,6 +532,67 @@ func (_c *AuthServiceMock_ValidateRefreshToken_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// VerifyMFA provides a mock function with given fields: ctx, mfaToken, code, ip
+func (_m *AuthServiceMock) VerifyMFA(ctx context.Context, mfaToken string, code string, ip string) (*domain.TokenPair, error) {
+	ret := _m.Called(ctx, mfaToken, code, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyMFA")
+	}
+
+	var r0 *domain.TokenPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.TokenPair, error)); ok {
+		return rf(ctx, mfaToken, code, ip)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.TokenPair); ok {
+		r0 = rf(ctx, mfaToken, code, ip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TokenPair)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, mfaToken, code, ip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_VerifyMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyMFA'
+type AuthServiceMock_VerifyMFA_Call struct {
+	*mock.Call
+}
+
+// VerifyMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mfaToken string
+//   - code string
+//   - ip string
+func (_e *AuthServiceMock_Expecter) VerifyMFA(ctx interface{}, mfaToken interface{}, code interface{}, ip interface{}) *AuthServiceMock_VerifyMFA_Call {
+	return &AuthServiceMock_VerifyMFA_Call{Call: _e.mock.On("VerifyMFA", ctx, mfaToken, code, ip)}
+}
+
+func (_c *AuthServiceMock_VerifyMFA_Call) Run(run func(ctx context.Context, mfaToken string, code string, ip string)) *AuthServiceMock_VerifyMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_VerifyMFA_Call) Return(_a0 *domain.TokenPair, _a1 error) *AuthServiceMock_VerifyMFA_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_VerifyMFA_Call) RunAndReturn(run func(context.Context, string, string, string) (*domain.TokenPair, error)) *AuthServiceMock_VerifyMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAuthServiceMock creates a new instance of AuthServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuthServiceMock(t interface {
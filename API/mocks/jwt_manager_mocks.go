@@ -5,6 +5,8 @@ package domain
 import (
 	mock "github.com/stretchr/testify/mock"
 	domain "github.com/vida-plus/api/internal/domain"
+
+	time "time"
 )
 
 // JWTManagerMock is an autogenerated mock type for the JWTManager type
@@ -76,9 +78,9 @@ func (_c *JWTManagerMock_Generate_Call) RunAndReturn(run func(*domain.User) (str
 	return _c
 }
 
-// GenerateRefreshToken provides a mock function with given fields: user
-func (_m *JWTManagerMock) GenerateRefreshToken(user *domain.User) (string, error) {
-	ret := _m.Called(user)
+// GenerateRefreshToken provides a mock function with given fields: token
+func (_m *JWTManagerMock) GenerateRefreshToken(token *domain.RefreshToken) (string, error) {
+	ret := _m.Called(token)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateRefreshToken")
@@ -86,17 +88,17 @@ func (_m *JWTManagerMock) GenerateRefreshToken(user *domain.User) (string, error
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*domain.User) (string, error)); ok {
-		return rf(user)
+	if rf, ok := ret.Get(0).(func(*domain.RefreshToken) (string, error)); ok {
+		return rf(token)
 	}
-	if rf, ok := ret.Get(0).(func(*domain.User) string); ok {
-		r0 = rf(user)
+	if rf, ok := ret.Get(0).(func(*domain.RefreshToken) string); ok {
+		r0 = rf(token)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(*domain.User) error); ok {
-		r1 = rf(user)
+	if rf, ok := ret.Get(1).(func(*domain.RefreshToken) error); ok {
+		r1 = rf(token)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -110,14 +112,14 @@ type JWTManagerMock_GenerateRefreshToken_Call struct {
 }
 
 // GenerateRefreshToken is a helper method to define mock.On call
-//   - user *domain.User
-func (_e *JWTManagerMock_Expecter) GenerateRefreshToken(user interface{}) *JWTManagerMock_GenerateRefreshToken_Call {
-	return &JWTManagerMock_GenerateRefreshToken_Call{Call: _e.mock.On("GenerateRefreshToken", user)}
+//   - token *domain.RefreshToken
+func (_e *JWTManagerMock_Expecter) GenerateRefreshToken(token interface{}) *JWTManagerMock_GenerateRefreshToken_Call {
+	return &JWTManagerMock_GenerateRefreshToken_Call{Call: _e.mock.On("GenerateRefreshToken", token)}
 }
 
-func (_c *JWTManagerMock_GenerateRefreshToken_Call) Run(run func(user *domain.User)) *JWTManagerMock_GenerateRefreshToken_Call {
+func (_c *JWTManagerMock_GenerateRefreshToken_Call) Run(run func(token *domain.RefreshToken)) *JWTManagerMock_GenerateRefreshToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*domain.User))
+		run(args[0].(*domain.RefreshToken))
 	})
 	return _c
 }
@@ -127,7 +129,66 @@ func (_c *JWTManagerMock_GenerateRefreshToken_Call) Return(_a0 string, _a1 error
 	return _c
 }
 
-func (_c *JWTManagerMock_GenerateRefreshToken_Call) RunAndReturn(run func(*domain.User) (string, error)) *JWTManagerMock_GenerateRefreshToken_Call {
+func (_c *JWTManagerMock_GenerateRefreshToken_Call) RunAndReturn(run func(*domain.RefreshToken) (string, error)) *JWTManagerMock_GenerateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateScopedToken provides a mock function with given fields: userID, email, purpose, ttl
+func (_m *JWTManagerMock) GenerateScopedToken(userID string, email string, purpose domain.TokenPurpose, ttl time.Duration) (string, error) {
+	ret := _m.Called(userID, email, purpose, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateScopedToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, domain.TokenPurpose, time.Duration) (string, error)); ok {
+		return rf(userID, email, purpose, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, domain.TokenPurpose, time.Duration) string); ok {
+		r0 = rf(userID, email, purpose, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, domain.TokenPurpose, time.Duration) error); ok {
+		r1 = rf(userID, email, purpose, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWTManagerMock_GenerateScopedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateScopedToken'
+type JWTManagerMock_GenerateScopedToken_Call struct {
+	*mock.Call
+}
+
+// GenerateScopedToken is a helper method to define mock.On call
+//   - userID string
+//   - email string
+//   - purpose domain.TokenPurpose
+//   - ttl time.Duration
+func (_e *JWTManagerMock_Expecter) GenerateScopedToken(userID interface{}, email interface{}, purpose interface{}, ttl interface{}) *JWTManagerMock_GenerateScopedToken_Call {
+	return &JWTManagerMock_GenerateScopedToken_Call{Call: _e.mock.On("GenerateScopedToken", userID, email, purpose, ttl)}
+}
+
+func (_c *JWTManagerMock_GenerateScopedToken_Call) Run(run func(userID string, email string, purpose domain.TokenPurpose, ttl time.Duration)) *JWTManagerMock_GenerateScopedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(domain.TokenPurpose), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *JWTManagerMock_GenerateScopedToken_Call) Return(_a0 string, _a1 error) *JWTManagerMock_GenerateScopedToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWTManagerMock_GenerateScopedToken_Call) RunAndReturn(run func(string, string, domain.TokenPurpose, time.Duration) (string, error)) *JWTManagerMock_GenerateScopedToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -248,6 +309,65 @@ func (_c *JWTManagerMock_ValidateRefreshToken_Call) RunAndReturn(run func(string
 	return _c
 }
 
+// ValidateScopedToken provides a mock function with given fields: token, purpose
+func (_m *JWTManagerMock) ValidateScopedToken(token string, purpose domain.TokenPurpose) (*domain.ScopedClaims, error) {
+	ret := _m.Called(token, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateScopedToken")
+	}
+
+	var r0 *domain.ScopedClaims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, domain.TokenPurpose) (*domain.ScopedClaims, error)); ok {
+		return rf(token, purpose)
+	}
+	if rf, ok := ret.Get(0).(func(string, domain.TokenPurpose) *domain.ScopedClaims); ok {
+		r0 = rf(token, purpose)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ScopedClaims)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, domain.TokenPurpose) error); ok {
+		r1 = rf(token, purpose)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWTManagerMock_ValidateScopedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateScopedToken'
+type JWTManagerMock_ValidateScopedToken_Call struct {
+	*mock.Call
+}
+
+// ValidateScopedToken is a helper method to define mock.On call
+//   - token string
+//   - purpose domain.TokenPurpose
+func (_e *JWTManagerMock_Expecter) ValidateScopedToken(token interface{}, purpose interface{}) *JWTManagerMock_ValidateScopedToken_Call {
+	return &JWTManagerMock_ValidateScopedToken_Call{Call: _e.mock.On("ValidateScopedToken", token, purpose)}
+}
+
+func (_c *JWTManagerMock_ValidateScopedToken_Call) Run(run func(token string, purpose domain.TokenPurpose)) *JWTManagerMock_ValidateScopedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(domain.TokenPurpose))
+	})
+	return _c
+}
+
+func (_c *JWTManagerMock_ValidateScopedToken_Call) Return(_a0 *domain.ScopedClaims, _a1 error) *JWTManagerMock_ValidateScopedToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWTManagerMock_ValidateScopedToken_Call) RunAndReturn(run func(string, domain.TokenPurpose) (*domain.ScopedClaims, error)) *JWTManagerMock_ValidateScopedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewJWTManagerMock creates a new instance of JWTManagerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewJWTManagerMock(t interface {
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	domain "github.com/vida-plus/api/internal/domain"
+
+	time "time"
 )
 
 // UserRepositoryMock is an autogenerated mock type for the UserRepository type
@@ -22,6 +24,66 @@ func (_m *UserRepositoryMock) EXPECT() *UserRepositoryMock_Expecter {
 	return &UserRepositoryMock_Expecter{mock: &_m.Mock}
 }
 
+// ConfirmEmailChange provides a mock function with given fields: ctx, id, email
+func (_m *UserRepositoryMock) ConfirmEmailChange(ctx context.Context, id string, email string) (*domain.User, error) {
+	ret := _m.Called(ctx, id, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmEmailChange")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.User, error)); ok {
+		return rf(ctx, id, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.User); ok {
+		r0 = rf(ctx, id, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_ConfirmEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmEmailChange'
+type UserRepositoryMock_ConfirmEmailChange_Call struct {
+	*mock.Call
+}
+
+// ConfirmEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - email string
+func (_e *UserRepositoryMock_Expecter) ConfirmEmailChange(ctx interface{}, id interface{}, email interface{}) *UserRepositoryMock_ConfirmEmailChange_Call {
+	return &UserRepositoryMock_ConfirmEmailChange_Call{Call: _e.mock.On("ConfirmEmailChange", ctx, id, email)}
+}
+
+func (_c *UserRepositoryMock_ConfirmEmailChange_Call) Run(run func(ctx context.Context, id string, email string)) *UserRepositoryMock_ConfirmEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_ConfirmEmailChange_Call) Return(_a0 *domain.User, _a1 error) *UserRepositoryMock_ConfirmEmailChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_ConfirmEmailChange_Call) RunAndReturn(run func(context.Context, string, string) (*domain.User, error)) *UserRepositoryMock_ConfirmEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUser provides a mock function with given fields: ctx, user
 func (_m *UserRepositoryMock) CreateUser(ctx context.Context, user *domain.User) error {
 	ret := _m.Called(ctx, user)
@@ -69,29 +131,29 @@ func (_c *UserRepositoryMock_CreateUser_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// GetAllUsers provides a mock function with given fields: ctx
-func (_m *UserRepositoryMock) GetAllUsers(ctx context.Context) ([]*domain.User, error) {
-	ret := _m.Called(ctx)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *UserRepositoryMock) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllUsers")
+		panic("no return value specified for GetByID")
 	}
 
-	var r0 []*domain.User
+	var r0 *domain.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.User, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*domain.User); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.User)
+			r0 = ret.Get(0).(*domain.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -99,57 +161,58 @@ func (_m *UserRepositoryMock) GetAllUsers(ctx context.Context) ([]*domain.User,
 	return r0, r1
 }
 
-// UserRepositoryMock_GetAllUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllUsers'
-type UserRepositoryMock_GetAllUsers_Call struct {
+// UserRepositoryMock_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type UserRepositoryMock_GetByID_Call struct {
 	*mock.Call
 }
 
-// GetAllUsers is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *UserRepositoryMock_Expecter) GetAllUsers(ctx interface{}) *UserRepositoryMock_GetAllUsers_Call {
-	return &UserRepositoryMock_GetAllUsers_Call{Call: _e.mock.On("GetAllUsers", ctx)}
+//   - id string
+func (_e *UserRepositoryMock_Expecter) GetByID(ctx interface{}, id interface{}) *UserRepositoryMock_GetByID_Call {
+	return &UserRepositoryMock_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *UserRepositoryMock_GetAllUsers_Call) Run(run func(ctx context.Context)) *UserRepositoryMock_GetAllUsers_Call {
+func (_c *UserRepositoryMock_GetByID_Call) Run(run func(ctx context.Context, id string)) *UserRepositoryMock_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *UserRepositoryMock_GetAllUsers_Call) Return(_a0 []*domain.User, _a1 error) *UserRepositoryMock_GetAllUsers_Call {
+func (_c *UserRepositoryMock_GetByID_Call) Return(_a0 *domain.User, _a1 error) *UserRepositoryMock_GetByID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *UserRepositoryMock_GetAllUsers_Call) RunAndReturn(run func(context.Context) ([]*domain.User, error)) *UserRepositoryMock_GetAllUsers_Call {
+func (_c *UserRepositoryMock_GetByID_Call) RunAndReturn(run func(context.Context, string) (*domain.User, error)) *UserRepositoryMock_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id
-func (_m *UserRepositoryMock) GetByID(ctx context.Context, id string) (*domain.User, error) {
-	ret := _m.Called(ctx, id)
+// GetStats provides a mock function with given fields: ctx, period
+func (_m *UserRepositoryMock) GetStats(ctx context.Context, period domain.StatsPeriod) (*domain.SystemStats, error) {
+	ret := _m.Called(ctx, period)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for GetStats")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.SystemStats
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsPeriod) (*domain.SystemStats, error)); ok {
+		return rf(ctx, period)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsPeriod) *domain.SystemStats); ok {
+		r0 = rf(ctx, period)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.SystemStats)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.StatsPeriod) error); ok {
+		r1 = rf(ctx, period)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -157,31 +220,31 @@ func (_m *UserRepositoryMock) GetByID(ctx context.Context, id string) (*domain.U
 	return r0, r1
 }
 
-// UserRepositoryMock_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type UserRepositoryMock_GetByID_Call struct {
+// UserRepositoryMock_GetStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStats'
+type UserRepositoryMock_GetStats_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// GetStats is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *UserRepositoryMock_Expecter) GetByID(ctx interface{}, id interface{}) *UserRepositoryMock_GetByID_Call {
-	return &UserRepositoryMock_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+//   - period domain.StatsPeriod
+func (_e *UserRepositoryMock_Expecter) GetStats(ctx interface{}, period interface{}) *UserRepositoryMock_GetStats_Call {
+	return &UserRepositoryMock_GetStats_Call{Call: _e.mock.On("GetStats", ctx, period)}
 }
 
-func (_c *UserRepositoryMock_GetByID_Call) Run(run func(ctx context.Context, id string)) *UserRepositoryMock_GetByID_Call {
+func (_c *UserRepositoryMock_GetStats_Call) Run(run func(ctx context.Context, period domain.StatsPeriod)) *UserRepositoryMock_GetStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(domain.StatsPeriod))
 	})
 	return _c
 }
 
-func (_c *UserRepositoryMock_GetByID_Call) Return(_a0 *domain.User, _a1 error) *UserRepositoryMock_GetByID_Call {
+func (_c *UserRepositoryMock_GetStats_Call) Return(_a0 *domain.SystemStats, _a1 error) *UserRepositoryMock_GetStats_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *UserRepositoryMock_GetByID_Call) RunAndReturn(run func(context.Context, string) (*domain.User, error)) *UserRepositoryMock_GetByID_Call {
+func (_c *UserRepositoryMock_GetStats_Call) RunAndReturn(run func(context.Context, domain.StatsPeriod) (*domain.SystemStats, error)) *UserRepositoryMock_GetStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -291,6 +354,643 @@ func (_c *UserRepositoryMock_HealthCheck_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListDoctors provides a mock function with given fields: ctx, filter, page
+func (_m *UserRepositoryMock) ListDoctors(ctx context.Context, filter domain.DoctorFilter, page domain.PageRequest) (*domain.Page[*domain.User], error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDoctors")
+	}
+
+	var r0 *domain.Page[*domain.User]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DoctorFilter, domain.PageRequest) (*domain.Page[*domain.User], error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DoctorFilter, domain.PageRequest) *domain.Page[*domain.User]); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[*domain.User])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DoctorFilter, domain.PageRequest) error); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_ListDoctors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDoctors'
+type UserRepositoryMock_ListDoctors_Call struct {
+	*mock.Call
+}
+
+// ListDoctors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.DoctorFilter
+//   - page domain.PageRequest
+func (_e *UserRepositoryMock_Expecter) ListDoctors(ctx interface{}, filter interface{}, page interface{}) *UserRepositoryMock_ListDoctors_Call {
+	return &UserRepositoryMock_ListDoctors_Call{Call: _e.mock.On("ListDoctors", ctx, filter, page)}
+}
+
+func (_c *UserRepositoryMock_ListDoctors_Call) Run(run func(ctx context.Context, filter domain.DoctorFilter, page domain.PageRequest)) *UserRepositoryMock_ListDoctors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DoctorFilter), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_ListDoctors_Call) Return(_a0 *domain.Page[*domain.User], _a1 error) *UserRepositoryMock_ListDoctors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_ListDoctors_Call) RunAndReturn(run func(context.Context, domain.DoctorFilter, domain.PageRequest) (*domain.Page[*domain.User], error)) *UserRepositoryMock_ListDoctors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsers provides a mock function with given fields: ctx, filter, page
+func (_m *UserRepositoryMock) ListUsers(ctx context.Context, filter domain.UserFilter, page domain.PageRequest) (*domain.Page[*domain.User], error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 *domain.Page[*domain.User]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserFilter, domain.PageRequest) (*domain.Page[*domain.User], error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserFilter, domain.PageRequest) *domain.Page[*domain.User]); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[*domain.User])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserFilter, domain.PageRequest) error); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type UserRepositoryMock_ListUsers_Call struct {
+	*mock.Call
+}
+
+// ListUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.UserFilter
+//   - page domain.PageRequest
+func (_e *UserRepositoryMock_Expecter) ListUsers(ctx interface{}, filter interface{}, page interface{}) *UserRepositoryMock_ListUsers_Call {
+	return &UserRepositoryMock_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, filter, page)}
+}
+
+func (_c *UserRepositoryMock_ListUsers_Call) Run(run func(ctx context.Context, filter domain.UserFilter, page domain.PageRequest)) *UserRepositoryMock_ListUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserFilter), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_ListUsers_Call) Return(_a0 *domain.Page[*domain.User], _a1 error) *UserRepositoryMock_ListUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_ListUsers_Call) RunAndReturn(run func(context.Context, domain.UserFilter, domain.PageRequest) (*domain.Page[*domain.User], error)) *UserRepositoryMock_ListUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailVerified provides a mock function with given fields: ctx, id, email, reason, changedBy
+func (_m *UserRepositoryMock) MarkEmailVerified(ctx context.Context, id string, email string, reason string, changedBy string) (*domain.User, error) {
+	ret := _m.Called(ctx, id, email, reason, changedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailVerified")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*domain.User, error)); ok {
+		return rf(ctx, id, email, reason, changedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *domain.User); ok {
+		r0 = rf(ctx, id, email, reason, changedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, id, email, reason, changedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_MarkEmailVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailVerified'
+type UserRepositoryMock_MarkEmailVerified_Call struct {
+	*mock.Call
+}
+
+// MarkEmailVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - email string
+//   - reason string
+//   - changedBy string
+func (_e *UserRepositoryMock_Expecter) MarkEmailVerified(ctx interface{}, id interface{}, email interface{}, reason interface{}, changedBy interface{}) *UserRepositoryMock_MarkEmailVerified_Call {
+	return &UserRepositoryMock_MarkEmailVerified_Call{Call: _e.mock.On("MarkEmailVerified", ctx, id, email, reason, changedBy)}
+}
+
+func (_c *UserRepositoryMock_MarkEmailVerified_Call) Run(run func(ctx context.Context, id string, email string, reason string, changedBy string)) *UserRepositoryMock_MarkEmailVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_MarkEmailVerified_Call) Return(_a0 *domain.User, _a1 error) *UserRepositoryMock_MarkEmailVerified_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_MarkEmailVerified_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*domain.User, error)) *UserRepositoryMock_MarkEmailVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkResetSent provides a mock function with given fields: ctx, id, sentAt, notAfter
+func (_m *UserRepositoryMock) MarkResetSent(ctx context.Context, id string, sentAt time.Time, notAfter time.Time) (bool, error) {
+	ret := _m.Called(ctx, id, sentAt, notAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkResetSent")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, id, sentAt, notAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, id, sentAt, notAfter)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, id, sentAt, notAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_MarkResetSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkResetSent'
+type UserRepositoryMock_MarkResetSent_Call struct {
+	*mock.Call
+}
+
+// MarkResetSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - sentAt time.Time
+//   - notAfter time.Time
+func (_e *UserRepositoryMock_Expecter) MarkResetSent(ctx interface{}, id interface{}, sentAt interface{}, notAfter interface{}) *UserRepositoryMock_MarkResetSent_Call {
+	return &UserRepositoryMock_MarkResetSent_Call{Call: _e.mock.On("MarkResetSent", ctx, id, sentAt, notAfter)}
+}
+
+func (_c *UserRepositoryMock_MarkResetSent_Call) Run(run func(ctx context.Context, id string, sentAt time.Time, notAfter time.Time)) *UserRepositoryMock_MarkResetSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_MarkResetSent_Call) Return(_a0 bool, _a1 error) *UserRepositoryMock_MarkResetSent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_MarkResetSent_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time) (bool, error)) *UserRepositoryMock_MarkResetSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkVerificationSent provides a mock function with given fields: ctx, id, sentAt, notAfter
+func (_m *UserRepositoryMock) MarkVerificationSent(ctx context.Context, id string, sentAt time.Time, notAfter time.Time) (bool, error) {
+	ret := _m.Called(ctx, id, sentAt, notAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkVerificationSent")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, id, sentAt, notAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, id, sentAt, notAfter)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, id, sentAt, notAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_MarkVerificationSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkVerificationSent'
+type UserRepositoryMock_MarkVerificationSent_Call struct {
+	*mock.Call
+}
+
+// MarkVerificationSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - sentAt time.Time
+//   - notAfter time.Time
+func (_e *UserRepositoryMock_Expecter) MarkVerificationSent(ctx interface{}, id interface{}, sentAt interface{}, notAfter interface{}) *UserRepositoryMock_MarkVerificationSent_Call {
+	return &UserRepositoryMock_MarkVerificationSent_Call{Call: _e.mock.On("MarkVerificationSent", ctx, id, sentAt, notAfter)}
+}
+
+func (_c *UserRepositoryMock_MarkVerificationSent_Call) Run(run func(ctx context.Context, id string, sentAt time.Time, notAfter time.Time)) *UserRepositoryMock_MarkVerificationSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_MarkVerificationSent_Call) Return(_a0 bool, _a1 error) *UserRepositoryMock_MarkVerificationSent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_MarkVerificationSent_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time) (bool, error)) *UserRepositoryMock_MarkVerificationSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordActivity provides a mock function with given fields: ctx, id, at
+func (_m *UserRepositoryMock) RecordActivity(ctx context.Context, id string, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordActivity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepositoryMock_RecordActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordActivity'
+type UserRepositoryMock_RecordActivity_Call struct {
+	*mock.Call
+}
+
+// RecordActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - at time.Time
+func (_e *UserRepositoryMock_Expecter) RecordActivity(ctx interface{}, id interface{}, at interface{}) *UserRepositoryMock_RecordActivity_Call {
+	return &UserRepositoryMock_RecordActivity_Call{Call: _e.mock.On("RecordActivity", ctx, id, at)}
+}
+
+func (_c *UserRepositoryMock_RecordActivity_Call) Run(run func(ctx context.Context, id string, at time.Time)) *UserRepositoryMock_RecordActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_RecordActivity_Call) Return(_a0 error) *UserRepositoryMock_RecordActivity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepositoryMock_RecordActivity_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *UserRepositoryMock_RecordActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *UserRepositoryMock) Restore(ctx context.Context, id string) (*domain.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type UserRepositoryMock_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *UserRepositoryMock_Expecter) Restore(ctx interface{}, id interface{}) *UserRepositoryMock_Restore_Call {
+	return &UserRepositoryMock_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *UserRepositoryMock_Restore_Call) Run(run func(ctx context.Context, id string)) *UserRepositoryMock_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_Restore_Call) Return(_a0 *domain.User, _a1 error) *UserRepositoryMock_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_Restore_Call) RunAndReturn(run func(context.Context, string) (*domain.User, error)) *UserRepositoryMock_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDelete provides a mock function with given fields: ctx, id, deletedBy
+func (_m *UserRepositoryMock) SoftDelete(ctx context.Context, id string, deletedBy string) (*domain.User, error) {
+	ret := _m.Called(ctx, id, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.User, error)); ok {
+		return rf(ctx, id, deletedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.User); ok {
+		r0 = rf(ctx, id, deletedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, deletedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_SoftDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDelete'
+type UserRepositoryMock_SoftDelete_Call struct {
+	*mock.Call
+}
+
+// SoftDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - deletedBy string
+func (_e *UserRepositoryMock_Expecter) SoftDelete(ctx interface{}, id interface{}, deletedBy interface{}) *UserRepositoryMock_SoftDelete_Call {
+	return &UserRepositoryMock_SoftDelete_Call{Call: _e.mock.On("SoftDelete", ctx, id, deletedBy)}
+}
+
+func (_c *UserRepositoryMock_SoftDelete_Call) Run(run func(ctx context.Context, id string, deletedBy string)) *UserRepositoryMock_SoftDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_SoftDelete_Call) Return(_a0 *domain.User, _a1 error) *UserRepositoryMock_SoftDelete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_SoftDelete_Call) RunAndReturn(run func(context.Context, string, string) (*domain.User, error)) *UserRepositoryMock_SoftDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function with given fields: ctx, id, hashedPassword
+func (_m *UserRepositoryMock) UpdatePassword(ctx context.Context, id string, hashedPassword string) error {
+	ret := _m.Called(ctx, id, hashedPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, hashedPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepositoryMock_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type UserRepositoryMock_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - hashedPassword string
+func (_e *UserRepositoryMock_Expecter) UpdatePassword(ctx interface{}, id interface{}, hashedPassword interface{}) *UserRepositoryMock_UpdatePassword_Call {
+	return &UserRepositoryMock_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, hashedPassword)}
+}
+
+func (_c *UserRepositoryMock_UpdatePassword_Call) Run(run func(ctx context.Context, id string, hashedPassword string)) *UserRepositoryMock_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdatePassword_Call) Return(_a0 error) *UserRepositoryMock_UpdatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdatePassword_Call) RunAndReturn(run func(context.Context, string, string) error) *UserRepositoryMock_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, id, status, reason, changedBy
+func (_m *UserRepositoryMock) UpdateStatus(ctx context.Context, id string, status domain.UserStatus, reason string, changedBy string) (*domain.User, error) {
+	ret := _m.Called(ctx, id, status, reason, changedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.UserStatus, string, string) (*domain.User, error)); ok {
+		return rf(ctx, id, status, reason, changedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.UserStatus, string, string) *domain.User); ok {
+		r0 = rf(ctx, id, status, reason, changedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.UserStatus, string, string) error); ok {
+		r1 = rf(ctx, id, status, reason, changedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type UserRepositoryMock_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - status domain.UserStatus
+//   - reason string
+//   - changedBy string
+func (_e *UserRepositoryMock_Expecter) UpdateStatus(ctx interface{}, id interface{}, status interface{}, reason interface{}, changedBy interface{}) *UserRepositoryMock_UpdateStatus_Call {
+	return &UserRepositoryMock_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, id, status, reason, changedBy)}
+}
+
+func (_c *UserRepositoryMock_UpdateStatus_Call) Run(run func(ctx context.Context, id string, status domain.UserStatus, reason string, changedBy string)) *UserRepositoryMock_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.UserStatus), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdateStatus_Call) Return(_a0 *domain.User, _a1 error) *UserRepositoryMock_UpdateStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, domain.UserStatus, string, string) (*domain.User, error)) *UserRepositoryMock_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function with given fields: ctx, id, update
+func (_m *UserRepositoryMock) UpdateUser(ctx context.Context, id string, update domain.UserUpdate) (*domain.User, error) {
+	ret := _m.Called(ctx, id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.UserUpdate) (*domain.User, error)); ok {
+		return rf(ctx, id, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.UserUpdate) *domain.User); ok {
+		r0 = rf(ctx, id, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.UserUpdate) error); ok {
+		r1 = rf(ctx, id, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type UserRepositoryMock_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - update domain.UserUpdate
+func (_e *UserRepositoryMock_Expecter) UpdateUser(ctx interface{}, id interface{}, update interface{}) *UserRepositoryMock_UpdateUser_Call {
+	return &UserRepositoryMock_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, id, update)}
+}
+
+func (_c *UserRepositoryMock_UpdateUser_Call) Run(run func(ctx context.Context, id string, update domain.UserUpdate)) *UserRepositoryMock_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.UserUpdate))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdateUser_Call) Return(_a0 *domain.User, _a1 error) *UserRepositoryMock_UpdateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdateUser_Call) RunAndReturn(run func(context.Context, string, domain.UserUpdate) (*domain.User, error)) *UserRepositoryMock_UpdateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepositoryMock creates a new instance of UserRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepositoryMock(t interface {
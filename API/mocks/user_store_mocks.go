@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	domain "github.com/vida-plus/api/internal/domain"
+
+	time "time"
 )
 
 // UserStoreMock is an autogenerated mock type for the UserStore type
@@ -187,6 +189,116 @@ func (_c *UserStoreMock_GetByID_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// RecordActivity provides a mock function with given fields: ctx, id, at
+func (_m *UserStoreMock) RecordActivity(ctx context.Context, id string, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordActivity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserStoreMock_RecordActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordActivity'
+type UserStoreMock_RecordActivity_Call struct {
+	*mock.Call
+}
+
+// RecordActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - at time.Time
+func (_e *UserStoreMock_Expecter) RecordActivity(ctx interface{}, id interface{}, at interface{}) *UserStoreMock_RecordActivity_Call {
+	return &UserStoreMock_RecordActivity_Call{Call: _e.mock.On("RecordActivity", ctx, id, at)}
+}
+
+func (_c *UserStoreMock_RecordActivity_Call) Run(run func(ctx context.Context, id string, at time.Time)) *UserStoreMock_RecordActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UserStoreMock_RecordActivity_Call) Return(_a0 error) *UserStoreMock_RecordActivity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserStoreMock_RecordActivity_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *UserStoreMock_RecordActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, id, status, reason, changedBy
+func (_m *UserStoreMock) UpdateStatus(ctx context.Context, id string, status domain.UserStatus, reason string, changedBy string) (*domain.User, error) {
+	ret := _m.Called(ctx, id, status, reason, changedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.UserStatus, string, string) (*domain.User, error)); ok {
+		return rf(ctx, id, status, reason, changedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.UserStatus, string, string) *domain.User); ok {
+		r0 = rf(ctx, id, status, reason, changedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.UserStatus, string, string) error); ok {
+		r1 = rf(ctx, id, status, reason, changedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserStoreMock_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type UserStoreMock_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - status domain.UserStatus
+//   - reason string
+//   - changedBy string
+func (_e *UserStoreMock_Expecter) UpdateStatus(ctx interface{}, id interface{}, status interface{}, reason interface{}, changedBy interface{}) *UserStoreMock_UpdateStatus_Call {
+	return &UserStoreMock_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, id, status, reason, changedBy)}
+}
+
+func (_c *UserStoreMock_UpdateStatus_Call) Run(run func(ctx context.Context, id string, status domain.UserStatus, reason string, changedBy string)) *UserStoreMock_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.UserStatus), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *UserStoreMock_UpdateStatus_Call) Return(_a0 *domain.User, _a1 error) *UserStoreMock_UpdateStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserStoreMock_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, domain.UserStatus, string, string) (*domain.User, error)) *UserStoreMock_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserStoreMock creates a new instance of UserStoreMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserStoreMock(t interface {
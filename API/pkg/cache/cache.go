@@ -0,0 +1,70 @@
+// Package cache provides a small in-memory cache with per-entry expiration.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// purgeInterval is how many writes happen between sweeps of expired entries.
+const purgeInterval = 256
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// Cache is a concurrency-safe map whose entries expire after a TTL.
+type Cache[K comparable, V any] struct {
+	mu     sync.RWMutex
+	items  map[K]entry[V]
+	writes int
+}
+
+// New creates an empty cache
+func New[K comparable, V any]() *Cache[K, V] {
+	return &Cache[K, V]{items: make(map[K]entry[V])}
+}
+
+// Get returns the cached value for key if it exists and has not expired
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.RLock()
+	item, ok := c.items[key]
+	c.mu.RUnlock()
+
+	if !ok || time.Now().After(item.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return item.value, true
+}
+
+// Set stores value under key for the given TTL
+func (c *Cache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items[key] = entry[V]{value: value, expiresAt: time.Now().Add(ttl)}
+
+	c.writes++
+	if c.writes%purgeInterval == 0 {
+		c.purgeExpired()
+	}
+}
+
+// Delete removes key from the cache
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.items, key)
+}
+
+func (c *Cache[K, V]) purgeExpired() {
+	now := time.Now()
+	for key, item := range c.items {
+		if now.After(item.expiresAt) {
+			delete(c.items, key)
+		}
+	}
+}
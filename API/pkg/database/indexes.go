@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Server error codes of index definitions that clash with an existing index
+const (
+	indexOptionsConflict  = 85
+	indexKeySpecsConflict = 86
+)
+
+// CollectionIndexes declares the indexes a collection must have
+type CollectionIndexes struct {
+	Collection string
+	Indexes    []mongo.IndexModel
+}
+
+// EnsureIndexes creates the declared indexes at startup. Creating an index that already exists
+// does nothing, and an existing index whose definition changed, such as one that became unique,
+// is dropped and created again. Unique indexes fail to build while the collection has duplicates.
+func EnsureIndexes(ctx context.Context, db *mongo.Database, declared []CollectionIndexes) error {
+	for _, collection := range declared {
+		logger := slog.With(slog.String("collection", collection.Collection))
+		indexes := db.Collection(collection.Collection).Indexes()
+
+		for _, index := range collection.Indexes {
+			_, err := indexes.CreateOne(ctx, index)
+			if isIndexConflict(err) && index.Options != nil && index.Options.Name != nil {
+				logger.Warn("index definition changed, recreating it", slog.String("index", *index.Options.Name))
+				if _, err = indexes.DropOne(ctx, *index.Options.Name); err == nil {
+					_, err = indexes.CreateOne(ctx, index)
+				}
+			}
+			if err != nil {
+				logger.Error("failed to create index", slog.Any("keys", index.Keys), slog.Any("error", err))
+				return fmt.Errorf("creating index of %s: %w", collection.Collection, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isIndexConflict checks if an index couldn't be created because another one has its name
+// or keys with different options
+func isIndexConflict(err error) bool {
+	var cmdErr mongo.CommandError
+	if !errors.As(err, &cmdErr) {
+		return false
+	}
+	return cmdErr.Code == indexOptionsConflict || cmdErr.Code == indexKeySpecsConflict
+}
@@ -11,12 +11,30 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// MongoConfig holds the connection settings of the MongoDB client
+type MongoConfig struct {
+	URI string
+	// ConnectTimeout bounds connecting and the first ping, and how long operations wait for a server
+	ConnectTimeout  time.Duration
+	MaxPoolSize     uint64
+	MinPoolSize     uint64
+	MaxConnIdleTime time.Duration
+}
+
 // InitMongoDB initializes and returns a MongoDB client
-func InitMongoDB() *mongo.Client {
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+func InitMongoDB(cfg MongoConfig) *mongo.Client {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
 	defer cancel()
 
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI("mongodb://localhost:27017/vida_plus"))
+	clientOptions := options.Client().
+		ApplyURI(cfg.URI).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetServerSelectionTimeout(cfg.ConnectTimeout).
+		SetMaxPoolSize(cfg.MaxPoolSize).
+		SetMinPoolSize(cfg.MinPoolSize).
+		SetMaxConnIdleTime(cfg.MaxConnIdleTime)
+
+	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		slog.Error("error connecting to MongoDB", slog.Any("error", err))
 		os.Exit(1)
@@ -4,6 +4,9 @@ package pkg
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // GenerateID generates a random unique identifier.
@@ -12,3 +15,24 @@ func GenerateID() string {
 	rand.Read(bytes)
 	return hex.EncodeToString(bytes)
 }
+
+// GenerateTimeID generates a random unique identifier that records t with millisecond
+// precision, which TimeFromID reads back.
+func GenerateTimeID(t time.Time) string {
+	bytes := make([]byte, 10)
+	rand.Read(bytes)
+	return strconv.FormatInt(t.UnixMilli(), 36) + "." + hex.EncodeToString(bytes)
+}
+
+// TimeFromID returns the time recorded in an identifier generated by GenerateTimeID.
+func TimeFromID(id string) (time.Time, bool) {
+	prefix, _, found := strings.Cut(id, ".")
+	if !found {
+		return time.Time{}, false
+	}
+	millis, err := strconv.ParseInt(prefix, 36, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(millis), true
+}
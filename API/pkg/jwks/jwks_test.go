@@ -0,0 +1,105 @@
+package jwks
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_JWKS_Thumbprint checks the Ed25519 example of RFC 8037 appendix A.
+func Test_JWKS_Thumbprint(t *testing.T) {
+	seed, err := b64.DecodeString("nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A")
+	require.NoError(t, err)
+
+	key, err := NewKey("", ed25519.NewKeyFromSeed(seed), time.Time{})
+	require.NoError(t, err)
+
+	assert.Equal(t, AlgorithmEdDSA, key.Algorithm)
+	assert.Equal(t, "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo", key.JWK().X)
+	assert.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", key.ID)
+}
+
+func Test_JWKS_ParsePEM(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmRS256, AlgorithmEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			generated, err := GenerateKey(algorithm, time.Time{})
+			require.NoError(t, err)
+
+			der, err := x509.MarshalPKCS8PrivateKey(generated.Signer)
+			require.NoError(t, err)
+
+			parsed, err := ParsePEM("", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), time.Time{})
+			require.NoError(t, err)
+			assert.Equal(t, generated.ID, parsed.ID)
+			assert.Equal(t, generated.JWK(), parsed.JWK())
+		})
+	}
+}
+
+func Test_JWKS_KeySet_rotation(t *testing.T) {
+	now := time.Now()
+	old, err := GenerateKey(AlgorithmEdDSA, now.Add(-time.Hour))
+	require.NoError(t, err)
+	next, err := GenerateKey(AlgorithmEdDSA, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	set := NewKeySet(24*time.Hour, old)
+	set.now = func() time.Time { return now }
+	set.Add(next)
+
+	// The scheduled key is published but does not sign yet
+	active, err := set.Active()
+	require.NoError(t, err)
+	assert.Equal(t, old.ID, active.ID)
+	assert.Len(t, set.JWKS().Keys, 2)
+
+	// Once it takes over, the old key only verifies during the grace period
+	now = now.Add(2 * time.Hour)
+	active, err = set.Active()
+	require.NoError(t, err)
+	assert.Equal(t, next.ID, active.ID)
+	_, ok := set.Lookup(old.ID)
+	assert.True(t, ok)
+
+	now = now.Add(24 * time.Hour)
+	_, ok = set.Lookup(old.ID)
+	assert.False(t, ok)
+	assert.Equal(t, []JSONWebKey{next.JWK()}, set.JWKS().Keys)
+}
+
+func Test_JWKS_Load_dir(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	write := func(id string, activeFrom time.Time) {
+		key, err := GenerateKey(AlgorithmRS256, activeFrom)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKCS8PrivateKey(key.Signer)
+		require.NoError(t, err)
+
+		path := filepath.Join(dir, id+".pem")
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+		require.NoError(t, os.Chtimes(path, activeFrom, activeFrom))
+	}
+	write("2026-01", now.Add(-48*time.Hour))
+	write("2026-02", now.Add(-time.Hour))
+
+	cfg := DefaultConfig()
+	cfg.Dir = dir
+	set, err := Load(cfg)
+	require.NoError(t, err)
+
+	active, err := set.Active()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-02", active.ID)
+	assert.Equal(t, AlgorithmRS256, active.Algorithm)
+	_, ok := set.Lookup("2026-01")
+	assert.True(t, ok)
+}
@@ -0,0 +1,158 @@
+// Package jwks manages asymmetric JWT signing keys and publishes them as a JSON Web Key Set (RFC 7517).
+package jwks
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Supported signing algorithms.
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// minRSABits is the smallest RSA modulus accepted for signing keys.
+const minRSABits = 2048
+
+// Key is a private signing key. It signs tokens from ActiveFrom until a newer key becomes active.
+type Key struct {
+	ID         string
+	Algorithm  string
+	Signer     crypto.Signer
+	ActiveFrom time.Time
+}
+
+// JSONWebKey is the public part of a key as published in the key set (RFC 7517 and RFC 8037).
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+// Set is a JSON Web Key Set.
+type Set struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+var b64 = base64.RawURLEncoding
+
+// NewKey wraps an RSA or Ed25519 private key. An empty id is replaced by the key thumbprint (RFC 7638).
+func NewKey(id string, signer crypto.Signer, activeFrom time.Time) (*Key, error) {
+	key := &Key{ID: id, Signer: signer, ActiveFrom: activeFrom}
+	switch k := signer.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("rsa key must have at least %d bits", minRSABits)
+		}
+		key.Algorithm = AlgorithmRS256
+	case ed25519.PrivateKey:
+		key.Algorithm = AlgorithmEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", signer)
+	}
+
+	if key.ID == "" {
+		key.ID = key.Thumbprint()
+	}
+	return key, nil
+}
+
+// GenerateKey creates a random key for the given algorithm.
+func GenerateKey(algorithm string, activeFrom time.Time) (*Key, error) {
+	var signer crypto.Signer
+	var err error
+	switch algorithm {
+	case AlgorithmRS256:
+		signer, err = rsa.GenerateKey(rand.Reader, minRSABits)
+	case AlgorithmEdDSA:
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewKey("", signer, activeFrom)
+}
+
+// ParsePEM parses a PKCS#8 or PKCS#1 encoded private key.
+func ParsePEM(id string, data []byte, activeFrom time.Time) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+	return NewKey(id, signer, activeFrom)
+}
+
+// Public returns the public key used to verify signatures.
+func (k *Key) Public() crypto.PublicKey {
+	return k.Signer.Public()
+}
+
+// JWK returns the public JSON Web Key.
+func (k *Key) JWK() JSONWebKey {
+	jwk := JSONWebKey{KeyID: k.ID, Use: "sig", Algorithm: k.Algorithm}
+	switch pub := k.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = b64.EncodeToString(pub.N.Bytes())
+		jwk.E = b64.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = b64.EncodeToString(pub)
+	}
+	return jwk
+}
+
+// Thumbprint returns the RFC 7638 SHA-256 thumbprint of the public key.
+func (k *Key) Thumbprint() string {
+	jwk := k.JWK()
+
+	// Required members only, in lexicographic order
+	var canonical string
+	switch jwk.KeyType {
+	case "RSA":
+		canonical = fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, jwk.E, jwk.N)
+	case "OKP":
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":"OKP","x":%q}`, jwk.Curve, jwk.X)
+	}
+
+	sum := sha256.Sum256([]byte(canonical))
+	return b64.EncodeToString(sum[:])
+}
@@ -0,0 +1,124 @@
+package jwks
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrNoActiveKey is returned when no key can sign yet.
+var ErrNoActiveKey = errors.New("no active signing key")
+
+// KeySet holds the signing keys of the service. The newest key whose ActiveFrom has passed
+// signs new tokens. Older keys keep verifying tokens for a grace period after a newer key
+// takes over, and keys scheduled for the future are published ahead of time so verifiers
+// can cache them before they are used.
+type KeySet struct {
+	mu    sync.RWMutex
+	keys  []*Key // sorted by ActiveFrom
+	grace time.Duration
+	now   func() time.Time
+}
+
+// NewKeySet creates a key set that keeps retired keys for grace.
+func NewKeySet(grace time.Duration, keys ...*Key) *KeySet {
+	s := &KeySet{grace: grace, now: time.Now}
+	s.Replace(keys)
+	return s
+}
+
+// Add adds a key to the set, replacing any key with the same ID, and drops keys whose grace period ended.
+func (s *KeySet) Add(key *Key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]*Key, 0, len(s.keys)+1)
+	for _, k := range s.keys {
+		if k.ID != key.ID {
+			keys = append(keys, k)
+		}
+	}
+	s.keys = s.prune(append(keys, key))
+}
+
+// Replace swaps every key of the set, as when reloading keys from disk.
+func (s *KeySet) Replace(keys []*Key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys = s.prune(append([]*Key(nil), keys...))
+}
+
+// Active returns the key that signs new tokens.
+func (s *KeySet) Active() (*Key, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := s.now()
+	for i := len(s.keys) - 1; i >= 0; i-- {
+		if !s.keys[i].ActiveFrom.After(now) {
+			return s.keys[i], nil
+		}
+	}
+	return nil, ErrNoActiveKey
+}
+
+// Lookup returns the key with the given ID if it may still verify tokens.
+func (s *KeySet) Lookup(id string) (*Key, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := s.now()
+	for i, k := range s.keys {
+		if k.ID == id {
+			return k, s.verifiable(i, now)
+		}
+	}
+	return nil, false
+}
+
+// JWKS returns the public keys that may verify tokens, newest first.
+func (s *KeySet) JWKS() Set {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := s.now()
+	set := Set{Keys: []JSONWebKey{}}
+	for i := len(s.keys) - 1; i >= 0; i-- {
+		if s.verifiable(i, now) {
+			set.Keys = append(set.Keys, s.keys[i].JWK())
+		}
+	}
+	return set
+}
+
+// retiredAt returns when the key at index i stopped signing, or the zero time if it has not.
+func (s *KeySet) retiredAt(i int, now time.Time) time.Time {
+	for _, next := range s.keys[i+1:] {
+		if !next.ActiveFrom.After(now) {
+			return next.ActiveFrom
+		}
+	}
+	return time.Time{}
+}
+
+func (s *KeySet) verifiable(i int, now time.Time) bool {
+	retired := s.retiredAt(i, now)
+	return retired.IsZero() || now.Before(retired.Add(s.grace))
+}
+
+// prune sorts keys and removes those whose grace period ended. Callers must hold the lock.
+func (s *KeySet) prune(keys []*Key) []*Key {
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].ActiveFrom.Before(keys[j].ActiveFrom) })
+
+	s.keys = keys
+	now := s.now()
+	kept := make([]*Key, 0, len(keys))
+	for i, k := range keys {
+		if s.verifiable(i, now) {
+			kept = append(kept, k)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,156 @@
+package jwks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// PublishAhead is how long generated keys are published before they start signing. It must
+// exceed how long verifiers cache the key set.
+const PublishAhead = 10 * time.Minute
+
+// Config controls where signing keys come from and how they rotate.
+type Config struct {
+	// Dir holds one PEM private key per file, named <kid>.pem. The modification time of a
+	// file is when its key becomes active, so a future time schedules a rotation.
+	Dir string
+	// PrivateKey is a PEM private key passed directly, identified by KeyID.
+	PrivateKey string
+	KeyID      string
+	// Algorithm of the keys generated when neither Dir nor PrivateKey is set.
+	Algorithm string
+	// RotationInterval is how often generated keys are replaced.
+	RotationInterval time.Duration
+	// ReloadInterval is how often Dir is read again.
+	ReloadInterval time.Duration
+	// GracePeriod is how long a replaced key keeps verifying tokens. It must cover the
+	// longest token lifetime.
+	GracePeriod time.Duration
+}
+
+// DefaultConfig generates EdDSA keys that rotate daily and verify for 7 days after rotation.
+func DefaultConfig() Config {
+	return Config{
+		Algorithm:        AlgorithmEdDSA,
+		RotationInterval: 24 * time.Hour,
+		ReloadInterval:   time.Minute,
+		GracePeriod:      7 * 24 * time.Hour,
+	}
+}
+
+// Generated reports whether keys are generated in memory rather than loaded. Generated keys
+// are lost on restart and are not shared between replicas.
+func (c Config) Generated() bool {
+	return c.Dir == "" && c.PrivateKey == ""
+}
+
+// Load builds the key set described by cfg.
+func Load(cfg Config) (*KeySet, error) {
+	if cfg.Generated() {
+		key, err := GenerateKey(cfg.Algorithm, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		return NewKeySet(cfg.GracePeriod, key), nil
+	}
+
+	keys, err := loadKeys(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	set := NewKeySet(cfg.GracePeriod, keys...)
+	if _, err := set.Active(); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// Run keeps set up to date until ctx is done, rotating generated keys or reloading Dir.
+func Run(ctx context.Context, set *KeySet, cfg Config) {
+	logger := slog.With(slog.String("func", "jwks.Run"))
+
+	interval := cfg.ReloadInterval
+	if cfg.Generated() {
+		interval = cfg.RotationInterval
+	} else if cfg.Dir == "" {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if cfg.Generated() {
+			key, err := GenerateKey(cfg.Algorithm, time.Now().Add(PublishAhead))
+			if err != nil {
+				logger.Error("error generating signing key", slog.Any("error", err))
+				continue
+			}
+			set.Add(key)
+			logger.Info("signing key scheduled", slog.String("kid", key.ID), slog.Time("activeFrom", key.ActiveFrom))
+			continue
+		}
+
+		keys, err := loadKeys(cfg)
+		if err != nil {
+			logger.Error("error reloading signing keys, keeping current keys", slog.Any("error", err))
+			continue
+		}
+		set.Replace(keys)
+	}
+}
+
+// loadKeys reads the keys of Dir and PrivateKey.
+func loadKeys(cfg Config) ([]*Key, error) {
+	var keys []*Key
+
+	if cfg.PrivateKey != "" {
+		key, err := ParsePEM(cfg.KeyID, []byte(cfg.PrivateKey), time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("parsing private key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if cfg.Dir != "" {
+		paths, err := filepath.Glob(filepath.Join(cfg.Dir, "*.pem"))
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, err
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+
+			id := strings.TrimSuffix(filepath.Base(path), ".pem")
+			key, err := ParsePEM(id, data, info.ModTime())
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s: %w", path, err)
+			}
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys found")
+	}
+	return keys, nil
+}
@@ -7,79 +7,186 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg/jwks"
 )
 
+// JWTConfig holds the registered claims issued in and required from every token.
+type JWTConfig struct {
+	Issuer   string
+	Audience string
+	// ClockSkew is the tolerance applied to exp, nbf and iat when validating tokens.
+	ClockSkew time.Duration
+	// AccessTokenTTL and RefreshTokenTTL are the lifetimes of the tokens of a session.
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+// DefaultJWTConfig returns the configuration used for local development.
+func DefaultJWTConfig() JWTConfig {
+	return JWTConfig{
+		Issuer:          "https://api.vidaplus.com",
+		Audience:        "vida-plus",
+		ClockSkew:       30 * time.Second,
+		AccessTokenTTL:  24 * time.Hour,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+	}
+}
+
 // JWTManagerImpl implements JWTManager interface.
 type JWTManagerImpl struct {
-	secret string
+	keys   *jwks.KeySet
+	config JWTConfig
+	parser *jwt.Parser
 }
 
-func NewJWTManager() domain.JWTManager {
-	return &JWTManagerImpl{secret: "local-development-secret-key"} // Chave fixa para desenvolvimento local
+// NewJWTManager creates a JWTManager that signs with the active key of keys and accepts
+// tokens signed by any key still in the set.
+func NewJWTManager(keys *jwks.KeySet, config JWTConfig) domain.JWTManager {
+	return &JWTManagerImpl{
+		keys:   keys,
+		config: config,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{jwks.AlgorithmRS256, jwks.AlgorithmEdDSA}),
+			jwt.WithIssuer(config.Issuer),
+			jwt.WithAudience(config.Audience),
+			jwt.WithLeeway(config.ClockSkew),
+			jwt.WithExpirationRequired(),
+			jwt.WithIssuedAt(),
+		),
+	}
 }
 
-// Generate generates a JWT token for a user.
-func (j *JWTManagerImpl) Generate(user *domain.User) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id":   user.ID,
-		"email":     user.Email,
-		"user_type": user.Type,
-		"exp":       time.Now().Add(24 * time.Hour).Unix(),
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secret))
+// registeredClaims returns the registered claims of a new token for subject.
+func (j *JWTManagerImpl) registeredClaims(id, subject string, issuedAt, expiresAt time.Time) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		ID:        id,
+		Issuer:    j.config.Issuer,
+		Subject:   subject,
+		Audience:  jwt.ClaimStrings{j.config.Audience},
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		NotBefore: jwt.NewNumericDate(issuedAt),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
 }
 
-// Validate validates a JWT token and returns the claims.
-func (j *JWTManagerImpl) Validate(tokenStr string) (*domain.AuthClaims, error) {
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(j.secret), nil
-	})
-	if err != nil || !token.Valid {
-		return nil, errors.New("invalid token")
+// sign signs claims with the active key, identified by the kid header.
+func (j *JWTManagerImpl) sign(claims jwt.Claims) (string, error) {
+	key, err := j.keys.Active()
+	if err != nil {
+		return "", err
 	}
-	claims, ok := token.Claims.(jwt.MapClaims)
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Signer)
+}
+
+// keyFunc returns the public key matching the kid header of a token.
+func (j *JWTManagerImpl) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := j.keys.Lookup(kid)
 	if !ok {
-		return nil, errors.New("invalid claims")
-	}
-	userID, _ := claims["user_id"].(string)
-	email, _ := claims["email"].(string)
-	userTypeStr, _ := claims["user_type"].(string)
-	userType := domain.UserType(userTypeStr)
-
-	return &domain.AuthClaims{
-		UserID:   userID,
-		Email:    email,
-		UserType: userType,
-	}, nil
+		return nil, errors.New("unknown signing key")
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, errors.New("unexpected signing method")
+	}
+	return key.Public(), nil
+}
+
+// parseAuthClaims validates a token and checks it is of the expected type.
+func (j *JWTManagerImpl) parseAuthClaims(tokenStr string, tokenType domain.TokenType) (*domain.AuthClaims, error) {
+	token, err := j.parser.ParseWithClaims(tokenStr, &domain.AuthClaims{}, j.keyFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*domain.AuthClaims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	if claims.Type != tokenType {
+		return nil, errors.New("invalid token type")
+	}
+	if claims.ID == "" || claims.Subject == "" || claims.Subject != claims.UserID {
+		return nil, errors.New("invalid token subject")
+	}
+
+	return claims, nil
+}
+
+// Generate generates an access token for a user.
+func (j *JWTManagerImpl) Generate(user *domain.User) (string, error) {
+	now := time.Now()
+	claims := &domain.AuthClaims{
+		Type:     domain.TokenTypeAccess,
+		UserID:   user.ID,
+		Email:    user.Email,
+		UserType: user.Type,
+		// The jti records the issue time more precisely than iat, for session revocations
+		RegisteredClaims: j.registeredClaims(GenerateTimeID(now), user.ID, now, now.Add(j.config.AccessTokenTTL)),
+	}
+	return j.sign(claims)
+}
+
+// Validate validates an access token and returns the claims.
+func (j *JWTManagerImpl) Validate(tokenStr string) (*domain.AuthClaims, error) {
+	return j.parseAuthClaims(tokenStr, domain.TokenTypeAccess)
 }
 
-func (j *JWTManagerImpl) GenerateRefreshToken(user *domain.User) (string, error) {
+// GenerateRefreshToken signs a refresh token for a persisted token record.
+func (j *JWTManagerImpl) GenerateRefreshToken(refreshToken *domain.RefreshToken) (string, error) {
 	claims := &domain.AuthClaims{
-		UserID: user.GetID(),
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(7 * 24 * time.Hour)), // 7 dias
-		},
+		Type:     domain.TokenTypeRefresh,
+		UserID:   refreshToken.UserID,
+		FamilyID: refreshToken.FamilyID,
+		RegisteredClaims: j.registeredClaims(refreshToken.ID, refreshToken.UserID,
+			refreshToken.CreatedAt, refreshToken.ExpiresAt),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secret))
+	return j.sign(claims)
 }
 
+// ValidateRefreshToken validates a refresh token and returns the claims.
 func (j *JWTManagerImpl) ValidateRefreshToken(token string) (*domain.AuthClaims, error) {
-	parsedToken, err := jwt.ParseWithClaims(token, &domain.AuthClaims{}, func(t *jwt.Token) (interface{}, error) {
-		return []byte(j.secret), nil
-	})
+	claims, err := j.parseAuthClaims(token, domain.TokenTypeRefresh)
 	if err != nil {
 		return nil, err
 	}
+	if claims.FamilyID == "" {
+		return nil, errors.New("invalid token family")
+	}
+	return claims, nil
+}
+
+// GenerateScopedToken signs a token that can only be used for the given purpose.
+func (j *JWTManagerImpl) GenerateScopedToken(userID, email string, purpose domain.TokenPurpose, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := &domain.ScopedClaims{
+		Type:             domain.TokenTypeScoped,
+		Email:            email,
+		Purpose:          purpose,
+		RegisteredClaims: j.registeredClaims(GenerateID(), userID, now, now.Add(ttl)),
+	}
+	return j.sign(claims)
+}
 
-	claims, ok := parsedToken.Claims.(*domain.AuthClaims)
+// ValidateScopedToken validates a scoped token and checks it was issued for the given purpose.
+func (j *JWTManagerImpl) ValidateScopedToken(token string, purpose domain.TokenPurpose) (*domain.ScopedClaims, error) {
+	parsedToken, err := j.parser.ParseWithClaims(token, &domain.ScopedClaims{}, j.keyFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := parsedToken.Claims.(*domain.ScopedClaims)
 	if !ok || !parsedToken.Valid {
 		return nil, errors.New("invalid token")
 	}
+	if claims.Type != domain.TokenTypeScoped {
+		return nil, errors.New("invalid token type")
+	}
+	if claims.Purpose != purpose || claims.Subject == "" {
+		return nil, errors.New("invalid token purpose")
+	}
 
 	return claims, nil
 }
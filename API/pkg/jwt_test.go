@@ -0,0 +1,136 @@
+package pkg
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg/jwks"
+)
+
+func newTestJWTManager(t *testing.T) (*JWTManagerImpl, *jwks.Key) {
+	key, err := jwks.GenerateKey(jwks.AlgorithmEdDSA, time.Now())
+	require.NoError(t, err)
+	return NewJWTManager(jwks.NewKeySet(time.Hour, key), DefaultJWTConfig()).(*JWTManagerImpl), key
+}
+
+func Test_JWT_Validate_registeredClaims(t *testing.T) {
+	manager, _ := newTestJWTManager(t)
+	user := &domain.User{ID: "user-1", Email: "user@test.com", Type: domain.UserTypeDoctor}
+
+	token, err := manager.Generate(user)
+	require.NoError(t, err)
+
+	claims, err := manager.Validate(token)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TokenTypeAccess, claims.Type)
+	assert.Equal(t, user.ID, claims.UserID)
+	assert.Equal(t, user.ID, claims.Subject)
+	assert.Equal(t, user.Type, claims.UserType)
+	assert.Equal(t, "https://api.vidaplus.com", claims.Issuer)
+	assert.Equal(t, jwt.ClaimStrings{"vida-plus"}, claims.Audience)
+	assert.NotEmpty(t, claims.ID)
+	assert.NotNil(t, claims.IssuedAt)
+	assert.NotNil(t, claims.NotBefore)
+	assert.NotNil(t, claims.ExpiresAt)
+}
+
+func Test_JWT_numericDatesAreWholeSeconds(t *testing.T) {
+	manager, _ := newTestJWTManager(t)
+
+	token, err := manager.Generate(&domain.User{ID: "user-1"})
+	require.NoError(t, err)
+
+	// Other services verify these tokens, and many only accept integer NumericDates
+	payload, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[1])
+	require.NoError(t, err)
+	var claims map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(payload, &claims))
+	for _, claim := range []string{"iat", "nbf", "exp"} {
+		assert.Regexp(t, `^[0-9]+$`, string(claims[claim]), claim)
+	}
+}
+
+func Test_JWT_tokenTypes(t *testing.T) {
+	manager, _ := newTestJWTManager(t)
+
+	access, err := manager.Generate(&domain.User{ID: "user-1"})
+	require.NoError(t, err)
+	refresh, err := manager.GenerateRefreshToken(&domain.RefreshToken{
+		ID: "token-1", FamilyID: "family-1", UserID: "user-1",
+		CreatedAt: time.Now(), ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	scoped, err := manager.GenerateScopedToken("user-1", "user@test.com", domain.TokenPurposeMFAChallenge, time.Minute)
+	require.NoError(t, err)
+
+	_, err = manager.Validate(access)
+	assert.NoError(t, err)
+	_, err = manager.ValidateRefreshToken(refresh)
+	assert.NoError(t, err)
+	_, err = manager.ValidateScopedToken(scoped, domain.TokenPurposeMFAChallenge)
+	assert.NoError(t, err)
+
+	for name, token := range map[string]string{"REFRESH": refresh, "SCOPED": scoped} {
+		_, err := manager.Validate(token)
+		assert.Errorf(t, err, "%s token accepted as access token", name)
+	}
+	for name, token := range map[string]string{"ACCESS": access, "SCOPED": scoped} {
+		_, err := manager.ValidateRefreshToken(token)
+		assert.Errorf(t, err, "%s token accepted as refresh token", name)
+	}
+	_, err = manager.ValidateScopedToken(access, domain.TokenPurposeMFAChallenge)
+	assert.Error(t, err)
+}
+
+func Test_JWT_Validate_rejects(t *testing.T) {
+	manager, key := newTestJWTManager(t)
+	now := time.Now()
+
+	valid := func() *domain.AuthClaims {
+		return &domain.AuthClaims{
+			Type:             domain.TokenTypeAccess,
+			UserID:           "user-1",
+			RegisteredClaims: manager.registeredClaims("token-1", "user-1", now, now.Add(time.Hour)),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(c *domain.AuthClaims)
+		valid  bool
+	}{
+		{"VALID", func(c *domain.AuthClaims) {}, true},
+		{"WRONG ISSUER", func(c *domain.AuthClaims) { c.Issuer = "https://evil.example.com" }, false},
+		{"WRONG AUDIENCE", func(c *domain.AuthClaims) { c.Audience = jwt.ClaimStrings{"other-service"} }, false},
+		{"MISSING EXPIRATION", func(c *domain.AuthClaims) { c.ExpiresAt = nil }, false},
+		{"MISSING JTI", func(c *domain.AuthClaims) { c.ID = "" }, false},
+		{"SUBJECT MISMATCH", func(c *domain.AuthClaims) { c.Subject = "user-2" }, false},
+		{"EXPIRED WITHIN SKEW", func(c *domain.AuthClaims) { c.ExpiresAt = jwt.NewNumericDate(now.Add(-10 * time.Second)) }, true},
+		{"EXPIRED", func(c *domain.AuthClaims) { c.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute)) }, false},
+		{"NOT YET VALID WITHIN SKEW", func(c *domain.AuthClaims) { c.NotBefore = jwt.NewNumericDate(now.Add(10 * time.Second)) }, true},
+		{"NOT YET VALID", func(c *domain.AuthClaims) { c.NotBefore = jwt.NewNumericDate(now.Add(time.Minute)) }, false},
+		{"ISSUED IN THE FUTURE", func(c *domain.AuthClaims) { c.IssuedAt = jwt.NewNumericDate(now.Add(time.Minute)) }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := valid()
+			tt.mutate(claims)
+
+			token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+			token.Header["kid"] = key.ID
+			signed, err := token.SignedString(key.Signer)
+			require.NoError(t, err)
+
+			_, err = manager.Validate(signed)
+			assert.Equal(t, tt.valid, err == nil, "Validate() error = %v", err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// MemoryMailer keeps sent emails in memory. It is meant for tests and local development.
+type MemoryMailer struct {
+	mu       sync.Mutex
+	messages []domain.Email
+}
+
+// NewMemoryMailer creates an empty MemoryMailer
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(_ context.Context, email *domain.Email) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.messages = append(m.messages, *email)
+	return nil
+}
+
+// Messages returns a copy of every email sent so far
+func (m *MemoryMailer) Messages() []domain.Email {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]domain.Email(nil), m.messages...)
+}
+
+// LastTo returns the most recent email sent to the given address
+func (m *MemoryMailer) LastTo(to string) (*domain.Email, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if m.messages[i].To == to {
+			email := m.messages[i]
+			return &email, true
+		}
+	}
+	return nil, false
+}
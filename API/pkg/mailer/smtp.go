@@ -0,0 +1,68 @@
+// Package mailer provides Mailer implementations.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+// SMTPMailer delivers emails through an SMTP server.
+type SMTPMailer struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPMailer creates a Mailer that authenticates with PLAIN auth when a username is set
+func NewSMTPMailer(host string, port int, username, password, from string) domain.Mailer {
+	return &SMTPMailer{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Send delivers the email. net/smtp has no context support, so ctx is only checked before dialing.
+func (m *SMTPMailer) Send(ctx context.Context, email *domain.Email) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	addr := net.JoinHostPort(m.host, fmt.Sprint(m.port))
+	return smtp.SendMail(addr, auth, m.from, []string{email.To}, m.message(email))
+}
+
+// message builds the email with the subject encoded as in RFC 2047 and a quoted-printable
+// body, so that relays without SMTPUTF8 support keep accented text intact.
+func (m *SMTPMailer) message(email *domain.Email) []byte {
+	var b bytes.Buffer
+	b.WriteString("From: " + m.from + "\r\n")
+	b.WriteString("To: " + email.To + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("UTF-8", email.Subject) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	b.WriteString("\r\n")
+
+	// The writer also turns line breaks into CRLF
+	body := quotedprintable.NewWriter(&b)
+	body.Write([]byte(email.Body))
+	body.Close()
+	return b.Bytes()
+}
@@ -0,0 +1,41 @@
+package mailer
+
+import (
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func Test_SMTPMailer_message(t *testing.T) {
+	m := NewSMTPMailer("localhost", 25, "", "", "no-reply@vidaplus.com").(*SMTPMailer)
+
+	raw := m.message(&domain.Email{
+		To:      "paciente@test.com",
+		Subject: "Redefinição de senha",
+		Body:    "Olá,\nuse o código abaixo para redefinir sua senha.",
+	})
+
+	// Headers are plain ASCII
+	for _, c := range raw {
+		require.Less(t, c, byte(0x80))
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Redefinição de senha", subject)
+	assert.Equal(t, "quoted-printable", msg.Header.Get("Content-Transfer-Encoding"))
+
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	require.NoError(t, err)
+	assert.Equal(t, "Olá,\r\nuse o código abaixo para redefinir sua senha.", string(body))
+}
@@ -0,0 +1,118 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/vida-plus/api/pkg"
+)
+
+// lockID is the _id of the lock document in Collection, apart from the numeric versions
+const lockID = "lock"
+
+// ErrLocked is returned when another process holds the lock for longer than the runner waits
+var ErrLocked = errors.New("migrations are locked by another process")
+
+// lockConfig controls how the lock is taken. The holder extends the lock every TTL/3, so an
+// expired lock means its holder died and the lock can be taken over.
+type lockConfig struct {
+	TTL  time.Duration
+	Wait time.Duration
+	Poll time.Duration
+}
+
+func defaultLockConfig() lockConfig {
+	return lockConfig{TTL: time.Minute, Wait: 5 * time.Minute, Poll: time.Second}
+}
+
+// withLock runs fn while holding the lock, waiting for other processes to release it. The
+// context of fn is cancelled if the lock is lost.
+func (r *Runner) withLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	hostname, _ := os.Hostname()
+	owner := fmt.Sprintf("%s/%d/%s", hostname, os.Getpid(), pkg.GenerateID())
+
+	if err := r.acquire(ctx, owner); err != nil {
+		return err
+	}
+	defer r.release(owner)
+
+	lockCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go r.keepAlive(lockCtx, cancel, owner)
+
+	return fn(lockCtx)
+}
+
+// acquire takes the lock when nobody holds it or its holder let it expire. Otherwise the
+// upsert collides with the existing lock document and acquire retries until lock.Wait passes.
+func (r *Runner) acquire(ctx context.Context, owner string) error {
+	deadline := time.Now().Add(r.lock.Wait)
+	for {
+		now := time.Now()
+		filter := bson.M{"_id": lockID, "expires_at": bson.M{"$lte": now}}
+		update := bson.M{"$set": bson.M{"owner": owner, "locked_at": now, "expires_at": now.Add(r.lock.TTL)}}
+
+		_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+		if err == nil {
+			slog.Info("migration lock acquired", slog.String("owner", owner))
+			return nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("acquiring migration lock: %w", err)
+		}
+
+		if time.Now().After(deadline) {
+			return ErrLocked
+		}
+		slog.Info("waiting for migration lock held by another process")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.lock.Poll):
+		}
+	}
+}
+
+// keepAlive extends the lock until ctx is done, and calls cancel if the lock was lost
+func (r *Runner) keepAlive(ctx context.Context, cancel context.CancelFunc, owner string) {
+	ticker := time.NewTicker(r.lock.TTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := r.collection.UpdateOne(ctx, bson.M{"_id": lockID, "owner": owner},
+				bson.M{"$set": bson.M{"expires_at": time.Now().Add(r.lock.TTL)}})
+			if err != nil {
+				slog.Error("failed to extend migration lock", slog.Any("error", err))
+				continue
+			}
+			if result.MatchedCount == 0 {
+				slog.Error("migration lock was lost", slog.String("owner", owner))
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// release deletes the lock if it is still held by owner. It doesn't use the context of the
+// migration, which may have been cancelled.
+func (r *Runner) release(owner string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": lockID, "owner": owner}); err != nil {
+		slog.Error("failed to release migration lock", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,208 @@
+// Package migrate applies versioned changes to the documents of a MongoDB database. Applied
+// versions are recorded in the schema_migrations collection, which also holds a lock so only
+// one process, such as one of several API replicas starting together, migrates at a time.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection records the applied migrations and the lock
+const Collection = "schema_migrations"
+
+// ErrIrreversible is returned when rolling back a migration without Down
+var ErrIrreversible = errors.New("migration cannot be rolled back")
+
+// Migration changes the documents or schema of the database. Up should be safe to run again
+// after a failure, since a migration is only recorded once Up returns.
+type Migration struct {
+	// Version orders the migrations and must be unique, usually the time it was written as
+	// in 20250301120000.
+	Version int64
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+	// Down undoes Up. Migrations without Down can't be rolled back.
+	Down func(ctx context.Context, db *mongo.Database) error
+}
+
+// Status is a migration and when it was applied, nil when it is pending.
+type Status struct {
+	Version   int64      `bson:"_id"`
+	Name      string     `bson:"name"`
+	AppliedAt *time.Time `bson:"applied_at"`
+	// Unknown is set for applied migrations that this build doesn't have, such as after
+	// deploying an older version.
+	Unknown bool `bson:"-"`
+}
+
+// Runner applies and rolls back a set of migrations.
+type Runner struct {
+	db         *mongo.Database
+	collection *mongo.Collection
+	migrations []Migration
+	lock       lockConfig
+}
+
+// New creates a Runner for migrations, which must have unique versions and an Up function.
+func New(db *mongo.Database, migrations []Migration) (*Runner, error) {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	for i, migration := range sorted {
+		if migration.Version <= 0 || migration.Up == nil {
+			return nil, fmt.Errorf("migration %d %q needs a positive version and an Up function", migration.Version, migration.Name)
+		}
+		if i > 0 && sorted[i-1].Version == migration.Version {
+			return nil, fmt.Errorf("migrations %q and %q have the same version %d", sorted[i-1].Name, migration.Name, migration.Version)
+		}
+	}
+
+	return &Runner{
+		db:         db,
+		collection: db.Collection(Collection),
+		migrations: sorted,
+		lock:       defaultLockConfig(),
+	}, nil
+}
+
+// Up applies every pending migration in order and returns how many were applied. It stops at
+// the first failure.
+func (r *Runner) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := r.withLock(ctx, func(ctx context.Context) error {
+		done, err := r.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range r.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+
+			logger := slog.With(slog.Int64("version", migration.Version), slog.String("name", migration.Name))
+			logger.Info("applying migration")
+			if err := migration.Up(ctx, r.db); err != nil {
+				return fmt.Errorf("applying migration %d %s: %w", migration.Version, migration.Name, err)
+			}
+
+			record := Status{Version: migration.Version, Name: migration.Name, AppliedAt: ptr(time.Now())}
+			if _, err := r.collection.InsertOne(ctx, record); err != nil {
+				return fmt.Errorf("recording migration %d: %w", migration.Version, err)
+			}
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the last steps applied migrations, newest first, and returns how many were
+// rolled back. It stops at the first failure or migration without Down.
+func (r *Runner) Down(ctx context.Context, steps int) (int, error) {
+	rolledBack := 0
+	err := r.withLock(ctx, func(ctx context.Context) error {
+		done, err := r.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		versions := make([]int64, 0, len(done))
+		for version := range done {
+			versions = append(versions, version)
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+		if steps < len(versions) {
+			versions = versions[:steps]
+		}
+
+		for _, version := range versions {
+			migration, ok := r.find(version)
+			if !ok {
+				return fmt.Errorf("migration %d %s isn't part of this build", version, done[version].Name)
+			}
+			if migration.Down == nil {
+				return fmt.Errorf("migration %d %s: %w", version, migration.Name, ErrIrreversible)
+			}
+
+			slog.Info("rolling back migration", slog.Int64("version", version), slog.String("name", migration.Name))
+			if err := migration.Down(ctx, r.db); err != nil {
+				return fmt.Errorf("rolling back migration %d %s: %w", version, migration.Name, err)
+			}
+			if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": version}); err != nil {
+				return fmt.Errorf("removing record of migration %d: %w", version, err)
+			}
+			rolledBack++
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// Status lists every migration of this build and the applied ones it doesn't have, by version.
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	done, err := r.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(r.migrations))
+	for _, migration := range r.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if record, ok := done[migration.Version]; ok {
+			status.AppliedAt = record.AppliedAt
+			delete(done, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, record := range done {
+		record.Unknown = true
+		statuses = append(statuses, record)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses, nil
+}
+
+// applied returns the records of the applied migrations by version
+func (r *Runner) applied(ctx context.Context) (map[int64]Status, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$type": "long"}},
+		options.Find().SetProjection(bson.M{"name": 1, "applied_at": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("reading applied migrations: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var records []Status
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("decoding applied migrations: %w", err)
+	}
+
+	done := make(map[int64]Status, len(records))
+	for _, record := range records {
+		done[record.Version] = record
+	}
+	return done, nil
+}
+
+func (r *Runner) find(version int64) (Migration, bool) {
+	for _, migration := range r.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,43 @@
+package migrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func Test_New(t *testing.T) {
+	up := func(ctx context.Context, db *mongo.Database) error { return nil }
+	db := (&mongo.Client{}).Database("test")
+
+	tests := []struct {
+		name       string
+		migrations []Migration
+		versions   []int64
+		wantErr    bool
+	}{
+		{"SORTED", []Migration{{Version: 3, Name: "c", Up: up}, {Version: 1, Name: "a", Up: up}, {Version: 2, Name: "b", Up: up}}, []int64{1, 2, 3}, false},
+		{"DUPLICATE_VERSION", []Migration{{Version: 1, Name: "a", Up: up}, {Version: 1, Name: "b", Up: up}}, nil, true},
+		{"MISSING_UP", []Migration{{Version: 1, Name: "a"}}, nil, true},
+		{"ZERO_VERSION", []Migration{{Name: "a", Up: up}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner, err := New(db, tt.migrations)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var versions []int64
+			for _, migration := range runner.migrations {
+				versions = append(versions, migration.Version)
+			}
+			assert.Equal(t, tt.versions, versions)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Package shutdown stops the API gracefully: readiness fails first so load balancers stop
+// sending traffic, then in-flight requests are drained and the registered hooks release the
+// remaining resources in order.
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Config controls how long each phase of the shutdown may take.
+type Config struct {
+	// ReadinessDelay is how long readiness fails before the server stops accepting
+	// connections, so load balancers notice it first.
+	ReadinessDelay time.Duration
+	// DrainTimeout bounds how long in-flight requests have to finish.
+	DrainTimeout time.Duration
+	// HookTimeout bounds each hook.
+	HookTimeout time.Duration
+}
+
+// DefaultConfig returns the configuration used in production.
+func DefaultConfig() Config {
+	return Config{
+		ReadinessDelay: 5 * time.Second,
+		DrainTimeout:   30 * time.Second,
+		HookTimeout:    10 * time.Second,
+	}
+}
+
+// Server is an HTTP server that stops accepting connections and waits for in-flight requests
+// on Shutdown, such as http.Server or echo.Echo.
+type Server interface {
+	Shutdown(ctx context.Context) error
+}
+
+// Hook releases a resource, such as stopping background workers or disconnecting a database.
+type Hook func(ctx context.Context) error
+
+type namedHook struct {
+	name string
+	run  Hook
+}
+
+// Manager runs the shutdown and reports whether it has started.
+type Manager struct {
+	config   Config
+	draining atomic.Bool
+
+	mu    sync.Mutex
+	hooks []namedHook
+}
+
+// New creates a Manager with config.
+func New(config Config) *Manager {
+	return &Manager{config: config}
+}
+
+// Register adds a hook that runs after the requests are drained. Hooks run in the order they
+// are registered, so resources used by others, such as the database, are registered last.
+func (m *Manager) Register(name string, hook Hook) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, namedHook{name: name, run: hook})
+}
+
+// Draining reports whether the shutdown has started, when the instance is no longer ready.
+func (m *Manager) Draining() bool {
+	return m.draining.Load()
+}
+
+// Shutdown fails readiness, drains the requests of server and runs every hook, even after
+// one fails. It returns the errors of all phases.
+func (m *Manager) Shutdown(server Server) error {
+	logger := slog.With(slog.String("func", "shutdown.Shutdown"))
+	start := time.Now()
+
+	m.draining.Store(true)
+	logger.Info("shutting down, readiness is now failing", slog.Duration("readiness_delay", m.config.ReadinessDelay))
+	time.Sleep(m.config.ReadinessDelay)
+
+	var errs []error
+	drainCtx, cancel := context.WithTimeout(context.Background(), m.config.DrainTimeout)
+	if err := server.Shutdown(drainCtx); err != nil {
+		logger.Error("failed to drain requests", slog.Any("error", err))
+		errs = append(errs, fmt.Errorf("draining requests: %w", err))
+	} else {
+		logger.Info("requests drained", slog.Duration("elapsed", time.Since(start)))
+	}
+	cancel()
+
+	m.mu.Lock()
+	hooks := append([]namedHook(nil), m.hooks...)
+	m.mu.Unlock()
+
+	for _, hook := range hooks {
+		if err := m.runHook(hook); err != nil {
+			logger.Error("shutdown hook failed", slog.String("hook", hook.name), slog.Any("error", err))
+			errs = append(errs, fmt.Errorf("%s: %w", hook.name, err))
+			continue
+		}
+		logger.Info("shutdown hook completed", slog.String("hook", hook.name))
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		logger.Error("shutdown completed with errors", slog.Duration("elapsed", time.Since(start)), slog.Any("error", err))
+		return err
+	}
+	logger.Info("shutdown completed", slog.Duration("elapsed", time.Since(start)))
+	return nil
+}
+
+// runHook runs hook with its own deadline. A hook that ignores its context is abandoned when
+// the deadline passes, so it can't hold up the ones after it.
+func (m *Manager) runHook(hook namedHook) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.config.HookTimeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- hook.run(ctx) }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
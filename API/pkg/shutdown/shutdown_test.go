@@ -0,0 +1,115 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() Config {
+	return Config{ReadinessDelay: 0, DrainTimeout: time.Second, HookTimeout: 100 * time.Millisecond}
+}
+
+func Test_Manager_Shutdown(t *testing.T) {
+	t.Run("DRAINS_REQUESTS_BEFORE_HOOKS", func(t *testing.T) {
+		manager := New(testConfig())
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		var mu sync.Mutex
+		var events []string
+		record := func(event string) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			record("request")
+		}))
+		defer server.Close()
+
+		go http.Get(server.URL)
+		<-started
+
+		manager.Register("workers", func(ctx context.Context) error {
+			record("workers")
+			return nil
+		})
+		manager.Register("database", func(ctx context.Context) error {
+			record("database")
+			return nil
+		})
+
+		assert.False(t, manager.Draining())
+		go func() {
+			// Readiness fails while the request is still running
+			assert.Eventually(t, manager.Draining, time.Second, time.Millisecond)
+			close(release)
+		}()
+
+		require.NoError(t, manager.Shutdown(server.Config))
+		assert.True(t, manager.Draining())
+		assert.Equal(t, []string{"request", "workers", "database"}, events)
+	})
+
+	t.Run("RUNS_EVERY_HOOK_AFTER_FAILURES", func(t *testing.T) {
+		manager := New(testConfig())
+
+		var ran atomic.Int32
+		unblock := make(chan struct{})
+		defer close(unblock)
+		manager.Register("failing", func(ctx context.Context) error {
+			ran.Add(1)
+			return errors.New("boom")
+		})
+		manager.Register("stuck", func(ctx context.Context) error {
+			ran.Add(1)
+			<-unblock
+			return nil
+		})
+		manager.Register("last", func(ctx context.Context) error {
+			ran.Add(1)
+			return nil
+		})
+
+		err := manager.Shutdown(&http.Server{})
+		assert.ErrorContains(t, err, "failing: boom")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(3), ran.Load())
+	})
+
+	t.Run("REPORTS_DRAIN_TIMEOUT", func(t *testing.T) {
+		config := testConfig()
+		config.DrainTimeout = 10 * time.Millisecond
+		manager := New(config)
+
+		hookRan := false
+		manager.Register("database", func(ctx context.Context) error {
+			hookRan = true
+			return nil
+		})
+
+		err := manager.Shutdown(slowServer{})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.True(t, hookRan)
+	})
+}
+
+// slowServer never finishes draining
+type slowServer struct{}
+
+func (slowServer) Shutdown(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
@@ -0,0 +1,96 @@
+// Package totp implements time-based one-time passwords (RFC 6238).
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Options controls how codes are generated. Authenticator apps expect DefaultOptions.
+type Options struct {
+	Period time.Duration
+	Digits int
+	Hash   func() hash.Hash
+}
+
+// DefaultOptions are the parameters supported by every authenticator app: 30 second steps,
+// 6 digits and HMAC-SHA1.
+var DefaultOptions = Options{Period: 30 * time.Second, Digits: 6, Hash: sha1.New}
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random base32 encoded 160-bit secret.
+func GenerateSecret() (string, error) {
+	key := make([]byte, 20)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(key), nil
+}
+
+// DecodeSecret decodes a base32 secret, ignoring case, spaces and padding.
+func DecodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	return encoding.DecodeString(strings.TrimRight(secret, "="))
+}
+
+// Step returns the time step t falls in.
+func Step(t time.Time, opts Options) int64 {
+	return t.Unix() / int64(opts.Period/time.Second)
+}
+
+// Code computes the code for a time step (RFC 4226 section 5.3).
+func Code(key []byte, step int64, opts Options) string {
+	mac := hmac.New(opts.Hash, key)
+	_ = binary.Write(mac, binary.BigEndian, step)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < opts.Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", opts.Digits, value%mod)
+}
+
+// Validate checks code against the steps around t, allowing skew steps of clock drift in
+// each direction. It returns the matching step so callers can reject replays.
+func Validate(secret, code string, t time.Time, skew int) (int64, bool) {
+	key, err := DecodeSecret(secret)
+	if err != nil || len(code) != DefaultOptions.Digits {
+		return 0, false
+	}
+
+	current := Step(t, DefaultOptions)
+	for i := -skew; i <= skew; i++ {
+		step := current + int64(i)
+		if subtle.ConstantTimeCompare([]byte(Code(key, step, DefaultOptions)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// URI returns the otpauth:// URI authenticator apps use to import a secret, usually shown as a QR code.
+func URI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(DefaultOptions.Digits))
+	query.Set("period", fmt.Sprint(int(DefaultOptions.Period/time.Second)))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
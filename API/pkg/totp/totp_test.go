@@ -0,0 +1,113 @@
+package totp
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_TOTP_Code checks the test vectors from RFC 6238 appendix B.
+func Test_TOTP_Code(t *testing.T) {
+	seeds := map[string]struct {
+		key  []byte
+		hash func() hash.Hash
+	}{
+		"SHA1":   {[]byte("12345678901234567890"), sha1.New},
+		"SHA256": {[]byte("12345678901234567890123456789012"), sha256.New},
+		"SHA512": {[]byte("1234567890123456789012345678901234567890123456789012345678901234"), sha512.New},
+	}
+
+	tests := []struct {
+		unix int64
+		algo string
+		want string
+	}{
+		{59, "SHA1", "94287082"},
+		{59, "SHA256", "46119246"},
+		{59, "SHA512", "90693936"},
+		{1111111109, "SHA1", "07081804"},
+		{1111111109, "SHA256", "68084774"},
+		{1111111109, "SHA512", "25091201"},
+		{1111111111, "SHA1", "14050471"},
+		{1111111111, "SHA256", "67062674"},
+		{1111111111, "SHA512", "99943326"},
+		{1234567890, "SHA1", "89005924"},
+		{1234567890, "SHA256", "91819424"},
+		{1234567890, "SHA512", "93441116"},
+		{2000000000, "SHA1", "69279037"},
+		{2000000000, "SHA256", "90698825"},
+		{2000000000, "SHA512", "38618901"},
+		{20000000000, "SHA1", "65353130"},
+		{20000000000, "SHA256", "77737706"},
+		{20000000000, "SHA512", "47863826"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algo+"_"+time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			seed := seeds[tt.algo]
+			opts := Options{Period: 30 * time.Second, Digits: 8, Hash: seed.hash}
+
+			assert.Equal(t, tt.want, Code(seed.key, Step(time.Unix(tt.unix, 0), opts), opts))
+		})
+	}
+}
+
+func Test_TOTP_Validate(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" // base32 of the RFC 6238 SHA1 seed
+	now := time.Unix(1111111111, 0)
+
+	// The last 6 digits of the 8 digit RFC vector
+	step, ok := Validate(secret, "050471", now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now, DefaultOptions), step)
+
+	// Codes from the neighbouring steps are accepted within the skew
+	_, ok = Validate(secret, "050471", now.Add(30*time.Second), 1)
+	assert.True(t, ok)
+	_, ok = Validate(secret, "050471", now.Add(90*time.Second), 1)
+	assert.False(t, ok)
+
+	// Lowercase secrets with spaces are accepted
+	_, ok = Validate(strings.ToLower("GEZD GNBV GY3T QOJQ GEZD GNBV GY3T QOJQ"), "050471", now, 0)
+	assert.True(t, ok)
+
+	_, ok = Validate(secret, "000000", now, 1)
+	assert.False(t, ok)
+	_, ok = Validate(secret, "05047", now, 1)
+	assert.False(t, ok)
+	_, ok = Validate("not base32!", "050471", now, 1)
+	assert.False(t, ok)
+}
+
+func Test_TOTP_GenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	key, err := DecodeSecret(secret)
+	require.NoError(t, err)
+	assert.Len(t, key, 20)
+
+	other, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+}
+
+func Test_TOTP_URI(t *testing.T) {
+	uri, err := url.Parse(URI("Vida Plus", "doctor@example.com", "JBSWY3DPEHPK3PXP"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/Vida Plus:doctor@example.com", uri.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", uri.Query().Get("secret"))
+	assert.Equal(t, "Vida Plus", uri.Query().Get("issuer"))
+	assert.Equal(t, "6", uri.Query().Get("digits"))
+	assert.Equal(t, "30", uri.Query().Get("period"))
+}
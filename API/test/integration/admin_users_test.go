@@ -0,0 +1,504 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestAdminUsersIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	setupAdmin := func(t *testing.T) (string, string) {
+		t.Helper()
+
+		rec := app.Register(t, domain.RegisterRequest{
+			Email:    "admin.users@test.com",
+			Password: "password123",
+			Type:     domain.UserTypeAdmin,
+			Profile:  domain.UserProfile{FirstName: "Admin", LastName: "User"},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var registerResp domain.RegisterResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registerResp))
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: "admin.users@test.com", Password: "password123"}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var loginResp domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		return registerResp.ID, loginResp.Token
+	}
+
+	createUser := func(t *testing.T, token string, req domain.CreateUserRequest) domain.User {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/admin/users", req, token)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var user domain.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &user))
+		return user
+	}
+
+	login := func(t *testing.T, email string) (int, *domain.LoginResponse) {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: "initialpassword123"}, "")
+		var loginResp domain.LoginResponse
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		}
+		return rec.Code, &loginResp
+	}
+
+	auditActions := func(t *testing.T, token, targetID string) []domain.AuditAction {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/admin/audit-logs?target_id="+targetID, nil, token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var events []domain.AuditEvent
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &events))
+		actions := make([]domain.AuditAction, 0, len(events))
+		for _, event := range events {
+			actions = append(actions, event.Action)
+		}
+		return actions
+	}
+
+	t.Run("should create active staff accounts", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		adminID, adminToken := setupAdmin(t)
+
+		doctor := createUser(t, adminToken, domain.CreateUserRequest{
+			Email:    "doctor.created@test.com",
+			Password: "initialpassword123",
+			Type:     domain.UserTypeDoctor,
+			Profile:  domain.UserProfile{FirstName: "Ana", LastName: "Costa", CRM: "CRM/SP 123456", Speciality: "Cardiologia"},
+		})
+		assert.Equal(t, domain.UserTypeDoctor, doctor.Type)
+		assert.Equal(t, domain.UserStatusActive, doctor.Status)
+		assert.Equal(t, "CRM/SP 123456", doctor.Profile.CRM)
+
+		// The doctor can log in right away
+		code, _ := login(t, "doctor.created@test.com")
+		assert.Equal(t, http.StatusOK, code)
+
+		// Duplicate emails are rejected
+		rec := app.DoJSON(t, http.MethodPost, "/v1/admin/users", domain.CreateUserRequest{
+			Email:    "doctor.created@test.com",
+			Password: "initialpassword123",
+			Type:     domain.UserTypeNurse,
+			Profile:  domain.UserProfile{FirstName: "Other", LastName: "User", COREN: "COREN-SP 654321"},
+		}, adminToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		// Unknown types are rejected
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/users", domain.CreateUserRequest{
+			Email:    "unknown.type@test.com",
+			Password: "initialpassword123",
+			Type:     "superuser",
+			Profile:  domain.UserProfile{FirstName: "Other", LastName: "User"},
+		}, adminToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		// Doctors need a CRM and documents must be valid
+		for _, profile := range []domain.UserProfile{
+			{FirstName: "No", LastName: "CRM"},
+			{FirstName: "Bad", LastName: "CRM", CRM: "CRM/XX 123"},
+			{FirstName: "Bad", LastName: "CPF", CRM: "CRM/SP 123", CPF: "123.456.789-00"},
+			{FirstName: "Bad", LastName: "Phone", CRM: "CRM/SP 123", Phone: "12345"},
+		} {
+			rec = app.DoJSON(t, http.MethodPost, "/v1/admin/users", domain.CreateUserRequest{
+				Email:    "invalid.doctor@test.com",
+				Password: "initialpassword123",
+				Type:     domain.UserTypeDoctor,
+				Profile:  profile,
+			}, adminToken)
+			assert.Equal(t, http.StatusBadRequest, rec.Code, profile.LastName)
+		}
+
+		// Documents are stored in their canonical format
+		nurse := createUser(t, adminToken, domain.CreateUserRequest{
+			Email:    "nurse.created@test.com",
+			Password: "initialpassword123",
+			Type:     domain.UserTypeNurse,
+			Profile:  domain.UserProfile{FirstName: "Bia", LastName: "Lima", COREN: "coren/rj 4321", Phone: "(21) 98888-7777"},
+		})
+		assert.Equal(t, "COREN-RJ 4321", nurse.Profile.COREN)
+		assert.Equal(t, "+5521988887777", nurse.Profile.Phone)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/admin/users/"+doctor.ID, nil, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		actions := auditActions(t, adminToken, doctor.ID)
+		assert.Equal(t, []domain.AuditAction{domain.AuditActionUserCreated}, actions)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/admin/audit-logs?action=user.created", nil, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var events []domain.AuditEvent
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &events))
+		require.Len(t, events, 1)
+		assert.Equal(t, adminID, events[0].ActorID)
+	})
+
+	t.Run("should update profile and type and end sessions on type change", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		adminID, adminToken := setupAdmin(t)
+		nurse := createUser(t, adminToken, domain.CreateUserRequest{
+			Email:    "nurse.updated@test.com",
+			Password: "initialpassword123",
+			Type:     domain.UserTypeNurse,
+			Profile:  domain.UserProfile{FirstName: "Bia", LastName: "Lima", COREN: "COREN-SP 1"},
+		})
+
+		code, session := login(t, "nurse.updated@test.com")
+		require.Equal(t, http.StatusOK, code)
+
+		// Profile changes keep the session
+		department := "UTI"
+		rec := app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+nurse.ID, domain.AdminUpdateUserRequest{
+			Profile: &domain.UpdateProfileRequest{Department: &department},
+		}, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var updated domain.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+		assert.Equal(t, "UTI", updated.Profile.Department)
+		assert.Equal(t, "COREN-SP 1", updated.Profile.COREN)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, session.Token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		// Type changes end the session
+		doctorType := domain.UserTypeDoctor
+		crm := "CRM/SP 654321"
+		rec = app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+nurse.ID, domain.AdminUpdateUserRequest{
+			Type:    &doctorType,
+			Profile: &domain.UpdateProfileRequest{CRM: &crm},
+		}, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+		assert.Equal(t, domain.UserTypeDoctor, updated.Type)
+		assert.Equal(t, crm, updated.Profile.CRM)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, session.Token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// Admins can't change their own type
+		patientType := domain.UserTypePatient
+		rec = app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+adminID, domain.AdminUpdateUserRequest{Type: &patientType}, adminToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPatch, "/v1/admin/users/missing", domain.AdminUpdateUserRequest{Type: &patientType}, adminToken)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("should soft-delete and restore users", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		adminID, adminToken := setupAdmin(t)
+		receptionist := createUser(t, adminToken, domain.CreateUserRequest{
+			Email:    "receptionist.deleted@test.com",
+			Password: "initialpassword123",
+			Type:     domain.UserTypeReceptionist,
+			Profile:  domain.UserProfile{FirstName: "Caio", LastName: "Reis"},
+		})
+
+		code, session := login(t, "receptionist.deleted@test.com")
+		require.Equal(t, http.StatusOK, code)
+
+		rec := app.DoJSON(t, http.MethodDelete, "/v1/admin/users/"+receptionist.ID, nil, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var deleted domain.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &deleted))
+		require.NotNil(t, deleted.DeletedAt)
+		assert.Equal(t, adminID, deleted.DeletedBy)
+
+		// Sessions end and the account can't log in
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, session.Token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/refresh", domain.RefreshRequest{RefreshToken: session.RefreshToken}, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		code, _ = login(t, "receptionist.deleted@test.com")
+		assert.Equal(t, http.StatusUnauthorized, code)
+
+		// Deleted users are hidden from the list but the email stays reserved
+		rec = app.DoJSON(t, http.MethodGet, "/v1/admin/users", nil, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), receptionist.ID)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/users", domain.CreateUserRequest{
+			Email:    "receptionist.deleted@test.com",
+			Password: "initialpassword123",
+			Type:     domain.UserTypeReceptionist,
+			Profile:  domain.UserProfile{FirstName: "Other", LastName: "User"},
+		}, adminToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodDelete, "/v1/admin/users/"+receptionist.ID, nil, adminToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodDelete, "/v1/admin/users/"+adminID, nil, adminToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		// Restoring gives access back
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/users/"+receptionist.ID+"/restore", nil, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var restored domain.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &restored))
+		assert.Nil(t, restored.DeletedAt)
+
+		code, _ = login(t, "receptionist.deleted@test.com")
+		assert.Equal(t, http.StatusOK, code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/users/"+receptionist.ID+"/restore", nil, adminToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		actions := auditActions(t, adminToken, receptionist.ID)
+		assert.Equal(t, []domain.AuditAction{
+			domain.AuditActionUserRestored,
+			domain.AuditActionUserDeleted,
+			domain.AuditActionUserCreated,
+		}, actions)
+	})
+
+	t.Run("should audit status changes", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		_, adminToken := setupAdmin(t)
+		doctor := createUser(t, adminToken, domain.CreateUserRequest{
+			Email:    "doctor.status@test.com",
+			Password: "initialpassword123",
+			Type:     domain.UserTypeDoctor,
+			Profile:  domain.UserProfile{FirstName: "Davi", LastName: "Melo", CRM: "CRM/RJ 45678"},
+		})
+
+		rec := app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+doctor.ID+"/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatusInactive,
+			Reason: "Left the clinic",
+		}, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/admin/audit-logs?target_id="+doctor.ID+"&action=user.status_changed", nil, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var events []domain.AuditEvent
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &events))
+		require.Len(t, events, 1)
+		assert.Equal(t, "inactive", events[0].Details["status"])
+		assert.Equal(t, "Left the clinic", events[0].Details["reason"])
+	})
+
+	t.Run("should page, filter and sort users", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		_, adminToken := setupAdmin(t)
+		staff := []domain.CreateUserRequest{
+			{Email: "ana.costa@test.com", Type: domain.UserTypeDoctor, Profile: domain.UserProfile{FirstName: "Ana", LastName: "Costa", CRM: "CRM/SP 111111"}},
+			{Email: "bruno.alves@test.com", Type: domain.UserTypeDoctor, Profile: domain.UserProfile{FirstName: "Bruno", LastName: "Alves", CRM: "CRM/SP 222222"}},
+			{Email: "carla.dias@test.com", Type: domain.UserTypeNurse, Profile: domain.UserProfile{FirstName: "Carla", LastName: "Dias", COREN: "COREN-SP 333333"}},
+			{Email: "daniel.rocha@test.com", Type: domain.UserTypeReceptionist, Profile: domain.UserProfile{FirstName: "Daniel", LastName: "Rocha"}},
+		}
+		var receptionistID string
+		for _, req := range staff {
+			req.Password = "initialpassword123"
+			receptionistID = createUser(t, adminToken, req).ID
+		}
+
+		list := func(t *testing.T, params url.Values) (int, domain.Page[domain.User]) {
+			t.Helper()
+
+			rec := app.DoJSON(t, http.MethodGet, "/v1/admin/users?"+params.Encode(), nil, adminToken)
+			var page domain.Page[domain.User]
+			if rec.Code == http.StatusOK {
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+			}
+			return rec.Code, page
+		}
+
+		// Following the cursor goes through every user once
+		seen := map[string]bool{}
+		params := url.Values{"limit": {"2"}}
+		for pages := 1; ; pages++ {
+			code, page := list(t, params)
+			require.Equal(t, http.StatusOK, code)
+			assert.Equal(t, int64(5), page.Total)
+			for _, user := range page.Items {
+				assert.False(t, seen[user.ID])
+				seen[user.ID] = true
+			}
+			if page.NextCursor == "" {
+				assert.Equal(t, 3, pages)
+				break
+			}
+			params.Set("cursor", page.NextCursor)
+		}
+		assert.Len(t, seen, 5)
+
+		code, page := list(t, url.Values{"type": {"doctor"}})
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int64(2), page.Total)
+
+		code, page = list(t, url.Values{"search": {"CAR"}})
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, "carla.dias@test.com", page.Items[0].Email)
+
+		code, page = list(t, url.Values{"sort": {"email"}})
+		require.Equal(t, http.StatusOK, code)
+		emails := make([]string, 0, len(page.Items))
+		for _, user := range page.Items {
+			emails = append(emails, user.Email)
+		}
+		assert.True(t, sort.StringsAreSorted(emails))
+
+		code, page = list(t, url.Values{"created_to": {"2000-01-01T00:00:00Z"}})
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int64(0), page.Total)
+		assert.Empty(t, page.Items)
+
+		// Sorting by another field invalidates the cursor
+		_, page = list(t, url.Values{"limit": {"1"}})
+		code, _ = list(t, url.Values{"sort": {"email"}, "cursor": {page.NextCursor}})
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		for _, params := range []url.Values{
+			{"sort": {"-password"}},
+			{"limit": {"500"}},
+			{"cursor": {"garbage"}},
+			{"type": {"superuser"}},
+			{"created_from": {"yesterday"}},
+		} {
+			code, _ = list(t, params)
+			assert.Equal(t, http.StatusBadRequest, code, params.Encode())
+		}
+
+		// Deleted users are listed apart
+		rec := app.DoJSON(t, http.MethodDelete, "/v1/admin/users/"+receptionistID, nil, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		code, page = list(t, url.Values{"deleted": {"true"}})
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, receptionistID, page.Items[0].ID)
+
+		code, page = list(t, nil)
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int64(4), page.Total)
+	})
+	t.Run("should aggregate system stats", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		_, adminToken := setupAdmin(t)
+		staff := []domain.CreateUserRequest{
+			{Email: "stats.doctor@test.com", Type: domain.UserTypeDoctor, Profile: domain.UserProfile{FirstName: "Ana", LastName: "Costa", CRM: "CRM/SP 111111", Department: "Cardiologia"}},
+			{Email: "stats.nurse@test.com", Type: domain.UserTypeNurse, Profile: domain.UserProfile{FirstName: "Bia", LastName: "Lima", COREN: "COREN-SP 222222", Department: "UTI"}},
+			{Email: "stats.receptionist@test.com", Type: domain.UserTypeReceptionist, Profile: domain.UserProfile{FirstName: "Caio", LastName: "Reis"}},
+		}
+		ids := make([]string, 0, len(staff))
+		for _, req := range staff {
+			req.Password = "initialpassword123"
+			ids = append(ids, createUser(t, adminToken, req).ID)
+		}
+
+		code, _ := login(t, "stats.doctor@test.com")
+		require.Equal(t, http.StatusOK, code)
+
+		rec := app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+ids[1]+"/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatusInactive,
+			Reason: "On leave",
+		}, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		// Deleted users aren't counted
+		rec = app.DoJSON(t, http.MethodDelete, "/v1/admin/users/"+ids[2], nil, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		stats := func(t *testing.T, params url.Values) (int, domain.SystemStats) {
+			t.Helper()
+
+			rec := app.DoJSON(t, http.MethodGet, "/v1/admin/stats?"+params.Encode(), nil, adminToken)
+			var stats domain.SystemStats
+			if rec.Code == http.StatusOK {
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stats))
+			}
+			return rec.Code, stats
+		}
+
+		code, got := stats(t, nil)
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int64(3), got.TotalUsers)
+		assert.Equal(t, map[domain.UserType]int64{
+			domain.UserTypeAdmin:  1,
+			domain.UserTypeDoctor: 1,
+			domain.UserTypeNurse:  1,
+		}, got.ByType)
+		assert.Equal(t, map[domain.UserStatus]int64{
+			domain.UserStatusActive:   2,
+			domain.UserStatusInactive: 1,
+		}, got.ByStatus)
+		assert.Equal(t, map[string]int64{"Cardiologia": 1, "UTI": 1}, got.ByDepartment)
+		// The admin and the doctor logged in
+		assert.Equal(t, map[string]int64{"24h": 2, "7d": 2, "30d": 2}, got.ActiveUsers)
+
+		// Every day of the default period has a bucket, and today's counts the registrations
+		assert.Equal(t, domain.StatsIntervalDay, got.Interval)
+		require.Len(t, got.Registrations, 31)
+		assert.Equal(t, int64(3), got.Registrations[len(got.Registrations)-1].Count)
+
+		code, got = stats(t, url.Values{"interval": {"week"}})
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, time.Monday, got.From.Weekday())
+		var registrations int64
+		for _, bucket := range got.Registrations {
+			registrations += bucket.Count
+		}
+		assert.Equal(t, int64(3), registrations)
+
+		code, got = stats(t, url.Values{"from": {"2000-01-01T00:00:00Z"}, "to": {"2000-01-08T00:00:00Z"}})
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, got.Registrations, 7)
+		for _, bucket := range got.Registrations {
+			assert.Zero(t, bucket.Count)
+		}
+
+		for _, params := range []url.Values{
+			{"interval": {"month"}},
+			{"from": {"yesterday"}},
+			{"from": {"2000-01-08T00:00:00Z"}, "to": {"2000-01-01T00:00:00Z"}},
+			{"from": {"2000-01-01T00:00:00Z"}, "to": {"2010-01-01T00:00:00Z"}},
+		} {
+			code, _ = stats(t, params)
+			assert.Equal(t, http.StatusBadRequest, code, params.Encode())
+		}
+	})
+}
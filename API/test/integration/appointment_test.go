@@ -0,0 +1,272 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestAppointmentIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	decode := func(t *testing.T, body []byte) domain.Appointment {
+		t.Helper()
+
+		var appointment domain.Appointment
+		require.NoError(t, json.Unmarshal(body, &appointment))
+		return appointment
+	}
+
+	// Two days from now at 09:00 in the clinic, which is in UTC
+	day := time.Now().UTC().AddDate(0, 0, 2)
+	start := time.Date(day.Year(), day.Month(), day.Day(), 9, 0, 0, 0, time.UTC)
+
+	// openCalendar has the doctor work from 08:00 to 18:00 every day, in 30 minute slots
+	openCalendar := func(t *testing.T, doctorID, token string) {
+		t.Helper()
+
+		weekly := make([]domain.WeeklyHours, 0, 7)
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			weekly = append(weekly, domain.WeeklyHours{Weekday: weekday, Start: "08:00", End: "18:00"})
+		}
+		rec := app.DoJSON(t, http.MethodPut, "/v1/doctors/"+doctorID+"/availability", domain.SetAvailabilityRequest{Weekly: weekly}, token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	t.Run("should book for the patient and reject overlapping times", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		doctorID, doctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "doctor.appt@test.com", domain.UserProfile{})
+		patientID, patientToken := app.RegisterAndLogin(t, domain.UserTypePatient, "patient.appt@test.com", domain.UserProfile{})
+		otherID, _ := app.RegisterAndLogin(t, domain.UserTypePatient, "other.appt@test.com", domain.UserProfile{})
+		_, receptionToken := app.RegisterAndLogin(t, domain.UserTypeReceptionist, "reception.appt@test.com", domain.UserProfile{})
+		openCalendar(t, doctorID, doctorToken)
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/appointments", domain.BookAppointmentRequest{
+			DoctorID: doctorID,
+			StartsAt: start,
+			EndsAt:   start.Add(30 * time.Minute),
+		}, patientToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		booked := decode(t, rec.Body.Bytes())
+		assert.Equal(t, patientID, booked.PatientID)
+		assert.Equal(t, domain.AppointmentStatusBooked, booked.Status)
+
+		// Patients can't book for others
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments", domain.BookAppointmentRequest{
+			PatientID: otherID,
+			DoctorID:  doctorID,
+			StartsAt:  start.Add(time.Hour),
+			EndsAt:    start.Add(90 * time.Minute),
+		}, patientToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		// The doctor is taken at this time
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments", domain.BookAppointmentRequest{
+			PatientID: otherID,
+			DoctorID:  doctorID,
+			StartsAt:  start,
+			EndsAt:    start.Add(30 * time.Minute),
+		}, receptionToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		// Only the slots of the doctor can be booked: not across two of them, outside the working
+		// hours or on a day off
+		rec = app.DoJSON(t, http.MethodPost, "/v1/doctors/"+doctorID+"/availability/exceptions",
+			domain.CreateAvailabilityExceptionRequest{
+				StartDate: start.AddDate(0, 0, 1).Format(domain.DateLayout),
+				EndDate:   start.AddDate(0, 0, 1).Format(domain.DateLayout),
+				Reason:    "Congresso",
+			}, doctorToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		for _, at := range []time.Time{start.Add(15 * time.Minute), start.Add(-2 * time.Hour), start.AddDate(0, 0, 1)} {
+			rec = app.DoJSON(t, http.MethodPost, "/v1/appointments", domain.BookAppointmentRequest{
+				PatientID: otherID,
+				DoctorID:  doctorID,
+				StartsAt:  at,
+				EndsAt:    at.Add(30 * time.Minute),
+			}, receptionToken)
+			assert.Equal(t, http.StatusBadRequest, rec.Code, at)
+		}
+
+		// Back-to-back appointments don't overlap
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments", domain.BookAppointmentRequest{
+			PatientID: otherID,
+			DoctorID:  doctorID,
+			StartsAt:  start.Add(30 * time.Minute),
+			EndsAt:    start.Add(time.Hour),
+		}, receptionToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		other := decode(t, rec.Body.Bytes())
+
+		// Patients only see their own appointments
+		rec = app.DoJSON(t, http.MethodGet, "/v1/appointments", nil, patientToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var page domain.AppointmentPage
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, booked.ID, page.Items[0].ID)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/appointments/"+other.ID, nil, patientToken)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/appointments?doctor_id="+doctorID, nil, receptionToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		assert.Equal(t, int64(2), page.Total)
+	})
+
+	t.Run("should reschedule, cancel and check in", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		doctorID, doctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "doctor.appt@test.com", domain.UserProfile{})
+		_, patientToken := app.RegisterAndLogin(t, domain.UserTypePatient, "patient.appt@test.com", domain.UserProfile{})
+		openCalendar(t, doctorID, doctorToken)
+
+		book := func(t *testing.T, at time.Time) domain.Appointment {
+			t.Helper()
+
+			rec := app.DoJSON(t, http.MethodPost, "/v1/appointments", domain.BookAppointmentRequest{
+				DoctorID: doctorID,
+				StartsAt: at,
+				EndsAt:   at.Add(30 * time.Minute),
+			}, patientToken)
+			require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+			return decode(t, rec.Body.Bytes())
+		}
+		first := book(t, start)
+		second := book(t, start.Add(time.Hour))
+
+		// Moving onto another appointment of the doctor fails and keeps the time
+		rec := app.DoJSON(t, http.MethodPost, "/v1/appointments/"+first.ID+"/reschedule", domain.RescheduleAppointmentRequest{
+			StartsAt: start.Add(time.Hour),
+			EndsAt:   start.Add(90 * time.Minute),
+		}, patientToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		rec = app.DoJSON(t, http.MethodGet, "/v1/appointments/"+first.ID, nil, patientToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		unchanged := decode(t, rec.Body.Bytes())
+		assert.True(t, start.Equal(unchanged.StartsAt))
+		assert.Equal(t, domain.AppointmentStatusBooked, unchanged.Status)
+		assert.Len(t, unchanged.History, 1)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments/"+first.ID+"/reschedule", domain.RescheduleAppointmentRequest{
+			StartsAt: start.Add(2 * time.Hour),
+			EndsAt:   start.Add(150 * time.Minute),
+			Reason:   "Conflito no trabalho",
+		}, patientToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		rescheduled := decode(t, rec.Body.Bytes())
+		assert.Equal(t, domain.AppointmentStatusRescheduled, rescheduled.Status)
+		require.Len(t, rescheduled.History, 2)
+		require.NotNil(t, rescheduled.History[1].PrevStartsAt)
+		assert.True(t, start.Equal(*rescheduled.History[1].PrevStartsAt))
+
+		// Patients can't check themselves in
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments/"+second.ID+"/check-in", nil, patientToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		// It's too early to check in, until the appointment is about to start
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments/"+second.ID+"/check-in", nil, doctorToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		soon := time.Now().Add(10 * time.Minute)
+		_, err := tc.Database.Collection("appointments").UpdateOne(ctx, bson.M{"_id": second.ID},
+			bson.M{"$set": bson.M{"starts_at": soon, "ends_at": soon.Add(30 * time.Minute)}})
+		require.NoError(t, err)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments/"+second.ID+"/check-in", nil, doctorToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, domain.AppointmentStatusCheckedIn, decode(t, rec.Body.Bytes()).Status)
+
+		// The appointment hasn't started yet
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments/"+first.ID+"/no-show", nil, doctorToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments/"+first.ID+"/cancel",
+			domain.CancelAppointmentRequest{Reason: "Viagem"}, patientToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, domain.AppointmentStatusCancelled, decode(t, rec.Body.Bytes()).Status)
+
+		// Cancelled appointments are final and free the time
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments/"+first.ID+"/cancel",
+			domain.CancelAppointmentRequest{Reason: "Viagem"}, patientToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		book(t, start.Add(2*time.Hour))
+	})
+
+	t.Run("should keep one of concurrent overlapping bookings", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		doctorID, doctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "doctor.appt@test.com", domain.UserProfile{})
+		patientID, _ := app.RegisterAndLogin(t, domain.UserTypePatient, "patient.appt@test.com", domain.UserProfile{})
+		_, receptionToken := app.RegisterAndLogin(t, domain.UserTypeReceptionist, "reception.appt@test.com", domain.UserProfile{})
+		openCalendar(t, doctorID, doctorToken)
+
+		const attempts = 10
+		codes := make([]int, attempts)
+		var wg sync.WaitGroup
+		for i := range attempts {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				// Every booking takes the same slot
+				rec := app.DoJSON(t, http.MethodPost, "/v1/appointments", domain.BookAppointmentRequest{
+					PatientID: patientID,
+					DoctorID:  doctorID,
+					StartsAt:  start,
+					EndsAt:    start.Add(30 * time.Minute),
+				}, receptionToken)
+				codes[i] = rec.Code
+			}()
+		}
+		wg.Wait()
+
+		created := 0
+		for _, code := range codes {
+			if code == http.StatusCreated {
+				created++
+			} else {
+				assert.Equal(t, http.StatusConflict, code)
+			}
+		}
+		assert.Equal(t, 1, created)
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/appointments?doctor_id="+doctorID, nil, receptionToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var page domain.AppointmentPage
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		assert.Equal(t, int64(1), page.Total)
+	})
+
+	t.Run("should deny users without appointment permissions", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		_, nurseToken := app.RegisterAndLogin(t, domain.UserTypeNurse, "nurse.appt@test.com", domain.UserProfile{})
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/appointments", nil, nurseToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/appointments", nil, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
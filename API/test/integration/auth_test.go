@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -46,7 +47,7 @@ func TestAuthIntegration(t *testing.T) {
 					LastName:    "Silva",
 					Phone:       "+55-11-99999-9999",
 					DateOfBirth: "1990-01-01",
-					CPF:         "123.456.789-00",
+					CPF:         "123.456.789-09",
 				},
 			},
 			{
@@ -87,25 +88,35 @@ func TestAuthIntegration(t *testing.T) {
 					Profile:  user.profile,
 				}
 
-				reqBody, err := json.Marshal(registerReq)
-				require.NoError(t, err)
-
-				req := httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(reqBody))
-				req.Header.Set("Content-Type", "application/json")
+				// Only patients self-register, staff accept an invitation
 				rec := httptest.NewRecorder()
-
-				app.Echo.ServeHTTP(rec, req)
+				if user.userType == domain.UserTypePatient {
+					reqBody, err := json.Marshal(registerReq)
+					require.NoError(t, err)
+
+					req := httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(reqBody))
+					req.Header.Set("Content-Type", "application/json")
+					app.Echo.ServeHTTP(rec, req)
+				} else {
+					rec = app.RegisterStaff(t, registerReq)
+				}
 
 				assert.Equal(t, http.StatusCreated, rec.Code)
 
 				var registerResp domain.RegisterResponse
-				err = json.Unmarshal(rec.Body.Bytes(), &registerResp)
+				err := json.Unmarshal(rec.Body.Bytes(), &registerResp)
 				require.NoError(t, err)
 
 				assert.NotEmpty(t, registerResp.ID)
 				assert.Equal(t, user.email, registerResp.Email)
 				assert.Equal(t, user.userType, registerResp.Type)
 				assert.Equal(t, user.profile.FirstName, registerResp.Profile.FirstName)
+
+				// Patients confirm their email before logging in
+				if user.userType == domain.UserTypePatient {
+					assert.Equal(t, domain.UserStatusPending, registerResp.Status)
+					app.VerifyEmail(t, user.email)
+				}
 			})
 
 			t.Run("Login_"+user.name, func(t *testing.T) {
@@ -176,6 +187,43 @@ func TestAuthIntegration(t *testing.T) {
 		assert.Equal(t, http.StatusConflict, rec2.Code)
 	})
 
+	t.Run("should enforce unique emails and documents under concurrent registrations", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register := func(email, cpf string) int {
+			return app.DoJSON(t, http.MethodPost, "/v1/auth/register", domain.RegisterRequest{
+				Email:    email,
+				Password: "password123",
+				Profile:  domain.UserProfile{FirstName: "Race", LastName: "Condition", CPF: cpf},
+			}, "").Code
+		}
+
+		// Every request passes the existence check before any of them is inserted
+		const attempts = 8
+		codes := make(chan int, attempts)
+		var wg sync.WaitGroup
+		for i := 0; i < attempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				codes <- register("race@test.com", "")
+			}()
+		}
+		wg.Wait()
+		close(codes)
+
+		counts := map[int]int{}
+		for code := range codes {
+			counts[code]++
+		}
+		assert.Equal(t, map[int]int{http.StatusCreated: 1, http.StatusConflict: attempts - 1}, counts)
+
+		// The CPF is unique too, whatever its punctuation
+		assert.Equal(t, http.StatusCreated, register("first.cpf@test.com", "529.982.247-25"))
+		assert.Equal(t, http.StatusConflict, register("second.cpf@test.com", "52998224725"))
+	})
+
 	t.Run("should reject invalid login credentials", func(t *testing.T) {
 		// Clean database before test
 		tc.CleanDatabase(ctx, t)
@@ -218,4 +266,43 @@ func TestAuthIntegration(t *testing.T) {
 		app.Echo.ServeHTTP(rec, req)
 		assert.Equal(t, http.StatusUnauthorized, rec.Code)
 	})
+
+	t.Run("should only let patients self-register", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		profile := domain.UserProfile{FirstName: "Self", LastName: "Registered"}
+
+		// Staff types need an invitation
+		for _, userType := range []domain.UserType{domain.UserTypeAdmin, domain.UserTypeDoctor, domain.UserTypeNurse, domain.UserTypeReceptionist} {
+			rec := app.DoJSON(t, http.MethodPost, "/v1/auth/register", domain.RegisterRequest{
+				Email:    string(userType) + ".self@test.com",
+				Password: "password123",
+				Type:     userType,
+				Profile:  profile,
+			}, "")
+			assert.Equal(t, http.StatusForbidden, rec.Code, userType)
+		}
+
+		// Unknown types are rejected
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/register", domain.RegisterRequest{
+			Email:    "unknown.self@test.com",
+			Password: "password123",
+			Type:     "superuser",
+			Profile:  profile,
+		}, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		// Omitting the type registers a patient
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/register", domain.RegisterRequest{
+			Email:    "default.self@test.com",
+			Password: "password123",
+			Profile:  profile,
+		}, "")
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var registerResp domain.RegisterResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registerResp))
+		assert.Equal(t, domain.UserTypePatient, registerResp.Type)
+	})
 }
@@ -40,15 +40,22 @@ func TestAuthorizationIntegration(t *testing.T) {
 			},
 		}
 
-		reqBody, err := json.Marshal(registerReq)
-		require.NoError(t, err)
+		if userType == domain.UserTypePatient {
+			reqBody, err := json.Marshal(registerReq)
+			require.NoError(t, err)
 
-		req := httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(reqBody))
-		req.Header.Set("Content-Type", "application/json")
-		rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
 
-		app.Echo.ServeHTTP(rec, req)
-		require.Equal(t, http.StatusCreated, rec.Code)
+			app.Echo.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusCreated, rec.Code)
+
+			app.VerifyEmail(t, email)
+		} else {
+			rec := app.RegisterStaff(t, registerReq)
+			require.Equal(t, http.StatusCreated, rec.Code)
+		}
 
 		// Login user
 		loginReq := domain.LoginRequest{
@@ -56,12 +63,12 @@ func TestAuthorizationIntegration(t *testing.T) {
 			Password: password,
 		}
 
-		reqBody, err = json.Marshal(loginReq)
+		reqBody, err := json.Marshal(loginReq)
 		require.NoError(t, err)
 
-		req = httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(reqBody))
 		req.Header.Set("Content-Type", "application/json")
-		rec = httptest.NewRecorder()
+		rec := httptest.NewRecorder()
 
 		app.Echo.ServeHTTP(rec, req)
 		require.Equal(t, http.StatusOK, rec.Code)
@@ -0,0 +1,148 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestAvailabilityIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app, whose clinic is in UTC
+	app := SetupTestApp(tc)
+
+	// A week from now, so none of its slots are past
+	day := time.Now().UTC().AddDate(0, 0, 7)
+	date := day.Format(domain.DateLayout)
+	at := func(hour, minute int) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, time.UTC)
+	}
+
+	slots := func(t *testing.T, doctorID, token string) domain.SlotList {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/doctors/"+doctorID+"/slots?from="+date+"&to="+date, nil, token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var list domain.SlotList
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+		return list
+	}
+
+	t.Run("should list free slots from the hours, exceptions and bookings", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		doctorID, doctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "doctor.slots@test.com", domain.UserProfile{})
+		_, patientToken := app.RegisterAndLogin(t, domain.UserTypePatient, "patient.slots@test.com", domain.UserProfile{})
+
+		// No hours until the doctor sets them
+		assert.Empty(t, slots(t, doctorID, patientToken).Slots)
+
+		rec := app.DoJSON(t, http.MethodPut, "/v1/doctors/"+doctorID+"/availability", domain.SetAvailabilityRequest{
+			Weekly: []domain.WeeklyHours{{Weekday: day.Weekday(), Start: "09:00", End: "11:00"}},
+		}, doctorToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		list := slots(t, doctorID, patientToken)
+		assert.Equal(t, "UTC", list.Timezone)
+		assert.Equal(t, 30, list.SlotMinutes)
+		require.Len(t, list.Slots, 4)
+		assert.True(t, at(9, 0).Equal(list.Slots[0].StartsAt))
+
+		// A booked appointment takes its slot
+		rec = app.DoJSON(t, http.MethodPost, "/v1/appointments", domain.BookAppointmentRequest{
+			DoctorID: doctorID,
+			StartsAt: at(9, 30),
+			EndsAt:   at(10, 0),
+		}, patientToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		list = slots(t, doctorID, patientToken)
+		require.Len(t, list.Slots, 3)
+		assert.True(t, at(10, 0).Equal(list.Slots[1].StartsAt))
+
+		// The exception replaces the weekly hours of the day
+		rec = app.DoJSON(t, http.MethodPost, "/v1/doctors/"+doctorID+"/availability/exceptions",
+			domain.CreateAvailabilityExceptionRequest{
+				StartDate: date,
+				EndDate:   date,
+				Reason:    "Plantão",
+				Hours:     []domain.TimeWindow{{Start: "14:00", End: "15:00"}},
+			}, doctorToken)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var exception domain.AvailabilityException
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &exception))
+		list = slots(t, doctorID, patientToken)
+		require.Len(t, list.Slots, 2)
+		assert.True(t, at(14, 0).Equal(list.Slots[0].StartsAt))
+
+		rec = app.DoJSON(t, http.MethodDelete, "/v1/doctors/"+doctorID+"/availability/exceptions/"+exception.ID, nil, doctorToken)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Len(t, slots(t, doctorID, patientToken).Slots, 3)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/doctors/"+doctorID+"/slots?from="+date+"&to="+day.AddDate(0, 1, 7).Format(domain.DateLayout),
+			nil, patientToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("should size slots by speciality", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		doctorID, doctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "psych.slots@test.com",
+			domain.UserProfile{Speciality: "Psiquiatria"})
+
+		rec := app.DoJSON(t, http.MethodPut, "/v1/doctors/"+doctorID+"/availability", domain.SetAvailabilityRequest{
+			Weekly: []domain.WeeklyHours{{Weekday: day.Weekday(), Start: "09:00", End: "11:00"}},
+		}, doctorToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		list := slots(t, doctorID, doctorToken)
+		assert.Equal(t, 50, list.SlotMinutes)
+		assert.Len(t, list.Slots, 2)
+	})
+
+	t.Run("should only let the doctor and the front desk manage the calendar", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		doctorID, _ := app.RegisterAndLogin(t, domain.UserTypeDoctor, "doctor.slots@test.com", domain.UserProfile{})
+		_, otherToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "other.slots@test.com",
+			domain.UserProfile{CRM: "CRM/SP 200000"})
+		_, receptionToken := app.RegisterAndLogin(t, domain.UserTypeReceptionist, "reception.slots@test.com", domain.UserProfile{})
+		_, patientToken := app.RegisterAndLogin(t, domain.UserTypePatient, "patient.slots@test.com", domain.UserProfile{})
+
+		weekly := domain.SetAvailabilityRequest{Weekly: []domain.WeeklyHours{{Weekday: time.Monday, Start: "08:00", End: "12:00"}}}
+		rec := app.DoJSON(t, http.MethodPut, "/v1/doctors/"+doctorID+"/availability", weekly, patientToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		rec = app.DoJSON(t, http.MethodPut, "/v1/doctors/"+doctorID+"/availability", weekly, otherToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPut, "/v1/doctors/"+doctorID+"/availability", weekly, receptionToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		rec = app.DoJSON(t, http.MethodGet, "/v1/doctors/"+doctorID+"/availability", nil, receptionToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var availability domain.Availability
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &availability))
+		assert.Equal(t, weekly.Weekly, availability.Weekly)
+
+		// Overlapping hours are rejected
+		weekly.Weekly = append(weekly.Weekly, domain.WeeklyHours{Weekday: time.Monday, Start: "11:00", End: "13:00"})
+		rec = app.DoJSON(t, http.MethodPut, "/v1/doctors/"+doctorID+"/availability", weekly, receptionToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/doctors/"+doctorID+"/slots", nil, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
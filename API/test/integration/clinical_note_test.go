@@ -0,0 +1,151 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestClinicalNoteIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	// decodeNote checks the status of a response and returns the note in it
+	decodeNote := func(t *testing.T, rec *httptest.ResponseRecorder, status int) domain.ClinicalNote {
+		t.Helper()
+
+		require.Equal(t, status, rec.Code, rec.Body.String())
+		var note domain.ClinicalNote
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &note))
+		return note
+	}
+
+	t.Run("should keep signed notes immutable and amend them with history", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		doctorID, doctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "doctor.note@test.com",
+			domain.UserProfile{FirstName: "Ana", LastName: "Souza"})
+		_, otherDoctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "other.doctor.note@test.com",
+			domain.UserProfile{FirstName: "Bruno", LastName: "Lima", CRM: "CRM/SP 222222"})
+		_, nurseToken := app.RegisterAndLogin(t, domain.UserTypeNurse, "nurse.note@test.com",
+			domain.UserProfile{FirstName: "Carla", LastName: "Dias"})
+		patientID, patientToken := app.RegisterAndLogin(t, domain.UserTypePatient, "patient.note@test.com",
+			domain.UserProfile{FirstName: "Paulo", LastName: "Reis"})
+		notesPath := "/v1/patients/" + patientID + "/medical-record/notes"
+
+		rec := app.DoJSON(t, http.MethodPost, notesPath, domain.CreateClinicalNoteRequest{
+			NoteContent: domain.NoteContent{Title: "Evolução", Body: "Queixa: tosse\nConduta: repouso"},
+		}, doctorToken)
+		note := decodeNote(t, rec, http.StatusCreated)
+		assert.Equal(t, domain.NoteDraft, note.Status)
+		assert.Equal(t, doctorID, note.AuthorID)
+		assert.Equal(t, 0, note.Version)
+		path := notesPath + "/" + note.ID
+
+		// Drafts are only visible to doctors and only changed by their author
+		rec = app.DoJSON(t, http.MethodGet, path, nil, patientToken)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = app.DoJSON(t, http.MethodPatch, path, domain.UpdateClinicalNoteRequest{
+			NoteContent: domain.NoteContent{Title: "Evolução", Body: "Queixa: tosse seca\nConduta: repouso"},
+		}, otherDoctorToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		rec = app.DoJSON(t, http.MethodPatch, path, domain.UpdateClinicalNoteRequest{
+			NoteContent: domain.NoteContent{Title: "Evolução", Body: "Queixa: tosse seca\nConduta: repouso"},
+		}, doctorToken)
+		note = decodeNote(t, rec, http.StatusOK)
+		assert.Equal(t, "Queixa: tosse seca\nConduta: repouso", note.Body)
+
+		// Only the author signs, and only the draft they read
+		read := domain.SignClinicalNoteRequest{UpdatedAt: note.UpdatedAt}
+		rec = app.DoJSON(t, http.MethodPost, path+"/sign", read, otherDoctorToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, path+"/sign", nil, doctorToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, path+"/sign", domain.SignClinicalNoteRequest{UpdatedAt: note.CreatedAt}, doctorToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, path+"/sign", read, doctorToken)
+		note = decodeNote(t, rec, http.StatusOK)
+		assert.Equal(t, domain.NoteSigned, note.Status)
+		assert.Equal(t, 1, note.Version)
+		assert.NotNil(t, note.SignedAt)
+		assert.Empty(t, note.Amendments)
+
+		// Signed notes never change in place
+		rec = app.DoJSON(t, http.MethodPatch, path, domain.UpdateClinicalNoteRequest{
+			NoteContent: domain.NoteContent{Title: "Evolução", Body: "Outro texto"},
+		}, doctorToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, path+"/sign", read, doctorToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		// Amendments are added by the author, with their diff and reason, leaving the original unchanged
+		corrected := domain.NoteContent{Title: "Evolução", Body: "Queixa: tosse seca\nConduta: salbutamol"}
+		rec = app.DoJSON(t, http.MethodPost, path+"/amendments", domain.AmendClinicalNoteRequest{
+			NoteContent: corrected,
+			Reason:      "Conduta corrigida",
+		}, otherDoctorToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, path+"/amendments", domain.AmendClinicalNoteRequest{
+			NoteContent: note.NoteContent,
+			Reason:      "Revisão",
+		}, doctorToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, path+"/amendments", domain.AmendClinicalNoteRequest{
+			NoteContent: corrected,
+			Reason:      "Conduta corrigida",
+			Amends:      1,
+		}, doctorToken)
+		note = decodeNote(t, rec, http.StatusCreated)
+		assert.Equal(t, 2, note.Version)
+		assert.Equal(t, "Queixa: tosse seca\nConduta: repouso", note.Body)
+		require.Len(t, note.Amendments, 1)
+		amendment := note.Amendments[0]
+		assert.NotEmpty(t, amendment.ID)
+		assert.Equal(t, note.ID, amendment.NoteID)
+		assert.Equal(t, 2, amendment.Version)
+		assert.Equal(t, 1, amendment.Amends)
+		assert.Equal(t, corrected, amendment.NoteContent)
+		assert.Equal(t, doctorID, amendment.AuthorID)
+		assert.Equal(t, "Conduta corrigida", amendment.Reason)
+		assert.False(t, amendment.CreatedAt.IsZero())
+		assert.Equal(t, []domain.DiffLine{
+			{Op: domain.DiffEqual, Text: "Queixa: tosse seca"},
+			{Op: domain.DiffDelete, Text: "Conduta: repouso"},
+			{Op: domain.DiffInsert, Text: "Conduta: salbutamol"},
+		}, amendment.Diff.Body)
+
+		// Amending a version that was corrected meanwhile fails
+		rec = app.DoJSON(t, http.MethodPost, path+"/amendments", domain.AmendClinicalNoteRequest{
+			NoteContent: domain.NoteContent{Title: "Evolução", Body: "Queixa: tosse\nConduta: salbutamol"},
+			Reason:      "Queixa corrigida",
+			Amends:      1,
+		}, doctorToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		// Patients see their signed notes, nurses none
+		rec = app.DoJSON(t, http.MethodGet, path, nil, patientToken)
+		note = decodeNote(t, rec, http.StatusOK)
+		assert.Equal(t, 2, note.Version)
+		assert.Len(t, note.Amendments, 1)
+		rec = app.DoJSON(t, http.MethodGet, notesPath, nil, nurseToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, notesPath, domain.CreateClinicalNoteRequest{
+			NoteContent: domain.NoteContent{Title: "Triagem", Body: "PA 12x8"},
+		}, nurseToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
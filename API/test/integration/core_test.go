@@ -46,16 +46,20 @@ func TestCoreIntegration(t *testing.T) {
 					Profile: domain.UserProfile{
 						FirstName: user.name,
 						LastName:  "Test User",
-						Phone:     "123456789",
+						Phone:     "11999999999",
 					},
 				}
 
-				body, _ := json.Marshal(registerReq)
-				req := httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(body))
-				req.Header.Set("Content-Type", "application/json")
+				// Only patients self-register, staff accept an invitation
 				rec := httptest.NewRecorder()
-
-				app.Echo.ServeHTTP(rec, req)
+				if user.userType == domain.UserTypePatient {
+					body, _ := json.Marshal(registerReq)
+					req := httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(body))
+					req.Header.Set("Content-Type", "application/json")
+					app.Echo.ServeHTTP(rec, req)
+				} else {
+					rec = app.RegisterStaff(t, registerReq)
+				}
 				assert.Equal(t, http.StatusCreated, rec.Code)
 
 				var registerResp domain.RegisterResponse
@@ -63,6 +67,10 @@ func TestCoreIntegration(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, user.userType, registerResp.Type)
 				assert.Equal(t, user.email, registerResp.Email)
+
+				if user.userType == domain.UserTypePatient {
+					app.VerifyEmail(t, user.email)
+				}
 			})
 		}
 
@@ -107,7 +115,6 @@ func TestCoreIntegration(t *testing.T) {
 
 				assert.Equal(t, string(user.userType), response["type"])
 				assert.Equal(t, user.email, response["email"])
-				assert.Contains(t, response["message"], string(user.userType))
 			})
 		}
 	})
@@ -0,0 +1,120 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestDoctorDirectoryIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	list := func(t *testing.T, query, token string) domain.DoctorPage {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/doctors"+query, nil, token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var page domain.DoctorPage
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		return page
+	}
+
+	t.Run("should search doctors without exposing private details", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		anaID, anaToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "ana.directory@test.com", domain.UserProfile{
+			FirstName: "Ana", LastName: "Maria Souza", Phone: "+5511988887777",
+			CRM: "CRM/SP 111111", Speciality: "Cardiologia", Department: "Ambulatório",
+		})
+		app.RegisterAndLogin(t, domain.UserTypeDoctor, "bruno.directory@test.com", domain.UserProfile{
+			FirstName: "Bruno", LastName: "Lima", CRM: "CRM/SP 222222", Speciality: "Pediatria", Department: "Ambulatório",
+		})
+		app.RegisterAndLogin(t, domain.UserTypeNurse, "nurse.directory@test.com", domain.UserProfile{FirstName: "Carla", LastName: "Dias"})
+		_, patientToken := app.RegisterAndLogin(t, domain.UserTypePatient, "patient.directory@test.com",
+			domain.UserProfile{FirstName: "Paulo", LastName: "Reis"})
+
+		page := list(t, "", patientToken)
+		assert.Equal(t, int64(2), page.Total)
+		require.Len(t, page.Items, 2)
+		assert.Equal(t, "Ana", page.Items[0].FirstName)
+		assert.Equal(t, "CRM/SP 111111", page.Items[0].CRM)
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/doctors", nil, patientToken)
+		for _, private := range []string{"ana.directory@test.com", "+5511988887777", "password", "status"} {
+			assert.NotContains(t, rec.Body.String(), private)
+		}
+
+		// Words match the start of any word of the name, speciality or department
+		page = list(t, "?search=souza+cardio", patientToken)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, anaID, page.Items[0].ID)
+		assert.Empty(t, list(t, "?search=ouza", patientToken).Items)
+
+		page = list(t, "?speciality=PEDIATRIA", patientToken)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, "Bruno", page.Items[0].FirstName)
+		assert.Equal(t, int64(2), list(t, "?department=ambulat%C3%B3rio", patientToken).Total)
+
+		page = list(t, "?sort=-first_name&limit=1", patientToken)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, "Bruno", page.Items[0].FirstName)
+		require.NotEmpty(t, page.NextCursor)
+		page = list(t, "?sort=-first_name&limit=1&cursor="+page.NextCursor, patientToken)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, "Ana", page.Items[0].FirstName)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/doctors?sort=email", nil, patientToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		// The next free slot shows up once the doctor has hours
+		tomorrow := time.Now().UTC().AddDate(0, 0, 1)
+		rec = app.DoJSON(t, http.MethodPut, "/v1/doctors/"+anaID+"/availability", domain.SetAvailabilityRequest{
+			Weekly: []domain.WeeklyHours{{Weekday: tomorrow.Weekday(), Start: "09:00", End: "10:00"}},
+		}, anaToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/doctors/"+anaID, nil, patientToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var doctor domain.DoctorProfile
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doctor))
+		require.NotNil(t, doctor.NextSlot)
+		assert.True(t, time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 9, 0, 0, 0, time.UTC).Equal(doctor.NextSlot.StartsAt))
+	})
+
+	t.Run("should only list active doctors to authenticated users", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		doctorID, _ := app.RegisterAndLogin(t, domain.UserTypeDoctor, "ana.directory@test.com",
+			domain.UserProfile{FirstName: "Ana", LastName: "Souza"})
+		nurseID, nurseToken := app.RegisterAndLogin(t, domain.UserTypeNurse, "nurse.directory@test.com",
+			domain.UserProfile{FirstName: "Carla", LastName: "Dias"})
+
+		_, err := app.UserRepo.UpdateStatus(ctx, doctorID, domain.UserStatusBlocked, "Licença", "admin")
+		require.NoError(t, err)
+
+		assert.Empty(t, list(t, "", nurseToken).Items)
+		rec := app.DoJSON(t, http.MethodGet, "/v1/doctors/"+doctorID, nil, nurseToken)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = app.DoJSON(t, http.MethodGet, "/v1/doctors/"+nurseID, nil, nurseToken)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/doctors", nil, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
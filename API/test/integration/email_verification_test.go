@@ -0,0 +1,148 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestEmailVerificationIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	register := func(t *testing.T, userType domain.UserType, email string) domain.RegisterResponse {
+		t.Helper()
+
+		rec := app.Register(t, domain.RegisterRequest{
+			Email:    email,
+			Password: "password123",
+			Type:     userType,
+			Profile: domain.UserProfile{
+				FirstName: "Verify",
+				LastName:  "User",
+				Phone:     "+55-11-99999-9999",
+			},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var registerResp domain.RegisterResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registerResp))
+		return registerResp
+	}
+
+	login := func(t *testing.T, email string) (int, []byte) {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: "password123"}, "")
+		return rec.Code, rec.Body.Bytes()
+	}
+
+	t.Run("should keep patients pending until they verify their email", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		registerResp := register(t, domain.UserTypePatient, "pending@test.com")
+		assert.Equal(t, domain.UserStatusPending, registerResp.Status)
+
+		code, body := login(t, "pending@test.com")
+		assert.Equal(t, http.StatusForbidden, code)
+		var apiErr domain.APIError
+		require.NoError(t, json.Unmarshal(body, &apiErr))
+		assert.Equal(t, domain.ProblemTypeAccountPending, apiErr.Type)
+
+		token := app.TokenFromEmail(t, "pending@test.com")
+
+		// The verification token can't be used as an access token
+		rec := app.DoJSON(t, http.MethodGet, "/v1/protected", nil, token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/verify-email", domain.VerifyEmailRequest{Token: token}, "")
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		code, _ = login(t, "pending@test.com")
+		assert.Equal(t, http.StatusOK, code)
+
+		user, err := app.UserRepo.GetByID(ctx, registerResp.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.UserStatusActive, user.Status)
+		assert.NotNil(t, user.EmailVerifiedAt)
+
+		// The link only works once
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/verify-email", domain.VerifyEmailRequest{Token: token}, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("should throttle verification resends", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypePatient, "throttle@test.com")
+		sent := len(app.Mailer.Messages())
+
+		// The registration email was just sent, so nothing is sent, without telling the account is pending
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/verify-email/resend", domain.ResendVerificationRequest{Email: "throttle@test.com"}, "")
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Len(t, app.Mailer.Messages(), sent)
+
+		// Unknown emails get the same response as registered ones
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/verify-email/resend", domain.ResendVerificationRequest{Email: "nobody@test.com"}, "")
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+
+		// Verified accounts don't get more emails
+		app.VerifyEmail(t, "throttle@test.com")
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/verify-email/resend", domain.ResendVerificationRequest{Email: "throttle@test.com"}, "")
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Len(t, app.Mailer.Messages(), sent)
+	})
+
+	t.Run("should reject invalid verification tokens", func(t *testing.T) {
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/verify-email", domain.VerifyEmailRequest{Token: "invalid-token"}, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/verify-email", domain.VerifyEmailRequest{}, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("should let admins verify pending accounts", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypeAdmin, "admin.verify@test.com")
+		patient := register(t, domain.UserTypePatient, "patient.verify@test.com")
+
+		code, body := login(t, "admin.verify@test.com")
+		require.Equal(t, http.StatusOK, code)
+		var admin domain.LoginResponse
+		require.NoError(t, json.Unmarshal(body, &admin))
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/admin/users/"+patient.ID+"/verify-email", nil, admin.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var verified domain.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &verified))
+		assert.Equal(t, domain.UserStatusActive, verified.Status)
+		assert.NotNil(t, verified.EmailVerifiedAt)
+
+		code, _ = login(t, "patient.verify@test.com")
+		assert.Equal(t, http.StatusOK, code)
+
+		// Already verified accounts and unknown users are rejected
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/users/"+patient.ID+"/verify-email", nil, admin.Token)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/users/unknown/verify-email", nil, admin.Token)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
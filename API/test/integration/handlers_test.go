@@ -32,7 +32,7 @@ func TestHandlersIntegration(t *testing.T) {
 				LastName:    "Silva",
 				Phone:       "11999999999",
 				DateOfBirth: "1990-01-01",
-				CPF:         "123.456.789-00",
+				CPF:         "123.456.789-09",
 			},
 		}
 
@@ -48,6 +48,10 @@ func TestHandlersIntegration(t *testing.T) {
 		err := json.Unmarshal(rec.Body.Bytes(), &registerResp)
 		require.NoError(t, err)
 		assert.Equal(t, domain.UserTypePatient, registerResp.Type)
+		assert.Equal(t, domain.UserStatusPending, registerResp.Status)
+
+		// Patients confirm their email before logging in
+		app.VerifyEmail(t, "patient.journey@test.com")
 
 		// Step 2: Login as patient
 		loginReq := domain.LoginRequest{
@@ -76,12 +80,7 @@ func TestHandlersIntegration(t *testing.T) {
 		app.Echo.ServeHTTP(rec, req)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var profileResp struct {
-			UserID  string          `json:"user_id"`
-			Email   string          `json:"email"`
-			Type    domain.UserType `json:"type"`
-			Message string          `json:"message"`
-		}
+		var profileResp domain.User
 		err = json.Unmarshal(rec.Body.Bytes(), &profileResp)
 		require.NoError(t, err)
 		assert.Equal(t, domain.UserTypePatient, profileResp.Type)
@@ -97,18 +96,13 @@ func TestHandlersIntegration(t *testing.T) {
 			Profile: domain.UserProfile{
 				FirstName:  "Maria",
 				LastName:   "Santos",
-				Phone:      "11888888888",
-				CRM:        "CRM12345",
+				Phone:      "11988888888",
+				CRM:        "CRM/SP 12345",
 				Speciality: "Cardiologia",
 			},
 		}
 
-		body, _ := json.Marshal(doctorReq)
-		req := httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(body))
-		req.Header.Set("Content-Type", "application/json")
-		rec := httptest.NewRecorder()
-
-		app.Echo.ServeHTTP(rec, req)
+		rec := app.RegisterStaff(t, doctorReq)
 		assert.Equal(t, http.StatusCreated, rec.Code)
 
 		// Step 2: Login as doctor
@@ -117,8 +111,8 @@ func TestHandlersIntegration(t *testing.T) {
 			Password: "password123",
 		}
 
-		body, _ = json.Marshal(loginReq)
-		req = httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(body))
+		body, _ := json.Marshal(loginReq)
+		req := httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(body))
 		req.Header.Set("Content-Type", "application/json")
 		rec = httptest.NewRecorder()
 
@@ -177,16 +171,11 @@ func TestHandlersIntegration(t *testing.T) {
 			Profile: domain.UserProfile{
 				FirstName: "Doctor",
 				LastName:  "For Admin",
-				Phone:     "11888888888",
+				Phone:     "11988888888",
 			},
 		}
 
-		body, _ = json.Marshal(doctorReq)
-		req = httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(body))
-		req.Header.Set("Content-Type", "application/json")
-		rec = httptest.NewRecorder()
-
-		app.Echo.ServeHTTP(rec, req)
+		rec = app.RegisterStaff(t, doctorReq)
 		assert.Equal(t, http.StatusCreated, rec.Code)
 
 		// Step 2: Register an admin
@@ -197,16 +186,11 @@ func TestHandlersIntegration(t *testing.T) {
 			Profile: domain.UserProfile{
 				FirstName: "Admin",
 				LastName:  "User",
-				Phone:     "11777777777",
+				Phone:     "11977777777",
 			},
 		}
 
-		body, _ = json.Marshal(adminReq)
-		req = httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(body))
-		req.Header.Set("Content-Type", "application/json")
-		rec = httptest.NewRecorder()
-
-		app.Echo.ServeHTTP(rec, req)
+		rec = app.RegisterStaff(t, adminReq)
 		assert.Equal(t, http.StatusCreated, rec.Code)
 
 		// Step 3: Login as admin
@@ -238,13 +222,10 @@ func TestHandlersIntegration(t *testing.T) {
 		app.Echo.ServeHTTP(rec, req)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var usersResp struct {
-			Users      []domain.User `json:"users"`
-			TotalCount int           `json:"total_count"`
-		}
+		var usersResp domain.Page[domain.User]
 		err = json.Unmarshal(rec.Body.Bytes(), &usersResp)
 		require.NoError(t, err)
-		assert.GreaterOrEqual(t, usersResp.TotalCount, 3) // At least the 3 users we created in this test
+		assert.GreaterOrEqual(t, usersResp.Total, int64(3)) // At least the 3 users we created in this test
 
 		// Step 5: Access system stats
 		req = httptest.NewRequest(http.MethodGet, "/v1/admin/stats", nil)
@@ -254,18 +235,13 @@ func TestHandlersIntegration(t *testing.T) {
 		app.Echo.ServeHTTP(rec, req)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var statsResp struct {
-			TotalUsers    int `json:"total_users"`
-			TotalPatients int `json:"total_patients"`
-			TotalDoctors  int `json:"total_doctors"`
-			TotalAdmins   int `json:"total_admins"`
-		}
+		var statsResp domain.SystemStats
 		err = json.Unmarshal(rec.Body.Bytes(), &statsResp)
 		require.NoError(t, err)
-		assert.GreaterOrEqual(t, statsResp.TotalUsers, 3)
-		assert.GreaterOrEqual(t, statsResp.TotalPatients, 1)
-		assert.GreaterOrEqual(t, statsResp.TotalDoctors, 1)
-		assert.GreaterOrEqual(t, statsResp.TotalAdmins, 1)
+		assert.GreaterOrEqual(t, statsResp.TotalUsers, int64(3))
+		assert.GreaterOrEqual(t, statsResp.ByType[domain.UserTypePatient], int64(1))
+		assert.GreaterOrEqual(t, statsResp.ByType[domain.UserTypeDoctor], int64(1))
+		assert.GreaterOrEqual(t, statsResp.ByType[domain.UserTypeAdmin], int64(1))
 	})
 
 	t.Run("Error Handling and Validation", func(t *testing.T) {
@@ -355,16 +331,11 @@ func TestHandlersIntegration(t *testing.T) {
 				Profile: domain.UserProfile{
 					FirstName: user.name,
 					LastName:  "Test",
-					Phone:     "11666666666",
+					Phone:     "11966666666",
 				},
 			}
 
-			body, _ := json.Marshal(regReq)
-			req := httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(body))
-			req.Header.Set("Content-Type", "application/json")
-			rec := httptest.NewRecorder()
-
-			app.Echo.ServeHTTP(rec, req)
+			rec := app.RegisterStaff(t, regReq)
 			assert.Equal(t, http.StatusCreated, rec.Code)
 
 			// Login user
@@ -373,8 +344,8 @@ func TestHandlersIntegration(t *testing.T) {
 				Password: "password123",
 			}
 
-			body, _ = json.Marshal(loginReq)
-			req = httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(body))
+			body, _ := json.Marshal(loginReq)
+			req := httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(body))
 			req.Header.Set("Content-Type", "application/json")
 			rec = httptest.NewRecorder()
 
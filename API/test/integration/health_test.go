@@ -2,11 +2,16 @@ package integration
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/healthcheck"
 )
 
 func TestHealthCheckIntegration(t *testing.T) {
@@ -38,4 +43,77 @@ func TestHealthCheckIntegration(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 	})
+
+	t.Run("should report liveness without checking the components", func(t *testing.T) {
+		rec := app.DoJSON(t, http.MethodGet, "/health/live", nil, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var report healthcheck.Report
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		assert.Equal(t, healthcheck.WorkingStatus, report.Status)
+		assert.Empty(t, report.Components)
+	})
+
+	t.Run("should report the status of each component when ready", func(t *testing.T) {
+		rec := app.DoJSON(t, http.MethodGet, "/health/ready", nil, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var report healthcheck.Report
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		assert.Equal(t, healthcheck.WorkingStatus, report.Status)
+		require.Len(t, report.Components, 2)
+		assert.Equal(t, "mongodb", report.Components[0].Name)
+		assert.Equal(t, healthcheck.WorkingStatus, report.Components[0].Status)
+		assert.True(t, report.Components[0].Critical)
+		assert.False(t, report.Components[0].CheckedAt.IsZero())
+		assert.Equal(t, "signing_keys", report.Components[1].Name)
+	})
+
+	t.Run("should stay ready but degraded when a non-critical component fails", func(t *testing.T) {
+		app.Health.Register(healthcheck.Component{Name: "mailer", Check: healthcheck.TCPCheck("127.0.0.1:1"), Timeout: time.Second})
+
+		rec := app.DoJSON(t, http.MethodGet, "/health/ready", nil, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var report healthcheck.Report
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		assert.Equal(t, healthcheck.DegradedStatus, report.Status)
+		require.Len(t, report.Components, 3)
+		assert.Equal(t, healthcheck.NotWorkingStatus, report.Components[2].Status)
+		assert.NotEmpty(t, report.Components[2].Error)
+
+		// The legacy endpoint only fails on critical components
+		rec = app.DoJSON(t, http.MethodGet, "/health", nil, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("should report unavailable once shutting down", func(t *testing.T) {
+		var hooks []string
+		app.Shutdown.Register("workers", func(ctx context.Context) error {
+			hooks = append(hooks, "workers")
+			return nil
+		})
+		app.Shutdown.Register("database", func(ctx context.Context) error {
+			hooks = append(hooks, "database")
+			return nil
+		})
+
+		require.NoError(t, app.Shutdown.Shutdown(app.Echo))
+		assert.Equal(t, []string{"workers", "database"}, hooks)
+
+		req := httptest.NewRequest(http.MethodGet, "/health", nil)
+		rec := httptest.NewRecorder()
+
+		app.Echo.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Contains(t, rec.Body.String(), "shutting down")
+
+		rec = app.DoJSON(t, http.MethodGet, "/health/ready", nil, "")
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+		// The process is still alive while it drains
+		rec = app.DoJSON(t, http.MethodGet, "/health/live", nil, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
 }
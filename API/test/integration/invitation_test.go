@@ -0,0 +1,170 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestInvitationIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	login := func(t *testing.T, email, password string) string {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: password}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var loginResp domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		return loginResp.Token
+	}
+
+	setupAdmin := func(t *testing.T) string {
+		t.Helper()
+
+		rec := app.RegisterStaff(t, domain.RegisterRequest{
+			Email:    "admin.invite@test.com",
+			Password: "password123",
+			Type:     domain.UserTypeAdmin,
+			Profile:  domain.UserProfile{FirstName: "Admin", LastName: "Invite"},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		return login(t, "admin.invite@test.com", "password123")
+	}
+
+	accept := func(t *testing.T, token string) int {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/invitations/accept", domain.AcceptInvitationRequest{
+			Token:    token,
+			Password: "nursepassword123",
+			Profile:  domain.UserProfile{FirstName: "Bia", LastName: "Lima", COREN: "COREN-SP 123456"},
+		}, "")
+		return rec.Code
+	}
+
+	t.Run("should create accounts with the invited type", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		adminToken := setupAdmin(t)
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/admin/invitations", domain.InviteUserRequest{
+			Email: "nurse.invite@test.com",
+			Type:  domain.UserTypeNurse,
+		}, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var invitation domain.Invitation
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &invitation))
+		assert.Equal(t, domain.UserTypeNurse, invitation.Type)
+		assert.NotEmpty(t, invitation.InvitedBy)
+
+		token := app.TokenFromEmail(t, "nurse.invite@test.com")
+
+		// Nurses need a COREN, and a rejected profile doesn't use up the invitation
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/invitations/accept", domain.AcceptInvitationRequest{
+			Token:    token,
+			Password: "nursepassword123",
+			Profile:  domain.UserProfile{FirstName: "Bia", LastName: "Lima"},
+		}, "")
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/invitations/accept", domain.AcceptInvitationRequest{
+			Token:    token,
+			Password: "nursepassword123",
+			Profile:  domain.UserProfile{FirstName: "Bia", LastName: "Lima", COREN: "COREN-SP 123456"},
+		}, "")
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var registerResp domain.RegisterResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registerResp))
+		assert.Equal(t, "nurse.invite@test.com", registerResp.Email)
+		assert.Equal(t, domain.UserTypeNurse, registerResp.Type)
+		assert.Equal(t, domain.UserStatusActive, registerResp.Status)
+
+		// The account works right away and the link can't be used again
+		nurseToken := login(t, "nurse.invite@test.com", "nursepassword123")
+		claims, err := app.JWTManager.Validate(nurseToken)
+		require.NoError(t, err)
+		assert.Equal(t, domain.UserTypeNurse, claims.UserType)
+
+		assert.Equal(t, http.StatusBadRequest, accept(t, token))
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/admin/audit-logs?action=user.invited", nil, adminToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var events []domain.AuditEvent
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &events))
+		require.Len(t, events, 1)
+		assert.Equal(t, "nurse.invite@test.com", events[0].Details["email"])
+	})
+
+	t.Run("should only keep the latest invitation", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		adminToken := setupAdmin(t)
+		invite := domain.InviteUserRequest{Email: "nurse.reinvite@test.com", Type: domain.UserTypeNurse}
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/admin/invitations", invite, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		first := app.TokenFromEmail(t, "nurse.reinvite@test.com")
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/invitations", invite, adminToken)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		second := app.TokenFromEmail(t, "nurse.reinvite@test.com")
+
+		assert.Equal(t, http.StatusBadRequest, accept(t, first))
+		assert.Equal(t, http.StatusCreated, accept(t, second))
+
+		// Registered emails can't be invited
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/invitations", invite, adminToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("should validate invitations", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		adminToken := setupAdmin(t)
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/admin/invitations", domain.InviteUserRequest{
+			Email: "unknown.invite@test.com",
+			Type:  "superuser",
+		}, adminToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		assert.Equal(t, http.StatusBadRequest, accept(t, "not-a-valid-token"))
+
+		// Only admins invite
+		rec = app.Register(t, domain.RegisterRequest{
+			Email:    "patient.invite@test.com",
+			Password: "password123",
+			Type:     domain.UserTypePatient,
+			Profile:  domain.UserProfile{FirstName: "Paulo", LastName: "Souza"},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		app.VerifyEmail(t, "patient.invite@test.com")
+		patientToken := login(t, "patient.invite@test.com", "password123")
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/invitations", domain.InviteUserRequest{
+			Email: "doctor.invite@test.com",
+			Type:  domain.UserTypeDoctor,
+		}, patientToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
@@ -0,0 +1,122 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg/jwks"
+)
+
+func TestJWKSIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	login := func(t *testing.T, email string) string {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: "password123"}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var loginResp domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		return loginResp.Token
+	}
+
+	keyID := func(t *testing.T, token string) string {
+		t.Helper()
+
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+		require.NoError(t, err)
+		kid, _ := parsed.Header["kid"].(string)
+		return kid
+	}
+
+	publishedKeys := func(t *testing.T) []string {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodGet, "/.well-known/jwks.json", nil, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("Cache-Control"), "max-age")
+
+		var set jwks.Set
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &set))
+
+		var ids []string
+		for _, key := range set.Keys {
+			assert.Equal(t, "sig", key.Use)
+			ids = append(ids, key.KeyID)
+		}
+		return ids
+	}
+
+	t.Run("should sign tokens with a published key and keep old keys during rotation", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		rec := app.Register(t, domain.RegisterRequest{
+			Email:    "patient.jwks@test.com",
+			Password: "password123",
+			Type:     domain.UserTypePatient,
+			Profile: domain.UserProfile{
+				FirstName: "Jwks",
+				LastName:  "User",
+				Phone:     "+55-11-99999-9999",
+			},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		app.VerifyEmail(t, "patient.jwks@test.com")
+
+		oldToken := login(t, "patient.jwks@test.com")
+		oldKid := keyID(t, oldToken)
+		assert.Contains(t, publishedKeys(t), oldKid)
+
+		// Rotate to a new RSA key
+		next, err := jwks.GenerateKey(jwks.AlgorithmRS256, time.Now())
+		require.NoError(t, err)
+		app.SigningKeys.Add(next)
+
+		newToken := login(t, "patient.jwks@test.com")
+		assert.Equal(t, next.ID, keyID(t, newToken))
+		assert.ElementsMatch(t, []string{oldKid, next.ID}, publishedKeys(t))
+
+		// Tokens signed with the retired key still work during the grace period
+		for _, token := range []string{oldToken, newToken} {
+			rec := app.DoJSON(t, http.MethodGet, "/v1/protected", nil, token)
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("should reject tokens signed by unknown keys", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		foreign, err := jwks.GenerateKey(jwks.AlgorithmEdDSA, time.Now())
+		require.NoError(t, err)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+			"user_id":   "someone",
+			"user_type": domain.UserTypeAdmin,
+			"exp":       time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = foreign.ID
+		signed, err := token.SignedString(foreign.Signer)
+		require.NoError(t, err)
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/protected", nil, signed)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
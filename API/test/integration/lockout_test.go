@@ -0,0 +1,152 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestLoginLockoutIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	register := func(t *testing.T, userType domain.UserType, email string) string {
+		t.Helper()
+
+		rec := app.Register(t, domain.RegisterRequest{
+			Email:    email,
+			Password: "password123",
+			Type:     userType,
+			Profile: domain.UserProfile{
+				FirstName: "Lockout",
+				LastName:  "User",
+				Phone:     "+55-11-99999-9999",
+			},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		if userType == domain.UserTypePatient {
+			app.VerifyEmail(t, email)
+		}
+
+		var registerResp domain.RegisterResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registerResp))
+		return registerResp.ID
+	}
+
+	// loginFrom logs in as if the request came through the proxy on behalf of ip
+	loginFrom := func(t *testing.T, ip, email, password string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		body, err := json.Marshal(domain.LoginRequest{Email: email, Password: password})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Forwarded-For", ip)
+		rec := httptest.NewRecorder()
+
+		app.Echo.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("should throttle an account after repeated failures until an admin unlocks it", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypeAdmin, "admin.lockout@test.com")
+		patientID := register(t, domain.UserTypePatient, "patient.lockout@test.com")
+
+		for i := 0; i < 3; i++ {
+			rec := loginFrom(t, "198.51.100.1", "patient.lockout@test.com", "wrongpassword")
+			require.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+
+		// Even the right password is refused while the delay runs, from any IP
+		rec := loginFrom(t, "198.51.100.2", "patient.lockout@test.com", "password123")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+		var apiErr domain.APIError
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+		assert.Contains(t, apiErr.Error(), "try again in")
+
+		adminLogin := loginFrom(t, "198.51.100.3", "admin.lockout@test.com", "password123")
+		require.Equal(t, http.StatusOK, adminLogin.Code)
+		var admin domain.LoginResponse
+		require.NoError(t, json.Unmarshal(adminLogin.Body.Bytes(), &admin))
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/admin/users/"+patientID+"/unlock", nil, admin.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = loginFrom(t, "198.51.100.2", "patient.lockout@test.com", "password123")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		// Both the lock and the unlock are in the audit trail
+		rec = app.DoJSON(t, http.MethodGet, "/v1/admin/audit-logs?target_id="+patientID, nil, admin.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var events []domain.AuditEvent
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &events))
+		require.Len(t, events, 2)
+		assert.Equal(t, domain.AuditActionAccountUnlocked, events[0].Action)
+		assert.Equal(t, domain.AuditActionLoginLocked, events[1].Action)
+		assert.Equal(t, domain.SystemActor, events[1].ActorID)
+	})
+
+	t.Run("should throttle an ip guessing many accounts", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypePatient, "patient.ip@test.com")
+
+		for i := 0; i < 20; i++ {
+			rec := loginFrom(t, "203.0.113.7", fmt.Sprintf("unknown%d@test.com", i), "password123")
+			require.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+
+		rec := loginFrom(t, "203.0.113.7", "patient.ip@test.com", "password123")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+		// Other clients are not affected
+		rec = loginFrom(t, "203.0.113.8", "patient.ip@test.com", "password123")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("should reset the account counter after a successful login", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypePatient, "patient.reset@test.com")
+
+		for i := 0; i < 2; i++ {
+			rec := loginFrom(t, "198.51.100.9", "patient.reset@test.com", "wrongpassword")
+			require.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+
+		rec := loginFrom(t, "198.51.100.9", "patient.reset@test.com", "password123")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		for i := 0; i < 2; i++ {
+			rec := loginFrom(t, "198.51.100.9", "patient.reset@test.com", "wrongpassword")
+			require.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+
+		rec = loginFrom(t, "198.51.100.9", "patient.reset@test.com", "password123")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
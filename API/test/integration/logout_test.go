@@ -0,0 +1,103 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestLogoutIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	login := func(t *testing.T, email, password string) domain.LoginResponse {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: password}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var loginResp domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		return loginResp
+	}
+
+	register := func(t *testing.T, email, password string) {
+		t.Helper()
+
+		rec := app.Register(t, domain.RegisterRequest{
+			Email:    email,
+			Password: password,
+			Type:     domain.UserTypePatient,
+			Profile: domain.UserProfile{
+				FirstName: "Logout",
+				LastName:  "User",
+				Phone:     "+55-11-99999-9999",
+			},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		app.VerifyEmail(t, email)
+	}
+
+	t.Run("should revoke the current session on logout", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, "logout@test.com", "password123")
+		session := login(t, "logout@test.com", "password123")
+		other := login(t, "logout@test.com", "password123")
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/protected", nil, session.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/logout", domain.LogoutRequest{RefreshToken: session.RefreshToken}, session.Token)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		// The access and refresh tokens of the session are no longer accepted
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, session.Token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/refresh", domain.RefreshRequest{RefreshToken: session.RefreshToken}, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// Other sessions keep working
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, other.Token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("should revoke every session on logout all", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, "logout.all@test.com", "password123")
+		first := login(t, "logout.all@test.com", "password123")
+		second := login(t, "logout.all@test.com", "password123")
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/logout/all", nil, first.Token)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		for _, session := range []domain.LoginResponse{first, second} {
+			rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, session.Token)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+			rec = app.DoJSON(t, http.MethodPost, "/v1/auth/refresh", domain.RefreshRequest{RefreshToken: session.RefreshToken}, "")
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("should require authentication", func(t *testing.T) {
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/logout", nil, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
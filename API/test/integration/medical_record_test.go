@@ -0,0 +1,176 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestMedicalRecordIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	// createEntry adds an entry as the doctor and returns it
+	createEntry := func(t *testing.T, patientID string, req domain.CreateRecordEntryRequest, token string) domain.RecordEntry {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/patients/"+patientID+"/medical-record/entries", req, token)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var entry domain.RecordEntry
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entry))
+		return entry
+	}
+
+	listEntries := func(t *testing.T, patientID, query, token string) domain.RecordEntryPage {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/patients/"+patientID+"/medical-record/entries"+query, nil, token)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var page domain.RecordEntryPage
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		return page
+	}
+
+	t.Run("should scope the record to the role of the user", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		_, doctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "doctor.record@test.com",
+			domain.UserProfile{FirstName: "Ana", LastName: "Souza"})
+		_, nurseToken := app.RegisterAndLogin(t, domain.UserTypeNurse, "nurse.record@test.com",
+			domain.UserProfile{FirstName: "Carla", LastName: "Dias"})
+		_, receptionistToken := app.RegisterAndLogin(t, domain.UserTypeReceptionist, "reception.record@test.com",
+			domain.UserProfile{FirstName: "Rita", LastName: "Lopes"})
+		patientID, patientToken := app.RegisterAndLogin(t, domain.UserTypePatient, "patient.record@test.com",
+			domain.UserProfile{FirstName: "Paulo", LastName: "Reis"})
+		otherPatientID, _ := app.RegisterAndLogin(t, domain.UserTypePatient, "other.record@test.com",
+			domain.UserProfile{FirstName: "Lia", LastName: "Reis"})
+
+		encounter := createEntry(t, patientID, domain.CreateRecordEntryRequest{
+			Kind: domain.RecordEntryEncounter,
+			RecordContent: domain.RecordContent{Encounter: &domain.Encounter{
+				OccurredAt: time.Now().UTC(), Reason: "Falta de ar", Summary: "Sibilos difusos",
+			}},
+			Finalize: true,
+		}, doctorToken)
+		diagnosis := createEntry(t, patientID, domain.CreateRecordEntryRequest{
+			Kind:        domain.RecordEntryDiagnosis,
+			EncounterID: encounter.ID,
+			RecordContent: domain.RecordContent{Diagnosis: &domain.Diagnosis{
+				Code: "J45", Description: "Asma", Notes: "Piora no inverno",
+			}},
+			Finalize: true,
+		}, doctorToken)
+		draft := createEntry(t, patientID, domain.CreateRecordEntryRequest{
+			Kind: domain.RecordEntryAllergy,
+			RecordContent: domain.RecordContent{Allergy: &domain.Allergy{
+				Substance: "Dipirona", Severity: domain.AllergySeverityModerate,
+			}},
+		}, doctorToken)
+		assert.Equal(t, domain.RecordEntryDraft, draft.Status)
+
+		// Doctors see every entry, drafts included
+		assert.Len(t, listEntries(t, patientID, "", doctorToken).Items, 3)
+		assert.Len(t, listEntries(t, patientID, "?encounter_id="+encounter.ID, doctorToken).Items, 1)
+
+		// Nurses see the basic view of final entries
+		page := listEntries(t, patientID, "", nurseToken)
+		require.Len(t, page.Items, 2)
+		assert.Equal(t, diagnosis.ID, page.Items[0].ID)
+		assert.Empty(t, page.Items[0].Diagnosis.Notes)
+		assert.Empty(t, page.Items[1].Encounter.Summary)
+		rec := app.DoJSON(t, http.MethodGet, "/v1/patients/"+patientID+"/medical-record/entries/"+draft.ID, nil, nurseToken)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = app.DoJSON(t, http.MethodGet, "/v1/patients/"+patientID+"/medical-record/entries?status=draft", nil, nurseToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		// Patients see their own final entries only
+		page = listEntries(t, patientID, "", patientToken)
+		require.Len(t, page.Items, 2)
+		assert.Equal(t, "Piora no inverno", page.Items[0].Diagnosis.Notes)
+		rec = app.DoJSON(t, http.MethodGet, "/v1/patients/"+patientID+"/medical-record/entries/"+draft.ID, nil, patientToken)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = app.DoJSON(t, http.MethodGet, "/v1/patients/"+otherPatientID+"/medical-record/entries", nil, patientToken)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		// Receptionists have no access, and only doctors write
+		rec = app.DoJSON(t, http.MethodGet, "/v1/patients/"+patientID+"/medical-record", nil, receptionistToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, "/v1/patients/"+patientID+"/medical-record/entries", domain.CreateRecordEntryRequest{
+			Kind:          domain.RecordEntryAllergy,
+			RecordContent: domain.RecordContent{Allergy: &domain.Allergy{Substance: "Látex", Severity: domain.AllergySeverityMild}},
+		}, nurseToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		// The overview only has final entries
+		rec = app.DoJSON(t, http.MethodGet, "/v1/patients/"+patientID+"/medical-record", nil, patientToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var record domain.MedicalRecord
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &record))
+		assert.Empty(t, record.Allergies)
+		require.Len(t, record.Diagnoses, 1)
+		assert.Equal(t, "Asma", record.Diagnoses[0].Diagnosis.Description)
+	})
+
+	t.Run("should only let the author change drafts", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		_, doctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "doctor.record@test.com",
+			domain.UserProfile{FirstName: "Ana", LastName: "Souza"})
+		_, otherDoctorToken := app.RegisterAndLogin(t, domain.UserTypeDoctor, "other.doctor.record@test.com",
+			domain.UserProfile{FirstName: "Bruno", LastName: "Lima", CRM: "CRM/SP 222222"})
+		patientID, _ := app.RegisterAndLogin(t, domain.UserTypePatient, "patient.record@test.com",
+			domain.UserProfile{FirstName: "Paulo", LastName: "Reis"})
+
+		draft := createEntry(t, patientID, domain.CreateRecordEntryRequest{
+			Kind: domain.RecordEntryMedication,
+			RecordContent: domain.RecordContent{Medication: &domain.Medication{
+				Name: "Salbutamol", Dosage: "100 mcg", Frequency: "se necessário",
+			}},
+		}, doctorToken)
+		path := "/v1/patients/" + patientID + "/medical-record/entries/" + draft.ID
+		update := domain.UpdateRecordEntryRequest{RecordContent: domain.RecordContent{Medication: &domain.Medication{
+			Name: "Salbutamol", Dosage: "200 mcg", Frequency: "se necessário",
+		}}}
+
+		rec := app.DoJSON(t, http.MethodPatch, path, update, otherDoctorToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPatch, path, domain.UpdateRecordEntryRequest{
+			RecordContent: domain.RecordContent{Allergy: &domain.Allergy{Substance: "Látex", Severity: domain.AllergySeverityMild}},
+		}, doctorToken)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPatch, path, update, doctorToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var entry domain.RecordEntry
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entry))
+		assert.Equal(t, "200 mcg", entry.Medication.Dosage)
+
+		rec = app.DoJSON(t, http.MethodPost, path+"/finalize", nil, doctorToken)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entry))
+		assert.Equal(t, domain.RecordEntryFinal, entry.Status)
+		assert.NotNil(t, entry.FinalizedAt)
+
+		// Final entries never change
+		rec = app.DoJSON(t, http.MethodPatch, path, update, doctorToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, path+"/finalize", nil, doctorToken)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
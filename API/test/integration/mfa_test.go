@@ -0,0 +1,242 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/pkg/totp"
+)
+
+func TestMFAIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	register := func(t *testing.T, userType domain.UserType, email string) {
+		t.Helper()
+
+		rec := app.Register(t, domain.RegisterRequest{
+			Email:    email,
+			Password: "password123",
+			Type:     userType,
+			Profile: domain.UserProfile{
+				FirstName: "MFA",
+				LastName:  "User",
+				Phone:     "+55-11-99999-9999",
+			},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+	}
+
+	login := func(t *testing.T, email string) domain.LoginResponse {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: "password123"}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var loginResp domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		return loginResp
+	}
+
+	// code returns the TOTP code for the current time step plus offset
+	code := func(t *testing.T, secret string, offset int64) string {
+		t.Helper()
+
+		key, err := totp.DecodeSecret(secret)
+		require.NoError(t, err)
+		return totp.Code(key, totp.Step(time.Now(), totp.DefaultOptions)+offset, totp.DefaultOptions)
+	}
+
+	decodeSetup := func(t *testing.T, body []byte) domain.MFASetup {
+		t.Helper()
+
+		var setup domain.MFASetup
+		require.NoError(t, json.Unmarshal(body, &setup))
+		require.NotEmpty(t, setup.Secret)
+		require.Len(t, setup.RecoveryCodes, 10)
+		return setup
+	}
+
+	t.Run("should enroll and require a second factor on login", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypeDoctor, "doctor.mfa@test.com")
+		session := login(t, "doctor.mfa@test.com")
+		require.NotEmpty(t, session.Token)
+		assert.False(t, session.MFARequired)
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/enroll", nil, session.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+		setup := decodeSetup(t, rec.Body.Bytes())
+		assert.Contains(t, setup.URI, "otpauth://totp/")
+		assert.Contains(t, setup.URI, "secret="+setup.Secret)
+
+		// MFA isn't enforced until the enrollment is confirmed
+		assert.NotEmpty(t, login(t, "doctor.mfa@test.com").Token)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/confirm", domain.MFACodeRequest{Code: "000000"}, session.Token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		currentCode := code(t, setup.Secret, 0)
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/confirm", domain.MFACodeRequest{Code: currentCode}, session.Token)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/enroll", nil, session.Token)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		// The password alone no longer returns tokens
+		challenge := login(t, "doctor.mfa@test.com")
+		assert.True(t, challenge.MFARequired)
+		assert.False(t, challenge.MFASetupRequired)
+		assert.Empty(t, challenge.Token)
+		assert.Empty(t, challenge.RefreshToken)
+		require.NotEmpty(t, challenge.MFAToken)
+
+		// The challenge token doesn't authenticate requests
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, challenge.MFAToken)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// Codes can't be replayed
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: currentCode}, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: code(t, setup.Secret, 1)}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var mfaSession domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &mfaSession))
+		require.NotEmpty(t, mfaSession.Token)
+		require.NotEmpty(t, mfaSession.RefreshToken)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, mfaSession.Token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		// Recovery codes work once
+		challenge = login(t, "doctor.mfa@test.com")
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: setup.RecoveryCodes[0]}, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		challenge = login(t, "doctor.mfa@test.com")
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: setup.RecoveryCodes[0]}, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: "invalid", Code: setup.RecoveryCodes[1]}, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// Disabling MFA restores password-only login
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/disable", domain.MFACodeRequest{Code: setup.RecoveryCodes[1]}, mfaSession.Token)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		assert.NotEmpty(t, login(t, "doctor.mfa@test.com").Token)
+	})
+
+	t.Run("should use a challenge once and throttle wrong codes", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypeDoctor, "throttled.mfa@test.com")
+		session := login(t, "throttled.mfa@test.com")
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/enroll", nil, session.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+		setup := decodeSetup(t, rec.Body.Bytes())
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/confirm", domain.MFACodeRequest{Code: code(t, setup.Secret, 0)}, session.Token)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		// A challenge is spent once it logs the user in
+		challenge := login(t, "throttled.mfa@test.com")
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: setup.RecoveryCodes[0]}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: setup.RecoveryCodes[1]}, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// Wrong codes count as failed logins, and logging in again doesn't clear them
+		challenge = login(t, "throttled.mfa@test.com")
+		for i := 0; i < 2; i++ {
+			rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: "000000"}, "")
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+		challenge = login(t, "throttled.mfa@test.com")
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: "000000"}, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// Even the right code is refused while the delay runs
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: setup.RecoveryCodes[1]}, "")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/setup", domain.MFASetupRequest{MFAToken: challenge.MFAToken}, "")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	})
+
+	t.Run("should enforce the mfa policy of a user type", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypeAdmin, "admin.mfa@test.com")
+		register(t, domain.UserTypeNurse, "nurse.mfa@test.com")
+		admin := login(t, "admin.mfa@test.com")
+		nurseSession := login(t, "nurse.mfa@test.com")
+
+		rec := app.DoJSON(t, http.MethodGet, "/v1/admin/mfa/policies", nil, admin.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var policies []domain.MFAPolicy
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &policies))
+		assert.Len(t, policies, len(domain.UserTypes))
+		for _, policy := range policies {
+			assert.False(t, policy.Required)
+		}
+
+		required := true
+		rec = app.DoJSON(t, http.MethodPut, "/v1/admin/mfa/policies/nurse", domain.MFAPolicyRequest{Required: &required}, admin.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var policy domain.MFAPolicy
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &policy))
+		assert.Equal(t, domain.UserTypeNurse, policy.UserType)
+		assert.True(t, policy.Required)
+
+		// Nurses without MFA must enroll during login
+		challenge := login(t, "nurse.mfa@test.com")
+		assert.True(t, challenge.MFARequired)
+		assert.True(t, challenge.MFASetupRequired)
+		assert.Empty(t, challenge.Token)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/setup", domain.MFASetupRequest{MFAToken: challenge.MFAToken}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		setup := decodeSetup(t, rec.Body.Bytes())
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/verify", domain.MFAVerifyRequest{MFAToken: challenge.MFAToken, Code: code(t, setup.Secret, 0)}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		// The enrollment is now confirmed
+		challenge = login(t, "nurse.mfa@test.com")
+		assert.True(t, challenge.MFARequired)
+		assert.False(t, challenge.MFASetupRequired)
+
+		// Required MFA can't be disabled
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/mfa/disable", domain.MFACodeRequest{Code: setup.RecoveryCodes[0]}, nurseSession.Token)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		// Invalid requests
+		rec = app.DoJSON(t, http.MethodPut, "/v1/admin/mfa/policies/unknown", domain.MFAPolicyRequest{Required: &required}, admin.Token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPut, "/v1/admin/mfa/policies/doctor", map[string]interface{}{}, admin.Token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/admin/mfa/policies", nil, nurseSession.Token)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
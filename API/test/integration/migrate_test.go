@@ -0,0 +1,228 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/vida-plus/api/internal/domain"
+	"github.com/vida-plus/api/internal/migrations"
+	"github.com/vida-plus/api/pkg/migrate"
+)
+
+func TestMigrateIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Migrations that record each run in the runs collection
+	var upCalls atomic.Int32
+	record := func(name string) func(ctx context.Context, db *mongo.Database) error {
+		return func(ctx context.Context, db *mongo.Database) error {
+			upCalls.Add(1)
+			_, err := db.Collection("runs").InsertOne(ctx, bson.M{"name": name})
+			return err
+		}
+	}
+	undo := func(name string) func(ctx context.Context, db *mongo.Database) error {
+		return func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("runs").DeleteOne(ctx, bson.M{"name": name})
+			return err
+		}
+	}
+	testMigrations := []migrate.Migration{
+		{Version: 2, Name: "second", Up: record("second"), Down: undo("second")},
+		{Version: 1, Name: "first", Up: record("first")},
+		{Version: 3, Name: "third", Up: record("third"), Down: undo("third")},
+	}
+
+	t.Run("should apply, report and roll back migrations in order", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		runner, err := migrate.New(tc.Database, testMigrations)
+		require.NoError(t, err)
+
+		statuses, err := runner.Status(ctx)
+		require.NoError(t, err)
+		require.Len(t, statuses, 3)
+		for _, status := range statuses {
+			assert.Nil(t, status.AppliedAt)
+		}
+
+		applied, err := runner.Up(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, applied)
+
+		// Nothing is pending anymore
+		applied, err = runner.Up(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, applied)
+
+		statuses, err = runner.Status(ctx)
+		require.NoError(t, err)
+		for i, status := range statuses {
+			assert.Equal(t, int64(i+1), status.Version)
+			assert.NotNil(t, status.AppliedAt)
+		}
+
+		// Rolls back the newest first and stops at the irreversible one
+		rolledBack, err := runner.Down(ctx, 3)
+		assert.ErrorIs(t, err, migrate.ErrIrreversible)
+		assert.Equal(t, 2, rolledBack)
+
+		count, err := tc.Database.Collection("runs").CountDocuments(ctx, bson.M{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		statuses, err = runner.Status(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, statuses[0].AppliedAt)
+		assert.Nil(t, statuses[1].AppliedAt)
+		assert.Nil(t, statuses[2].AppliedAt)
+	})
+
+	t.Run("should stop at a failing migration and resume after it is fixed", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		failing := append([]migrate.Migration{}, testMigrations...)
+		failing[0].Up = func(ctx context.Context, db *mongo.Database) error { return errors.New("boom") }
+
+		runner, err := migrate.New(tc.Database, failing)
+		require.NoError(t, err)
+		applied, err := runner.Up(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, 1, applied)
+
+		runner, err = migrate.New(tc.Database, testMigrations)
+		require.NoError(t, err)
+		applied, err = runner.Up(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, applied)
+	})
+
+	t.Run("should apply migrations once when replicas start together", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+		upCalls.Store(0)
+
+		const replicas = 4
+		var wg sync.WaitGroup
+		for i := 0; i < replicas; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				runner, err := migrate.New(tc.Database, testMigrations)
+				assert.NoError(t, err)
+				_, err = runner.Up(ctx)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(3), upCalls.Load())
+
+		// The lock is released
+		count, err := tc.Database.Collection(migrate.Collection).CountDocuments(ctx, bson.M{"_id": "lock"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("should report applied migrations missing from the build", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		runner, err := migrate.New(tc.Database, testMigrations)
+		require.NoError(t, err)
+		_, err = runner.Up(ctx)
+		require.NoError(t, err)
+
+		older, err := migrate.New(tc.Database, testMigrations[:2])
+		require.NoError(t, err)
+		statuses, err := older.Status(ctx)
+		require.NoError(t, err)
+		require.Len(t, statuses, 3)
+		assert.True(t, statuses[2].Unknown)
+		assert.Equal(t, "third", statuses[2].Name)
+	})
+
+	t.Run("should normalize the documents of existing users", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		users := tc.Database.Collection("users")
+		_, err := users.InsertMany(ctx, []interface{}{
+			domain.User{ID: "legacy-doctor", Email: "legacy.doctor@test.com", Type: domain.UserTypeDoctor,
+				Profile: domain.UserProfile{Phone: "(11) 98888-8888", CRM: "crm-sp 12345"}},
+			domain.User{ID: "legacy-patient", Email: "legacy.patient@test.com", Type: domain.UserTypePatient,
+				Profile: domain.UserProfile{Phone: "not a phone", CPF: "12345678909"}},
+		})
+		require.NoError(t, err)
+
+		runner, err := migrate.New(tc.Database, migrations.All)
+		require.NoError(t, err)
+		_, err = runner.Up(ctx)
+		require.NoError(t, err)
+
+		var doctor, patient domain.User
+		require.NoError(t, users.FindOne(ctx, bson.M{"_id": "legacy-doctor"}).Decode(&doctor))
+		require.NoError(t, users.FindOne(ctx, bson.M{"_id": "legacy-patient"}).Decode(&patient))
+		assert.Equal(t, "+5511988888888", doctor.Profile.Phone)
+		assert.Equal(t, "CRM/SP 12345", doctor.Profile.CRM)
+		assert.Equal(t, "123.456.789-09", patient.Profile.CPF)
+		// Invalid values are left for the user to fix
+		assert.Equal(t, "not a phone", patient.Profile.Phone)
+	})
+
+	t.Run("should move note entries of medical records to clinical notes", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		finalizedAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+		entries := tc.Database.Collection("medical_record_entries")
+		_, err := entries.InsertMany(ctx, []interface{}{
+			domain.RecordEntry{ID: "final-note", PatientID: "patient-1", Kind: domain.RecordEntryNote, Status: domain.RecordEntryFinal,
+				RecordContent: domain.RecordContent{Note: &domain.NoteContent{Title: "Evolução", Body: "Estável"}},
+				AuthorID:      "doctor-1", FinalizedAt: &finalizedAt},
+			domain.RecordEntry{ID: "draft-note", PatientID: "patient-1", Kind: domain.RecordEntryNote, Status: domain.RecordEntryDraft,
+				RecordContent: domain.RecordContent{Note: &domain.NoteContent{Title: "Rascunho", Body: "Em avaliação"}},
+				AuthorID:      "doctor-1"},
+			domain.RecordEntry{ID: "allergy", PatientID: "patient-1", Kind: domain.RecordEntryAllergy, Status: domain.RecordEntryFinal,
+				RecordContent: domain.RecordContent{Allergy: &domain.Allergy{Substance: "Látex"}}},
+		})
+		require.NoError(t, err)
+
+		runner, err := migrate.New(tc.Database, migrations.All)
+		require.NoError(t, err)
+		_, err = runner.Up(ctx)
+		require.NoError(t, err)
+
+		// Only the other kinds stay in the record
+		count, err := entries.CountDocuments(ctx, bson.M{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		notes := tc.Database.Collection("clinical_notes")
+		var signed, draft domain.ClinicalNote
+		require.NoError(t, notes.FindOne(ctx, bson.M{"_id": "final-note"}).Decode(&signed))
+		require.NoError(t, notes.FindOne(ctx, bson.M{"_id": "draft-note"}).Decode(&draft))
+		assert.Equal(t, domain.NoteSigned, signed.Status)
+		assert.Equal(t, "Estável", signed.Body)
+		require.NotNil(t, signed.SignedAt)
+		assert.True(t, finalizedAt.Equal(*signed.SignedAt))
+		assert.Equal(t, domain.NoteDraft, draft.Status)
+		assert.Equal(t, "doctor-1", draft.AuthorID)
+		assert.Nil(t, draft.SignedAt)
+	})
+}
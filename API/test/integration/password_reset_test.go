@@ -0,0 +1,161 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestPasswordResetIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	register := func(t *testing.T, email, password string) {
+		t.Helper()
+
+		rec := app.Register(t, domain.RegisterRequest{
+			Email:    email,
+			Password: password,
+			Type:     domain.UserTypePatient,
+			Profile: domain.UserProfile{
+				FirstName: "Reset",
+				LastName:  "User",
+				Phone:     "+55-11-99999-9999",
+			},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		app.VerifyEmail(t, email)
+	}
+
+	login := func(t *testing.T, email, password string) *domain.LoginResponse {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: password}, "")
+		if rec.Code != http.StatusOK {
+			return nil
+		}
+
+		var loginResp domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		return &loginResp
+	}
+
+	t.Run("should reset the password and revoke existing sessions", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, "reset@test.com", "oldpassword123")
+		session := login(t, "reset@test.com", "oldpassword123")
+		require.NotNil(t, session)
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/password/forgot", domain.ForgotPasswordRequest{Email: "reset@test.com"}, "")
+		require.Equal(t, http.StatusAccepted, rec.Code)
+
+		token := app.TokenFromEmail(t, "reset@test.com")
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/password/reset", domain.ResetPasswordRequest{
+			Token:    token,
+			Password: "newpassword123",
+		}, "")
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		// Existing sessions are revoked
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, session.Token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/refresh", domain.RefreshRequest{RefreshToken: session.RefreshToken}, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// Only the new password works
+		assert.Nil(t, login(t, "reset@test.com", "oldpassword123"))
+		newSession := login(t, "reset@test.com", "newpassword123")
+		require.NotNil(t, newSession)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, newSession.Token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		// The token can only be used once
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/password/reset", domain.ResetPasswordRequest{
+			Token:    token,
+			Password: "anotherpassword123",
+		}, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("should only accept the most recent reset token", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, "twice@test.com", "oldpassword123")
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/password/forgot", domain.ForgotPasswordRequest{Email: "twice@test.com"}, "")
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		firstToken := app.TokenFromEmail(t, "twice@test.com")
+
+		// Requests within the cooldown are answered the same way but send nothing
+		sent := len(app.Mailer.Messages())
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/password/forgot", domain.ForgotPasswordRequest{Email: "twice@test.com"}, "")
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Len(t, app.Mailer.Messages(), sent)
+
+		_, err := tc.Database.Collection("users").UpdateOne(ctx, bson.M{"email": "twice@test.com"},
+			bson.M{"$set": bson.M{"reset_sent_at": time.Now().Add(-2 * time.Minute)}})
+		require.NoError(t, err)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/password/forgot", domain.ForgotPasswordRequest{Email: "twice@test.com"}, "")
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		secondToken := app.TokenFromEmail(t, "twice@test.com")
+		require.NotEqual(t, firstToken, secondToken)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/password/reset", domain.ResetPasswordRequest{
+			Token:    firstToken,
+			Password: "newpassword123",
+		}, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/password/reset", domain.ResetPasswordRequest{
+			Token:    secondToken,
+			Password: "newpassword123",
+		}, "")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("should not reveal whether an email is registered", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		sent := len(app.Mailer.Messages())
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/password/forgot", domain.ForgotPasswordRequest{Email: "nobody@test.com"}, "")
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Len(t, app.Mailer.Messages(), sent)
+	})
+
+	t.Run("should reject invalid tokens and weak passwords", func(t *testing.T) {
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/password/reset", domain.ResetPasswordRequest{
+			Token:    "invalid-token",
+			Password: "newpassword123",
+		}, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/password/reset", domain.ResetPasswordRequest{
+			Token:    "invalid-token",
+			Password: "short",
+		}, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
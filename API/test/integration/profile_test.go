@@ -0,0 +1,182 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestProfileIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	register := func(t *testing.T, userType domain.UserType, email string) {
+		t.Helper()
+
+		rec := app.Register(t, domain.RegisterRequest{
+			Email:    email,
+			Password: "password123",
+			Type:     userType,
+			Profile: domain.UserProfile{
+				FirstName: "Profile",
+				LastName:  "User",
+				Phone:     "+55-11-99999-9999",
+			},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		if userType == domain.UserTypePatient {
+			app.VerifyEmail(t, email)
+		}
+	}
+
+	login := func(t *testing.T, email, password string) (int, string) {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: password}, "")
+		if rec.Code != http.StatusOK {
+			return rec.Code, ""
+		}
+
+		var loginResp domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		return rec.Code, loginResp.Token
+	}
+
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("should read and update own profile", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypeDoctor, "doctor.profile@test.com")
+		_, token := login(t, "doctor.profile@test.com", "password123")
+
+		rec := app.DoJSON(t, http.MethodPatch, "/v1/profile", domain.UpdateProfileRequest{
+			Phone:      strPtr("+55-11-98888-7777"),
+			CRM:        strPtr("CRM/SP 123456"),
+			Speciality: strPtr("Cardiologia"),
+		}, token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/profile", nil, token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var user domain.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &user))
+		assert.Equal(t, "doctor.profile@test.com", user.Email)
+		assert.Equal(t, "Profile", user.Profile.FirstName)
+		assert.Equal(t, "+5511988887777", user.Profile.Phone)
+		assert.Equal(t, "CRM/SP 123456", user.Profile.CRM)
+		assert.Equal(t, "Cardiologia", user.Profile.Speciality)
+	})
+
+	t.Run("should not let patients change professional registrations", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypePatient, "patient.profile@test.com")
+		_, token := login(t, "patient.profile@test.com", "password123")
+
+		for _, req := range []domain.UpdateProfileRequest{
+			{CRM: strPtr("CRM/SP 123456")},
+			{COREN: strPtr("COREN-SP 123456"), FirstName: strPtr("Maria")},
+		} {
+			rec := app.DoJSON(t, http.MethodPatch, "/v1/profile", req, token)
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+
+		// Nothing from the rejected updates was saved
+		rec := app.DoJSON(t, http.MethodGet, "/v1/profile", nil, token)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var user domain.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &user))
+		assert.Equal(t, "Profile", user.Profile.FirstName)
+		assert.Empty(t, user.Profile.CRM)
+		assert.Empty(t, user.Profile.COREN)
+	})
+
+	t.Run("should change password after checking the current one", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypePatient, "patient.password@test.com")
+		_, token := login(t, "patient.password@test.com", "password123")
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/profile/password", domain.ChangePasswordRequest{
+			CurrentPassword: "wrongpassword",
+			NewPassword:     "newpassword123",
+		}, token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/profile/password", domain.ChangePasswordRequest{
+			CurrentPassword: "password123",
+			NewPassword:     "newpassword123",
+		}, token)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		// Existing sessions end with the old password
+		rec = app.DoJSON(t, http.MethodGet, "/v1/profile", nil, token)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		code, _ := login(t, "patient.password@test.com", "password123")
+		assert.Equal(t, http.StatusUnauthorized, code)
+		code, _ = login(t, "patient.password@test.com", "newpassword123")
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("should change email only after the new address is confirmed", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		register(t, domain.UserTypePatient, "old.email@test.com")
+		register(t, domain.UserTypePatient, "taken.email@test.com")
+		_, token := login(t, "old.email@test.com", "password123")
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/profile/email", domain.ChangeEmailRequest{
+			Password: "password123",
+			NewEmail: "taken.email@test.com",
+		}, token)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/profile/email", domain.ChangeEmailRequest{
+			Password: "password123",
+			NewEmail: "new.email@test.com",
+		}, token)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+
+		// The old address is warned and keeps working until confirmation
+		notice, ok := app.Mailer.LastTo("old.email@test.com")
+		require.True(t, ok)
+		assert.Contains(t, notice.Body, "new.email@test.com")
+		code, _ := login(t, "old.email@test.com", "password123")
+		assert.Equal(t, http.StatusOK, code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/profile/email/confirm", domain.VerifyEmailRequest{
+			Token: app.TokenFromEmail(t, "new.email@test.com"),
+		}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var user domain.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &user))
+		assert.Equal(t, "new.email@test.com", user.Email)
+		assert.Empty(t, user.PendingEmail)
+
+		code, _ = login(t, "old.email@test.com", "password123")
+		assert.Equal(t, http.StatusUnauthorized, code)
+		code, _ = login(t, "new.email@test.com", "password123")
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
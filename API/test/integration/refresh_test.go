@@ -0,0 +1,112 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestRefreshTokenIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	refresh := func(t *testing.T, refreshToken string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		reqBody, err := json.Marshal(domain.RefreshRequest{RefreshToken: refreshToken})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/auth/refresh", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		app.Echo.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("should rotate refresh tokens and revoke the family on reuse", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		registerReq := domain.RegisterRequest{
+			Email:    "refresh@test.com",
+			Password: "password123",
+			Type:     domain.UserTypePatient,
+			Profile: domain.UserProfile{
+				FirstName: "Refresh",
+				LastName:  "User",
+				Phone:     "+55-11-99999-9999",
+			},
+		}
+
+		reqBody, err := json.Marshal(registerReq)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/auth/register", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		app.Echo.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		app.VerifyEmail(t, registerReq.Email)
+
+		reqBody, err = json.Marshal(domain.LoginRequest{Email: registerReq.Email, Password: registerReq.Password})
+		require.NoError(t, err)
+
+		req = httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		rec = httptest.NewRecorder()
+
+		app.Echo.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var loginResp domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		assert.NotEmpty(t, loginResp.Token)
+		require.NotEmpty(t, loginResp.RefreshToken)
+
+		// First refresh rotates the token
+		rec = refresh(t, loginResp.RefreshToken)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var rotated domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rotated))
+		assert.NotEmpty(t, rotated.Token)
+		require.NotEmpty(t, rotated.RefreshToken)
+		assert.NotEqual(t, loginResp.RefreshToken, rotated.RefreshToken)
+
+		claims, err := app.JWTManager.Validate(rotated.Token)
+		require.NoError(t, err)
+		assert.Equal(t, registerReq.Email, claims.Email)
+
+		// Replaying the original token is detected as reuse
+		rec = refresh(t, loginResp.RefreshToken)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// The whole family is revoked, including the rotated token
+		rec = refresh(t, rotated.RefreshToken)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("should reject invalid refresh tokens", func(t *testing.T) {
+		rec := refresh(t, "invalid-token")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = refresh(t, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -2,9 +2,14 @@
 package integration
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 	"time"
 
@@ -16,10 +21,14 @@ import (
 
 	"github.com/vida-plus/api/internal/domain"
 	"github.com/vida-plus/api/internal/handler"
+	"github.com/vida-plus/api/internal/healthcheck"
 	"github.com/vida-plus/api/internal/middleware"
 	"github.com/vida-plus/api/internal/repository"
 	"github.com/vida-plus/api/internal/service"
 	"github.com/vida-plus/api/pkg"
+	"github.com/vida-plus/api/pkg/jwks"
+	"github.com/vida-plus/api/pkg/mailer"
+	"github.com/vida-plus/api/pkg/shutdown"
 )
 
 // TestContainer holds the MongoDB test container and related resources
@@ -40,6 +49,11 @@ type TestApp struct {
 	AuthHandler      *handler.AuthHandler
 	ProtectedHandler *handler.ProtectedHandler
 	HealthHandler    *handler.HealthHandler
+	Mailer           *mailer.MemoryMailer
+	SigningKeys      *jwks.KeySet
+	Invitations      domain.InvitationService
+	Shutdown         *shutdown.Manager
+	Health           *healthcheck.Registry
 }
 
 // SetupMongoDB creates a MongoDB test container
@@ -116,22 +130,83 @@ func (tc *TestContainer) TeardownMongoDB(ctx context.Context, t *testing.T) {
 func SetupTestApp(tc *TestContainer) *TestApp {
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(tc.Database)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(tc.Database)
+	tokenRevocationRepo := repository.NewTokenRevocationRepository(tc.Database)
+	passwordResetRepo := repository.NewPasswordResetRepository(tc.Database)
+	mfaRepo := repository.NewMFARepository(tc.Database)
+	mfaPolicyRepo := repository.NewMFAPolicyRepository(tc.Database)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(tc.Database)
+	auditRepo := repository.NewAuditRepository(tc.Database)
+	if err := repository.EnsureIndexes(context.Background(), tc.Database); err != nil {
+		log.Fatalf("Failed to create indexes: %v", err)
+	}
 
 	// Initialize services
-	jwtManager := pkg.NewJWTManager()
-	userService := service.NewUserService(userRepo)
-	authService := service.NewAuthService(userService, jwtManager)
+	signingKeys, err := jwks.Load(jwks.DefaultConfig())
+	if err != nil {
+		log.Fatalf("Failed to generate signing keys: %v", err)
+	}
+	jwtConfig := pkg.DefaultJWTConfig()
+	jwtManager := pkg.NewJWTManager(signingKeys, jwtConfig)
+	revocationStore := service.NewTokenRevocationService(tokenRevocationRepo, refreshTokenRepo, jwtConfig.AccessTokenTTL)
+	userStatusCache := service.NewUserStatusService(userRepo)
+	userService := service.NewUserService(userRepo, userStatusCache, revocationStore)
+	memoryMailer := mailer.NewMemoryMailer()
+	passwordResetService := service.NewPasswordResetService(userRepo, passwordResetRepo, memoryMailer, revocationStore,
+		"http://localhost:5173/reset-password")
+	emailVerificationService := service.NewEmailVerificationService(userRepo, jwtManager, memoryMailer, userStatusCache,
+		"http://localhost:5173/verify-email")
+	mfaService := service.NewMFAService(userRepo, mfaRepo, mfaPolicyRepo)
+	profileService := service.NewProfileService(userRepo, jwtManager, memoryMailer, revocationStore,
+		"http://localhost:5173/confirm-email")
+	loginThrottle := service.NewLoginThrottleService(loginAttemptRepo, userService, auditRepo, service.DefaultLoginThrottleConfig())
+	userAdminService := service.NewUserAdminService(userRepo, userService, userStatusCache, revocationStore, auditRepo)
+	invitationService := service.NewInvitationService(userRepo, repository.NewInvitationRepository(tc.Database), memoryMailer, auditRepo,
+		"http://localhost:5173/accept-invitation")
+	authService := service.NewAuthService(userService, jwtManager, refreshTokenRepo, revocationStore, emailVerificationService, mfaService,
+		loginThrottle, jwtConfig.RefreshTokenTTL)
 
 	// Initialize handlers
-	authHandler := handler.NewAuthHandler(authService)
+	authHandler := handler.NewAuthHandler(authService, passwordResetService, emailVerificationService)
+	mfaHandler := handler.NewMFAHandler(mfaService)
 	protectedHandler := handler.NewProtectedHandler()
-	healthHandler := handler.NewHealthHandler(tc.MongoClient)
+	profileHandler := handler.NewProfileHandler(profileService)
+	invitationHandler := handler.NewInvitationHandler(invitationService)
+	shutdownManager := shutdown.New(shutdown.Config{DrainTimeout: time.Second, HookTimeout: time.Second})
+	healthRegistry := healthcheck.NewRegistry(0)
+	healthRegistry.Register(healthcheck.Component{Name: "mongodb", Check: healthcheck.MongoCheck(tc.MongoClient),
+		Timeout: time.Second, Critical: true})
+	healthRegistry.Register(healthcheck.Component{Name: "signing_keys", Check: func(ctx context.Context) error {
+		_, err := signingKeys.Active()
+		return err
+	}, Timeout: time.Second, Critical: true})
+	healthHandler := handler.NewHealthHandler(healthRegistry, shutdownManager)
+	jwksHandler := handler.NewJWKSHandler(signingKeys)
+	appointmentRepo := repository.NewAppointmentRepository(tc.Database)
+	availabilityService := service.NewAvailabilityService(repository.NewAvailabilityRepository(tc.Database), appointmentRepo, userRepo,
+		service.AvailabilityConfig{
+			Location:                time.UTC,
+			SlotDuration:            30 * time.Minute,
+			SpecialitySlotDurations: map[string]time.Duration{"Psiquiatria": 50 * time.Minute},
+		})
+	appointmentHandler := handler.NewAppointmentHandler(service.NewAppointmentService(appointmentRepo, userRepo, availabilityService))
+	availabilityHandler := handler.NewAvailabilityHandler(availabilityService)
+	doctorHandler := handler.NewDoctorHandler(service.NewDoctorDirectoryService(userRepo, availabilityService))
+	medicalRecordRepo := repository.NewMedicalRecordRepository(tc.Database)
+	medicalRecordHandler := handler.NewMedicalRecordHandler(service.NewMedicalRecordService(medicalRecordRepo, appointmentRepo, userRepo))
+	clinicalNoteHandler := handler.NewClinicalNoteHandler(service.NewClinicalNoteService(
+		repository.NewClinicalNoteRepository(tc.Database), medicalRecordRepo, userRepo))
 
 	// Setup Echo app
 	e := echo.New()
+	e.IPExtractor = echo.ExtractIPFromXFFHeader()
 
 	// Configure routes
-	setupTestRoutes(e, jwtManager, userRepo, authHandler, protectedHandler, healthHandler)
+	jwtMiddleware := middleware.JWTMiddleware(jwtManager, revocationStore, userStatusCache)
+	adminHandler := handler.NewAdminHandler(service.NewStatsService(userRepo), userAdminService, emailVerificationService, loginThrottle, auditRepo)
+	setupTestRoutes(e, jwtMiddleware, authHandler, adminHandler, mfaHandler, protectedHandler, profileHandler, invitationHandler,
+		healthHandler, jwksHandler, appointmentHandler, availabilityHandler, doctorHandler, medicalRecordHandler,
+		clinicalNoteHandler)
 
 	return &TestApp{
 		Echo:             e,
@@ -141,48 +216,234 @@ func SetupTestApp(tc *TestContainer) *TestApp {
 		AuthHandler:      authHandler,
 		ProtectedHandler: protectedHandler,
 		HealthHandler:    healthHandler,
+		Mailer:           memoryMailer,
+		SigningKeys:      signingKeys,
+		Invitations:      invitationService,
+		Shutdown:         shutdownManager,
+		Health:           healthRegistry,
 	}
 }
 
 // setupTestRoutes configures all routes for testing
-func setupTestRoutes(e *echo.Echo, jwtManager domain.JWTManager, userRepo domain.UserRepository, authHandler *handler.AuthHandler,
-	protectedHandler *handler.ProtectedHandler, healthHandler *handler.HealthHandler) {
+func setupTestRoutes(e *echo.Echo, jwtMiddleware echo.MiddlewareFunc, authHandler *handler.AuthHandler, adminHandler *handler.AdminHandler,
+	mfaHandler *handler.MFAHandler, protectedHandler *handler.ProtectedHandler, profileHandler *handler.ProfileHandler,
+	invitationHandler *handler.InvitationHandler, healthHandler *handler.HealthHandler, jwksHandler *handler.JWKSHandler,
+	appointmentHandler *handler.AppointmentHandler, availabilityHandler *handler.AvailabilityHandler, doctorHandler *handler.DoctorHandler,
+	medicalRecordHandler *handler.MedicalRecordHandler, clinicalNoteHandler *handler.ClinicalNoteHandler) {
 
 	// Health check
 	e.GET("/health", healthHandler.Check)
+	e.GET("/health/live", healthHandler.Live)
+	e.GET("/health/ready", healthHandler.Ready)
+	e.GET("/.well-known/jwks.json", jwksHandler.GetKeys)
 
 	// Auth routes
 	v1 := e.Group("/v1")
 	v1.POST("/auth/register", authHandler.Register)
+	v1.POST("/auth/invitations/accept", invitationHandler.Accept)
 	v1.POST("/auth/login", authHandler.Login)
+	v1.POST("/auth/refresh", authHandler.Refresh)
+	v1.POST("/auth/logout", authHandler.Logout, jwtMiddleware)
+	v1.POST("/auth/logout/all", authHandler.LogoutAll, jwtMiddleware)
+	v1.POST("/auth/password/forgot", authHandler.ForgotPassword)
+	v1.POST("/auth/password/reset", authHandler.ResetPassword)
+	v1.POST("/auth/verify-email", authHandler.VerifyEmail)
+	v1.POST("/auth/verify-email/resend", authHandler.ResendVerification)
+	v1.POST("/auth/mfa/setup", authHandler.SetupMFA)
+	v1.POST("/auth/mfa/verify", authHandler.VerifyMFA)
+	v1.POST("/auth/mfa/enroll", mfaHandler.Enroll, jwtMiddleware)
+	v1.POST("/auth/mfa/confirm", mfaHandler.Confirm, jwtMiddleware)
+	v1.POST("/auth/mfa/disable", mfaHandler.Disable, jwtMiddleware)
 
 	// Protected routes
-	protected := v1.Group("", middleware.JWTMiddleware(jwtManager))
+	protected := v1.Group("", jwtMiddleware)
 	protected.GET("/protected", protectedHandler.GetProtectedInfo)
 
-	// Simple profile endpoint to demonstrate user differentiation
-	protected.GET("/profile", func(c echo.Context) error {
-		claims, err := domain.GetAuthClaims(c.Get("claims"))
-		if err != nil {
-			return c.JSON(401, domain.NewAPIError(401, err.Error()))
-		}
-
-		return c.JSON(200, map[string]interface{}{
-			"user_id": claims.UserID,
-			"email":   claims.Email,
-			"type":    claims.UserType,
-			"message": "Perfil do usuário - acesso baseado no tipo: " + string(claims.UserType),
-		})
-	})
+	// Profile self-service
+	v1.POST("/profile/email/confirm", profileHandler.ConfirmEmailChange)
+	protected.GET("/profile", profileHandler.GetProfile)
+	protected.PATCH("/profile", profileHandler.UpdateProfile)
+	protected.POST("/profile/password", profileHandler.ChangePassword)
+	protected.POST("/profile/email", profileHandler.ChangeEmail)
 
 	// Admin routes (require Admin role) - using real AdminHandler
-	adminHandler := handler.NewAdminHandler(userRepo)
 	adminGroup := protected.Group("/admin", middleware.RequireRole(domain.UserTypeAdmin))
-	adminGroup.GET("/users", adminHandler.GetAllUsers)
+	adminGroup.GET("/users", adminHandler.ListUsers)
+	adminGroup.POST("/users", adminHandler.CreateUser)
+	adminGroup.GET("/users/:id", adminHandler.GetUser)
+	adminGroup.PATCH("/users/:id", adminHandler.UpdateUser)
+	adminGroup.DELETE("/users/:id", adminHandler.DeleteUser)
+	adminGroup.POST("/users/:id/restore", adminHandler.RestoreUser)
+	adminGroup.POST("/invitations", invitationHandler.Invite)
 	adminGroup.GET("/stats", adminHandler.GetSystemStats)
+	adminGroup.PATCH("/users/:id/status", adminHandler.UpdateUserStatus)
+	adminGroup.POST("/users/:id/verify-email", adminHandler.VerifyUserEmail)
+	adminGroup.POST("/users/:id/unlock", adminHandler.UnlockUser)
+	adminGroup.GET("/audit-logs", adminHandler.GetAuditLogs)
+	adminGroup.GET("/mfa/policies", mfaHandler.GetPolicies)
+	adminGroup.PUT("/mfa/policies/:type", mfaHandler.SetPolicy)
+
+	// Appointment routes (patients manage their own, staff manage anyone's)
+	appointments := protected.Group("/appointments",
+		middleware.RequirePermission(domain.PermissionManageAppointments, domain.PermissionManageOwnAppointments))
+	appointments.POST("", appointmentHandler.Book)
+	appointments.GET("", appointmentHandler.List)
+	appointments.GET("/:id", appointmentHandler.Get)
+	appointments.POST("/:id/reschedule", appointmentHandler.Reschedule)
+	appointments.POST("/:id/cancel", appointmentHandler.Cancel)
+	staff := middleware.RequirePermission(domain.PermissionManageAppointments)
+	appointments.POST("/:id/check-in", appointmentHandler.CheckIn, staff)
+	appointments.POST("/:id/no-show", appointmentHandler.NoShow, staff)
+
+	// Doctor directory and calendars (any user searches doctors and slots, the doctor and the front
+	// desk manage the hours)
+	protected.GET("/doctors", doctorHandler.List)
+	protected.GET("/doctors/:id", doctorHandler.Get)
+	protected.GET("/doctors/:id/slots", availabilityHandler.Slots)
+	calendar := protected.Group("/doctors/:id/availability",
+		middleware.RequireUserType(domain.UserTypeDoctor, domain.UserTypeAdmin, domain.UserTypeReceptionist))
+	calendar.GET("", availabilityHandler.GetAvailability)
+	calendar.PUT("", availabilityHandler.SetAvailability)
+	calendar.GET("/exceptions", availabilityHandler.ListExceptions)
+	calendar.POST("/exceptions", availabilityHandler.AddException)
+	calendar.DELETE("/exceptions/:exceptionId", availabilityHandler.DeleteException)
+
+	// Medical records (doctors read and write, nurses read the basic view, patients read their own)
+	records := protected.Group("/patients/:id/medical-record",
+		middleware.RequirePermission(domain.PermissionViewMedicalRecords, domain.PermissionViewBasicRecords, domain.PermissionViewOwnRecords))
+	records.GET("", medicalRecordHandler.GetRecord)
+	records.GET("/entries", medicalRecordHandler.ListEntries)
+	records.POST("/entries", medicalRecordHandler.CreateEntry)
+	records.GET("/entries/:entryId", medicalRecordHandler.GetEntry)
+	records.PATCH("/entries/:entryId", medicalRecordHandler.UpdateEntry)
+	records.POST("/entries/:entryId/finalize", medicalRecordHandler.FinalizeEntry)
+	records.GET("/notes", clinicalNoteHandler.ListNotes)
+	records.POST("/notes", clinicalNoteHandler.CreateNote)
+	records.GET("/notes/:noteId", clinicalNoteHandler.GetNote)
+	records.PATCH("/notes/:noteId", clinicalNoteHandler.UpdateNote)
+	records.POST("/notes/:noteId/sign", clinicalNoteHandler.SignNote)
+	records.POST("/notes/:noteId/amendments", clinicalNoteHandler.AmendNote)
 }
 
-// CleanDatabase removes all data from test database
+// DoJSON sends a request with an optional JSON body and bearer token to the test app
+func (app *TestApp) DoJSON(t *testing.T, method, path string, body interface{}, token string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			t.Fatalf("Failed to encode request body: %v", err)
+		}
+	}
+
+	req := httptest.NewRequest(method, path, &reqBody)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+
+	app.Echo.ServeHTTP(rec, req)
+	return rec
+}
+
+// tokenLinkPattern matches the token query parameter of links sent by email
+var tokenLinkPattern = regexp.MustCompile(`[?&]token=([A-Za-z0-9_-]+)`)
+
+// TokenFromEmail returns the token embedded in the last email sent to the given address
+func (app *TestApp) TokenFromEmail(t *testing.T, to string) string {
+	t.Helper()
+
+	email, ok := app.Mailer.LastTo(to)
+	if !ok {
+		t.Fatalf("No email sent to %s", to)
+	}
+
+	match := tokenLinkPattern.FindStringSubmatch(email.Body)
+	if match == nil {
+		t.Fatalf("No token link found in email to %s", to)
+	}
+	return match[1]
+}
+
+// VerifyEmail confirms the email of a newly registered account using the link sent to it
+func (app *TestApp) VerifyEmail(t *testing.T, email string) {
+	t.Helper()
+
+	rec := app.DoJSON(t, http.MethodPost, "/v1/auth/verify-email", domain.VerifyEmailRequest{Token: app.TokenFromEmail(t, email)}, "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("Failed to verify email %s: %d %s", email, rec.Code, rec.Body.String())
+	}
+}
+
+// Register creates an account the way its type is onboarded: patients self-register and staff
+// accept an invitation.
+func (app *TestApp) Register(t *testing.T, req domain.RegisterRequest) *httptest.ResponseRecorder {
+	t.Helper()
+
+	if req.Type == "" || req.Type == domain.UserTypePatient {
+		return app.DoJSON(t, http.MethodPost, "/v1/auth/register", req, "")
+	}
+	return app.RegisterStaff(t, req)
+}
+
+// RegisterStaff creates an account of a type that can't self-register by accepting an invitation
+// sent by the system. The response is the same as a successful /auth/register. Doctors and
+// nurses without a CRM or COREN get one, since their profiles require it.
+func (app *TestApp) RegisterStaff(t *testing.T, req domain.RegisterRequest) *httptest.ResponseRecorder {
+	t.Helper()
+
+	if req.Type == domain.UserTypeDoctor && req.Profile.CRM == "" {
+		req.Profile.CRM = "CRM/SP 100000"
+	}
+	if req.Type == domain.UserTypeNurse && req.Profile.COREN == "" {
+		req.Profile.COREN = "COREN-SP 100000"
+	}
+
+	invite := domain.InviteUserRequest{Email: req.Email, Type: req.Type}
+	if _, err := app.Invitations.Invite(context.Background(), invite, domain.SystemActor); err != nil {
+		t.Fatalf("Failed to invite %s: %v", req.Email, err)
+	}
+
+	return app.DoJSON(t, http.MethodPost, "/v1/auth/invitations/accept", domain.AcceptInvitationRequest{
+		Token:    app.TokenFromEmail(t, req.Email),
+		Password: req.Password,
+		Profile:  req.Profile,
+	}, "")
+}
+
+// RegisterAndLogin creates an account of the given type, verifying the email of patients, and
+// returns its ID and access token. Profiles without a name are registered as "Test User".
+func (app *TestApp) RegisterAndLogin(t *testing.T, userType domain.UserType, email string, profile domain.UserProfile) (string, string) {
+	t.Helper()
+
+	if profile.FirstName == "" {
+		profile.FirstName, profile.LastName = "Test", "User"
+	}
+	rec := app.Register(t, domain.RegisterRequest{Email: email, Password: "password123", Type: userType, Profile: profile})
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Failed to register %s: %d %s", email, rec.Code, rec.Body.String())
+	}
+	var registered domain.RegisterResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &registered); err != nil {
+		t.Fatalf("Failed to decode registration of %s: %v", email, err)
+	}
+	if userType == domain.UserTypePatient {
+		app.VerifyEmail(t, email)
+	}
+
+	rec = app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: "password123"}, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Failed to log in %s: %d %s", email, rec.Code, rec.Body.String())
+	}
+	var loginResp domain.LoginResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &loginResp); err != nil {
+		t.Fatalf("Failed to decode login of %s: %v", email, err)
+	}
+	return registered.ID, loginResp.Token
+}
+
+// CleanDatabase removes all data from test database, keeping the indexes of the application
 func (tc *TestContainer) CleanDatabase(ctx context.Context, t *testing.T) {
 	t.Helper()
 
@@ -196,6 +457,10 @@ func (tc *TestContainer) CleanDatabase(ctx context.Context, t *testing.T) {
 			t.Fatalf("Failed to drop collection %s: %v", collection, err)
 		}
 	}
+
+	if err := repository.EnsureIndexes(ctx, tc.Database); err != nil {
+		t.Fatalf("Failed to create indexes: %v", err)
+	}
 }
 
 // Cleanup cleans up the test container and database
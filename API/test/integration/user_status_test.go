@@ -0,0 +1,177 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vida-plus/api/internal/domain"
+)
+
+func TestUserStatusIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test container
+	tc := SetupMongoDB(ctx, t)
+	defer tc.TeardownMongoDB(ctx, t)
+
+	// Setup test app
+	app := SetupTestApp(tc)
+
+	register := func(t *testing.T, userType domain.UserType, email string) string {
+		t.Helper()
+
+		rec := app.Register(t, domain.RegisterRequest{
+			Email:    email,
+			Password: "password123",
+			Type:     userType,
+			Profile: domain.UserProfile{
+				FirstName: "Status",
+				LastName:  "User",
+				Phone:     "+55-11-99999-9999",
+			},
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		if userType == domain.UserTypePatient {
+			app.VerifyEmail(t, email)
+		}
+
+		var registerResp domain.RegisterResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registerResp))
+		return registerResp.ID
+	}
+
+	login := func(t *testing.T, email string) *domain.LoginResponse {
+		t.Helper()
+
+		rec := app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: email, Password: "password123"}, "")
+		if rec.Code != http.StatusOK {
+			return nil
+		}
+
+		var loginResp domain.LoginResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &loginResp))
+		return &loginResp
+	}
+
+	problemType := func(t *testing.T, body []byte) string {
+		t.Helper()
+
+		var apiErr domain.APIError
+		require.NoError(t, json.Unmarshal(body, &apiErr))
+		return apiErr.Type
+	}
+
+	t.Run("should reject blocked users until they are reactivated", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		adminID := register(t, domain.UserTypeAdmin, "admin.status@test.com")
+		patientID := register(t, domain.UserTypePatient, "patient.status@test.com")
+
+		admin := login(t, "admin.status@test.com")
+		require.NotNil(t, admin)
+		patient := login(t, "patient.status@test.com")
+		require.NotNil(t, patient)
+
+		// Block the patient
+		rec := app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+patientID+"/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatusBlocked,
+			Reason: "Suspicious activity",
+		}, admin.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var updated domain.User
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+		assert.Equal(t, domain.UserStatusBlocked, updated.Status)
+		assert.Equal(t, "Suspicious activity", updated.StatusReason)
+		assert.Equal(t, adminID, updated.StatusChangedBy)
+
+		// Existing tokens stop working
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, patient.Token)
+		assert.NotEqual(t, http.StatusOK, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/refresh", domain.RefreshRequest{RefreshToken: patient.RefreshToken}, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// Login is rejected with a distinct problem type
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: "patient.status@test.com", Password: "password123"}, "")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, domain.ProblemTypeAccountBlocked, problemType(t, rec.Body.Bytes()))
+
+		// Deactivated accounts get their own problem type
+		rec = app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+patientID+"/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatusInactive,
+			Reason: "Patient moved away",
+		}, admin.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPost, "/v1/auth/login", domain.LoginRequest{Email: "patient.status@test.com", Password: "password123"}, "")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Equal(t, domain.ProblemTypeAccountInactive, problemType(t, rec.Body.Bytes()))
+
+		// Reactivation restores access
+		rec = app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+patientID+"/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatusActive,
+			Reason: "Issue resolved",
+		}, admin.Token)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		patient = login(t, "patient.status@test.com")
+		require.NotNil(t, patient)
+
+		rec = app.DoJSON(t, http.MethodGet, "/v1/protected", nil, patient.Token)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("should validate status changes", func(t *testing.T) {
+		// Clean database before test
+		tc.CleanDatabase(ctx, t)
+
+		adminID := register(t, domain.UserTypeAdmin, "admin.validation@test.com")
+		patientID := register(t, domain.UserTypePatient, "patient.validation@test.com")
+
+		admin := login(t, "admin.validation@test.com")
+		require.NotNil(t, admin)
+		patient := login(t, "patient.validation@test.com")
+		require.NotNil(t, patient)
+
+		// Reason is required
+		rec := app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+patientID+"/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatusBlocked,
+		}, admin.Token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		// Unknown statuses are rejected
+		rec = app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+patientID+"/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatus("deleted"),
+			Reason: "Invalid",
+		}, admin.Token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		// Admins can't lock themselves out
+		rec = app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+adminID+"/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatusBlocked,
+			Reason: "Mistake",
+		}, admin.Token)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = app.DoJSON(t, http.MethodPatch, "/v1/admin/users/unknown/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatusBlocked,
+			Reason: "Unknown user",
+		}, admin.Token)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		// Only admins can change statuses
+		rec = app.DoJSON(t, http.MethodPatch, "/v1/admin/users/"+adminID+"/status", domain.UpdateStatusRequest{
+			Status: domain.UserStatusBlocked,
+			Reason: "Not allowed",
+		}, patient.Token)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}